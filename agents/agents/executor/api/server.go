@@ -11,9 +11,12 @@
 
 var logger = log.Logger("executor-api")
 
-// Start starts the api server.
-func Start(ctx context.Context, metricsPort uint16) error {
+// Start starts the api server. If health is non-nil, its healthz, readyz and livez endpoints are served alongside metrics.
+func Start(ctx context.Context, metricsPort uint16, health *ginhelper.HealthRegistry) error {
 	router := ginhelper.New(logger)
+	if health != nil {
+		health.Mount(router)
+	}
 
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -13,6 +13,7 @@
 	"github.com/synapsecns/sanguine/agents/agents/executor/metadata"
 	execConfig "github.com/synapsecns/sanguine/agents/config/executor"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	omnirpcClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	scribeAPI "github.com/synapsecns/sanguine/services/scribe/api"
@@ -23,6 +24,7 @@
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 
 	// used to embed markdown.
@@ -193,8 +195,13 @@ func createExecutorParameters(ctx context.Context, c *cli.Context, metrics metri
 			return fmt.Errorf("failed to create executor: %w", err)
 		}
 
+		health := ginhelper.NewHealthRegistry()
+		if gormStore, ok := executorDB.(interface{ DB() *gorm.DB }); ok {
+			health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+		}
+
 		g.Go(func() error {
-			err := api.Start(ctx, uint16(c.Uint(metricsPortFlag.Name)))
+			err := api.Start(ctx, uint16(c.Uint(metricsPortFlag.Name)), health)
 			if err != nil {
 				return fmt.Errorf("failed to start api: %w", err)
 			}
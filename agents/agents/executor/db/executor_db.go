@@ -5,6 +5,7 @@
 	"encoding/json"
 	"github.com/synapsecns/sanguine/agents/agents/executor/types"
 	agentsTypes "github.com/synapsecns/sanguine/agents/types"
+	"github.com/synapsecns/sanguine/core/merkle"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 )
 
@@ -34,6 +35,12 @@ type ExecutorDBReader interface {
 	GetMessage(ctx context.Context, messageMask DBMessage) (*agentsTypes.Message, error)
 	// GetMessages gets messages from the database, paginated and ordered in ascending order by nonce.
 	GetMessages(ctx context.Context, messageMask DBMessage, page int) ([]agentsTypes.Message, error)
+	// GetMessagesFromNonce gets the messages of a chain with a nonce greater than or equal to nonce, paginated and
+	// ordered in ascending order by nonce.
+	GetMessagesFromNonce(ctx context.Context, chainID uint32, nonce uint32, page int) ([]agentsTypes.Message, error)
+	// GetEarliestUnexecutedNonce gets the lowest nonce of the messages from a chain to any of the destinations that
+	// have not been executed. It returns nil if every message was executed.
+	GetEarliestUnexecutedNonce(ctx context.Context, chainID uint32, destinations []uint32) (*uint32, error)
 	// GetBlockNumber gets the block number of a message from the database.
 	GetBlockNumber(ctx context.Context, messageMask DBMessage) (uint64, error)
 	// GetLastBlockNumber gets the last block number that had a message in the database.
@@ -81,4 +88,6 @@ type ExecutorDB interface {
 	ExecutorDBWriter
 	ExecutorDBReader
 	SubmitterDB() submitterDB.Service
+	// MerkleStore gets the store of the message tree of the origin on chainID.
+	MerkleStore(chainID uint32) merkle.Store
 }
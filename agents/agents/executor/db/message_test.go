@@ -322,3 +322,67 @@ func (t *DBSuite) TestSetMinimumTime() {
 		Equal(t.T(), uint64(10), *time)
 	})
 }
+
+func (t *DBSuite) TestGetMessagesFromNonce() {
+	t.RunOnAllDBs(func(testDB db.ExecutorDB) {
+		chainID := gofakeit.Uint32()
+		destination := gofakeit.Uint32()
+
+		for nonce := uint32(1); nonce <= 5; nonce++ {
+			header := agentsTypes.NewHeader(agentsTypes.MessageFlagManager, chainID, nonce, destination, gofakeit.Uint32())
+			err := testDB.StoreMessage(t.GetTestContext(), agentsTypes.NewMessage(header, nil, []byte{byte(nonce)}), uint64(nonce), false, 0)
+			Nil(t.T(), err)
+		}
+
+		messages, err := testDB.GetMessagesFromNonce(t.GetTestContext(), chainID, 3, 1)
+		Nil(t.T(), err)
+		Equal(t.T(), 3, len(messages))
+		for i, message := range messages {
+			Equal(t.T(), uint32(i+3), message.Nonce())
+		}
+
+		messages, err = testDB.GetMessagesFromNonce(t.GetTestContext(), chainID, 6, 1)
+		Nil(t.T(), err)
+		Empty(t.T(), messages)
+	})
+}
+
+func (t *DBSuite) TestGetEarliestUnexecutedNonce() {
+	t.RunOnAllDBs(func(testDB db.ExecutorDB) {
+		chainID := gofakeit.Uint32()
+		destination := gofakeit.Uint32()
+		otherDestination := destination + 1
+
+		earliestNonce, err := testDB.GetEarliestUnexecutedNonce(t.GetTestContext(), chainID, []uint32{destination})
+		Nil(t.T(), err)
+		Nil(t.T(), earliestNonce)
+
+		for nonce := uint32(1); nonce <= 3; nonce++ {
+			header := agentsTypes.NewHeader(agentsTypes.MessageFlagManager, chainID, nonce, destination, gofakeit.Uint32())
+			err = testDB.StoreMessage(t.GetTestContext(), agentsTypes.NewMessage(header, nil, []byte{byte(nonce)}), uint64(nonce), false, 0)
+			Nil(t.T(), err)
+		}
+		// messages to other destinations are ignored.
+		header := agentsTypes.NewHeader(agentsTypes.MessageFlagManager, chainID, 4, otherDestination, gofakeit.Uint32())
+		err = testDB.StoreMessage(t.GetTestContext(), agentsTypes.NewMessage(header, nil, []byte{4}), 4, false, 0)
+		Nil(t.T(), err)
+
+		earliestNonce, err = testDB.GetEarliestUnexecutedNonce(t.GetTestContext(), chainID, []uint32{destination})
+		Nil(t.T(), err)
+		Equal(t.T(), uint32(1), *earliestNonce)
+
+		for nonce := uint32(1); nonce <= 3; nonce++ {
+			nonce := nonce
+			err = testDB.ExecuteMessage(t.GetTestContext(), db.DBMessage{ChainID: &chainID, Destination: &destination, Nonce: &nonce})
+			Nil(t.T(), err)
+		}
+
+		earliestNonce, err = testDB.GetEarliestUnexecutedNonce(t.GetTestContext(), chainID, []uint32{destination})
+		Nil(t.T(), err)
+		Nil(t.T(), earliestNonce)
+
+		earliestNonce, err = testDB.GetEarliestUnexecutedNonce(t.GetTestContext(), chainID, []uint32{destination, otherDestination})
+		Nil(t.T(), err)
+		Equal(t.T(), uint32(4), *earliestNonce)
+	})
+}
@@ -1,7 +1,11 @@
 package base
 
 import (
+	"fmt"
+
 	"github.com/synapsecns/sanguine/agents/agents/executor/db"
+	"github.com/synapsecns/sanguine/core/merkle"
+	"github.com/synapsecns/sanguine/core/merkle/gormstore"
 	"github.com/synapsecns/sanguine/core/metrics"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
@@ -31,6 +35,11 @@ func (s Store) SubmitterDB() submitterDB.Service {
 	return s.submitterStore
 }
 
+// MerkleStore gets the store of the message tree of the origin on chainID.
+func (s Store) MerkleStore(chainID uint32) merkle.Store {
+	return gormstore.NewStore(s.db, fmt.Sprintf("executor_origin_%d", chainID))
+}
+
 // GetAllModels gets all models to migrate
 // see: https://medium.com/@SaifAbid/slice-interfaces-8c78f8b6345d for an explanation of why we can't do this at initialization time
 func GetAllModels() (allModels []interface{}) {
@@ -38,6 +47,7 @@ func GetAllModels() (allModels []interface{}) {
 		&Message{}, &Attestation{}, &State{},
 	)
 	allModels = append(allModels, txdb.GetAllModels()...)
+	allModels = append(allModels, gormstore.GetAllModels()...)
 	return allModels
 }
 
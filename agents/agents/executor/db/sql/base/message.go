@@ -123,6 +123,63 @@ func (s Store) GetMessages(ctx context.Context, messageMask db.DBMessage, page i
 	return decodedMessages, nil
 }
 
+// GetMessagesFromNonce gets the messages of a chain with a nonce greater than or equal to nonce, paginated and ordered
+// in ascending order by nonce.
+func (s Store) GetMessagesFromNonce(ctx context.Context, chainID uint32, nonce uint32, page int) ([]agentsTypes.Message, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	var messages []Message
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&messages).
+		Where(fmt.Sprintf("%s = ?", ChainIDFieldName), chainID).
+		Where(fmt.Sprintf("%s >= ?", NonceFieldName), nonce).
+		Order(fmt.Sprintf("%s ASC", NonceFieldName)).
+		Offset((page - 1) * PageSize).
+		Limit(PageSize).
+		Scan(&messages)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", dbTx.Error)
+	}
+
+	decodedMessages := make([]agentsTypes.Message, len(messages))
+	for i, message := range messages {
+		decodedMessage, err := agentsTypes.DecodeMessage(message.Message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode message: %w", err)
+		}
+		decodedMessages[i] = decodedMessage
+	}
+
+	return decodedMessages, nil
+}
+
+// GetEarliestUnexecutedNonce gets the lowest nonce of the messages from a chain to any of the destinations that have
+// not been executed. It returns nil if every message was executed.
+func (s Store) GetEarliestUnexecutedNonce(ctx context.Context, chainID uint32, destinations []uint32) (*uint32, error) {
+	var earliestNonce sql.NullInt64
+
+	dbTx := s.DB().WithContext(ctx).
+		Model(&Message{}).
+		Select(fmt.Sprintf("MIN(%s)", NonceFieldName)).
+		Where(fmt.Sprintf("%s = ?", ChainIDFieldName), chainID).
+		Where(fmt.Sprintf("%s IN ?", DestinationFieldName), destinations).
+		Where(fmt.Sprintf("%s = ?", ExecutedFieldName), false).
+		Scan(&earliestNonce)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("failed to get earliest unexecuted nonce: %w", dbTx.Error)
+	}
+	if !earliestNonce.Valid {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	nonce := uint32(earliestNonce.Int64)
+	return &nonce, nil
+}
+
 // GetBlockNumber gets the block number of a message from the database.
 func (s Store) GetBlockNumber(ctx context.Context, messageMask db.DBMessage) (uint64, error) {
 	var message Message
@@ -608,17 +608,18 @@ func getChainTime(ctx context.Context, backend Backend) (uint64, error) {
 	return chainTime, nil
 }
 
-// newTreeFromDB creates a new merkle tree from the database's messages.
+// newTreeFromDB loads the merkle tree of the origin on chainID from the database. Messages stored since the tree
+// was last updated are inserted, so the tree only has to be built from scratch the first time.
 func newTreeFromDB(ctx context.Context, chainID uint32, executorDB db.ExecutorDB) (*merkle.HistoricalTree, error) {
-	var allMessages []types.Message
-
-	messageMask := db.DBMessage{
-		ChainID: &chainID,
+	merkleTree, err := merkle.NewTreeWithStore(merkle.MessageTreeHeight, executorDB.MerkleStore(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("could not create merkle tree: %w", err)
 	}
-	page := 1
 
+	page := 1
+	fromNonce := merkleTree.NumOfItems() + 1
 	for {
-		messages, err := executorDB.GetMessages(ctx, messageMask, page)
+		messages, err := executorDB.GetMessagesFromNonce(ctx, chainID, fromNonce, page)
 		if err != nil {
 			return nil, fmt.Errorf("could not get messages: %w", err)
 		}
@@ -626,24 +627,51 @@ func newTreeFromDB(ctx context.Context, chainID uint32, executorDB db.ExecutorDB
 			break
 		}
 
-		allMessages = append(allMessages, messages...)
+		for _, message := range messages {
+			if message.Nonce() != merkleTree.NumOfItems()+1 {
+				return nil, fmt.Errorf("message with nonce %d is missing from the db", merkleTree.NumOfItems()+1)
+			}
+
+			leaf, err := message.ToLeaf()
+			if err != nil {
+				return nil, fmt.Errorf("could not convert message to leaf: %w", err)
+			}
+
+			err = merkleTree.Insert(leaf[:])
+			if err != nil {
+				return nil, fmt.Errorf("could not insert message into merkle tree: %w", err)
+			}
+		}
 		page++
 	}
 
-	rawMessages := make([][]byte, len(allMessages))
+	return merkleTree, nil
+}
 
-	for i, message := range allMessages {
-		rawMessage, err := message.ToLeaf()
-		if err != nil {
-			return nil, fmt.Errorf("could not convert message to leaf: %w", err)
-		}
+// pruneTree drops the historical states of the merkle tree of the origin on chainID that are older than every
+// message that can still be executed, since proofs are only generated for those.
+func (e Executor) pruneTree(ctx context.Context, chainID uint32) error {
+	merkleTree := e.chainExecutors[chainID].merkleTree
 
-		rawMessages[i] = rawMessage[:]
+	destinations := make([]uint32, len(e.config.Chains))
+	for i, chain := range e.config.Chains {
+		destinations[i] = chain.ChainID
 	}
 
-	merkleTree := merkle.NewTreeFromItems(rawMessages, merkle.MessageTreeHeight)
+	pruneBefore := merkleTree.NumOfItems()
+	earliestNonce, err := e.executorDB.GetEarliestUnexecutedNonce(ctx, chainID, destinations)
+	if err != nil {
+		return fmt.Errorf("could not get earliest unexecuted nonce: %w", err)
+	}
+	if earliestNonce != nil && *earliestNonce < pruneBefore {
+		pruneBefore = *earliestNonce
+	}
 
-	return merkleTree, nil
+	err = merkleTree.PruneBefore(pruneBefore)
+	if err != nil {
+		return fmt.Errorf("could not prune merkle tree: %w", err)
+	}
+	return nil
 }
 
 // checkIfExecuted checks if a message has been executed.
@@ -883,6 +911,11 @@ func (e Executor) executeExecutable(parentCtx context.Context, chainID uint32) (
 
 				page++
 			}
+
+			err = e.pruneTree(parentCtx, chainID)
+			if err != nil {
+				return err
+			}
 		}
 	}
 }
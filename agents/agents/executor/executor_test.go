@@ -314,7 +314,8 @@ func (e *ExecutorSuite) TestMerkleInsert() {
 	<-waitChan
 	exec.Stop(chainID)
 
-	oldTreeItems := exec.GetMerkleTree(chainID).Items()
+	oldTreeItems, err := exec.GetMerkleTree(chainID).Items()
+	e.Nil(err)
 
 	var newRoot []byte
 	e.Eventually(func() bool {
@@ -334,7 +335,8 @@ func (e *ExecutorSuite) TestMerkleInsert() {
 	})
 	<-waitChan
 
-	newTreeItems := exec.GetMerkleTree(chainID).Items()
+	newTreeItems, err := exec.GetMerkleTree(chainID).Items()
+	e.Nil(err)
 
 	e.Equal(oldTreeItems, newTreeItems)
 
@@ -441,6 +443,29 @@ func (e *ExecutorSuite) TestVerifyMessageMerkleProof() {
 	inTree3, err := exec.VerifyMessageMerkleProof(message3)
 	e.Nil(err)
 	e.True(inTree3)
+
+	// nothing is executed yet, so the state of every message is kept.
+	e.Nil(exec.PruneTree(e.GetTestContext(), chainID))
+	e.Equal(uint32(1), exec.GetMerkleTree(chainID).PrunedBefore())
+
+	for _, nonce := range nonces[:2] {
+		nonce := nonce
+		err = e.ExecutorTestDB.ExecuteMessage(e.GetTestContext(), execTypes.DBMessage{ChainID: &chainID, Destination: &destination, Nonce: &nonce})
+		e.Nil(err)
+	}
+
+	e.Nil(exec.PruneTree(e.GetTestContext(), chainID))
+	e.Equal(nonces[2], exec.GetMerkleTree(chainID).PrunedBefore())
+
+	inTree2, err = exec.VerifyMessageMerkleProof(message2)
+	e.Nil(err)
+	e.True(inTree2)
+
+	// the pruned tree is picked up from the db.
+	dbTree, err = executor.NewTreeFromDB(e.GetTestContext(), chainID, e.ExecutorTestDB)
+	e.Nil(err)
+	e.Equal(nonces[2], dbTree.PrunedBefore())
+	e.Equal(uint32(len(nonces)), dbTree.NumOfItems())
 }
 
 func (e *ExecutorSuite) TestExecutor() {
@@ -601,7 +626,7 @@ func (e *ExecutorSuite) TestExecutor() {
 	leaf, err := message.ToLeaf()
 	e.Nil(err)
 
-	tree.Insert(leaf[:])
+	e.Nil(tree.Insert(leaf[:]))
 
 	root, err := tree.Root(1)
 	e.Nil(err)
@@ -141,13 +141,17 @@ func (e Executor) processMessage(ctx context.Context, message types.Message, log
 	default:
 	}
 
-	e.chainExecutors[message.OriginDomain()].merkleTree.Insert(leaf[:])
-
+	// the message is stored first, so a tree that misses it is caught up from the db on restart.
 	err = e.executorDB.StoreMessage(ctx, message, logBlockNumber, false, 0)
 	if err != nil {
 		return fmt.Errorf("could not store message: %w", err)
 	}
 
+	err = e.chainExecutors[message.OriginDomain()].merkleTree.Insert(leaf[:])
+	if err != nil {
+		return fmt.Errorf("could not insert message into merkle tree: %w", err)
+	}
+
 	return nil
 }
 
@@ -65,6 +65,11 @@ func (e Executor) OverrideMerkleTree(chainID uint32, tree *merkle.HistoricalTree
 	e.chainExecutors[chainID].merkleTree = tree
 }
 
+// PruneTree prunes the merkle tree of the origin on chainID.
+func (e Executor) PruneTree(ctx context.Context, chainID uint32) error {
+	return e.pruneTree(ctx, chainID)
+}
+
 // CheckIfExecuted checks if a message has been executed.
 func (e Executor) CheckIfExecuted(ctx context.Context, message types.Message) (bool, error) {
 	return e.checkIfExecuted(ctx, message)
@@ -17,6 +17,7 @@
 	"github.com/synapsecns/sanguine/agents/agents/guard/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/guard/metadata"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	omnirpcClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	scribeAPI "github.com/synapsecns/sanguine/services/scribe/api"
@@ -27,6 +28,7 @@
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 
 	// used to embed markdown.
@@ -211,8 +213,13 @@ func createGuardParameters(ctx context.Context, c *cli.Context, metrics metrics.
 				return nil
 			})
 
+			health := ginhelper.NewHealthRegistry()
+			if gormStore, ok := guardDB.(interface{ DB() *gorm.DB }); ok {
+				health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+			}
+
 			g.Go(func() error {
-				err := api.Start(c.Context, uint16(c.Uint(metricsPortFlag.Name)))
+				err := api.Start(c.Context, uint16(c.Uint(metricsPortFlag.Name)), health)
 				if err != nil {
 					return fmt.Errorf("failed to start api: %w", err)
 				}
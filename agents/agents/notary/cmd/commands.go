@@ -7,10 +7,12 @@
 	"github.com/synapsecns/sanguine/agents/agents/notary/db/sql/sqlite"
 	"github.com/synapsecns/sanguine/agents/agents/notary/metadata"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	omnirpcClient "github.com/synapsecns/sanguine/services/omnirpc/client"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 	"os"
 	"sync/atomic"
@@ -141,8 +143,13 @@ func createNotaryParameters(ctx context.Context, c *cli.Context, metrics metrics
 				return nil
 			})
 
+			health := ginhelper.NewHealthRegistry()
+			if gormStore, ok := notaryDB.(interface{ DB() *gorm.DB }); ok {
+				health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+			}
+
 			g.Go(func() error {
-				err := api.Start(c.Context, uint16(c.Uint(metricsPortFlag.Name)))
+				err := api.Start(c.Context, uint16(c.Uint(metricsPortFlag.Name)), health)
 				if err != nil {
 					return fmt.Errorf("failed to start api: %w", err)
 				}
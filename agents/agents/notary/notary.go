@@ -44,6 +44,12 @@ type Notary struct {
 	handler                            metrics.Handler
 	retryConfig                        []retry.WithBackoffConfigurator
 	txSubmitter                        submitter.TransactionSubmitter
+
+	// myLatestNotaryAttestationStatus receives the status of the in-flight submission of myLatestNotaryAttestation.
+	// It is nil if there is no submission in flight.
+	myLatestNotaryAttestationStatus <-chan submitter.SubmissionStatus
+	// cancelMyLatestNotaryAttestationStatus stops the status subscription.
+	cancelMyLatestNotaryAttestationStatus context.CancelFunc
 }
 
 // NewNotary creates a new notary.
@@ -184,6 +190,7 @@ func (n *Notary) loadNotaryLatestAttestation(parentCtx context.Context) {
 			latestNotaryAttestation.Attestation().SnapshotRoot() != n.myLatestNotaryAttestation.Attestation().SnapshotRoot() {
 			n.myLatestNotaryAttestation = latestNotaryAttestation
 			n.didSubmitMyLatestNotaryAttestation = false
+			n.stopWatchingAttestationSubmission()
 		}
 	}
 }
@@ -557,6 +564,12 @@ func (n *Notary) submitMyLatestAttestation(parentCtx context.Context) {
 		return
 	}
 
+	// don't resubmit while the last submission is still pending.
+	if n.attestationSubmissionInFlight() {
+		span.AddEvent("attestation submission in flight")
+		return
+	}
+
 	attestationSignature, _, _, err := n.myLatestNotaryAttestation.Attestation().SignAttestation(ctx, n.bondedSigner, true)
 	if err != nil {
 		logger.Errorf("Error signing attestation: %v", err)
@@ -564,7 +577,9 @@ func (n *Notary) submitMyLatestAttestation(parentCtx context.Context) {
 			attribute.String("err", err.Error()),
 		))
 	} else {
-		_, err = n.txSubmitter.SubmitTransaction(ctx, big.NewInt(int64(n.destinationDomain.Config().DomainID)), func(transactor *bind.TransactOpts) (tx *ethTypes.Transaction, err error) {
+		var nonce uint64
+		chainID := big.NewInt(int64(n.destinationDomain.Config().DomainID))
+		nonce, err = n.txSubmitter.SubmitTransaction(ctx, chainID, func(transactor *bind.TransactOpts) (tx *ethTypes.Transaction, err error) {
 			tx, err = n.destinationDomain.LightInbox().SubmitAttestation(
 				transactor,
 				n.myLatestNotaryAttestation.AttPayload(),
@@ -582,8 +597,39 @@ func (n *Notary) submitMyLatestAttestation(parentCtx context.Context) {
 			span.AddEvent("Error submitting attestation", trace.WithAttributes(
 				attribute.String("err", err.Error()),
 			))
+			return
+		}
+
+		// use the parent context so the subscription outlives this span.
+		statusCtx, cancel := context.WithCancel(parentCtx)
+		n.myLatestNotaryAttestationStatus = n.txSubmitter.SubscribeStatus(statusCtx, chainID, nonce)
+		n.cancelMyLatestNotaryAttestationStatus = cancel
+	}
+}
+
+// attestationSubmissionInFlight drains the status updates of the latest attestation submission and returns true
+// until the submission is final. Whether the attestation was accepted is still checked on destination.
+func (n *Notary) attestationSubmissionInFlight() bool {
+	for n.myLatestNotaryAttestationStatus != nil {
+		select {
+		case _, ok := <-n.myLatestNotaryAttestationStatus:
+			if !ok {
+				n.stopWatchingAttestationSubmission()
+			}
+		default:
+			return true
 		}
 	}
+	return false
+}
+
+// stopWatchingAttestationSubmission stops tracking the in-flight attestation submission, if any.
+func (n *Notary) stopWatchingAttestationSubmission() {
+	if n.cancelMyLatestNotaryAttestationStatus != nil {
+		n.cancelMyLatestNotaryAttestationStatus()
+	}
+	n.myLatestNotaryAttestationStatus = nil
+	n.cancelMyLatestNotaryAttestationStatus = nil
 }
 
 // Start starts the notary.
@@ -3,7 +3,9 @@
 import (
 	"context"
 	"crypto/rand"
+	"encoding/json"
 	"math/big"
+	"os"
 	"testing"
 	"time"
 
@@ -12,11 +14,13 @@
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/agents/testutil"
 	"github.com/synapsecns/sanguine/agents/types"
 	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/merkle"
 	"github.com/synapsecns/sanguine/ethergo/backends/simulated"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
 	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
@@ -336,6 +340,98 @@ func TestEncodeSnapshotParity(t *testing.T) {
 	True(t, crypto.VerifySignature(crypto.FromECDSAPub(testWallet.PublicKey()), core.BytesToSlice(testSnapshotHash), encodedSignature[:crypto.RecoveryIDOffset]))
 }
 
+func TestSnapshotMultiProofParity(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	testBackend := simulated.NewSimulatedBackend(ctx, t)
+	deployManager := testutil.NewDeployManager(t)
+
+	_, snapshotContract := deployManager.GetSnapshotHarness(ctx, testBackend)
+
+	states := make([]types.State, 5)
+	var encodedStates [][]byte
+	for i := range states {
+		gasData := types.NewGasData(gofakeit.Uint16(), gofakeit.Uint16(), gofakeit.Uint16(), gofakeit.Uint16(), gofakeit.Uint16(), gofakeit.Uint16())
+		states[i] = types.NewState(common.BigToHash(big.NewInt(gofakeit.Int64())), gofakeit.Uint32(), gofakeit.Uint32(), randomUint40BigInt(t), randomUint40BigInt(t), gasData)
+		encodedState, err := states[i].Encode()
+		Nil(t, err)
+		encodedStates = append(encodedStates, encodedState)
+	}
+
+	snapshotPayload, err := snapshotContract.FormatSnapshot(&bind.CallOpts{Context: ctx}, encodedStates)
+	Nil(t, err)
+	contractRoot, err := snapshotContract.CalculateRoot(&bind.CallOpts{Context: ctx}, snapshotPayload)
+	Nil(t, err)
+
+	tree := merkle.NewTree(merkle.SnapshotTreeHeight)
+	var leftLeafs [][]byte
+	for _, state := range states {
+		leftLeaf, rightLeaf, err := state.SubLeaves()
+		Nil(t, err)
+		Nil(t, tree.Insert(leftLeaf[:]))
+		Nil(t, tree.Insert(rightLeaf[:]))
+		leftLeafs = append(leftLeafs, leftLeaf[:])
+	}
+	count := uint32(len(states) * 2)
+
+	// Prove all the states at once: this is what the executor needs for a whole snapshot.
+	indices := make([]uint32, len(states))
+	for i := range indices {
+		indices[i] = uint32(i * 2)
+	}
+	proof, err := tree.MultiProof(indices, count)
+	Nil(t, err)
+	True(t, merkle.VerifyMultiProof(contractRoot[:], leftLeafs, indices, count, proof, merkle.SnapshotTreeHeight))
+
+	// Prove a subset of the states.
+	subsetIndices := []uint32{indices[1], indices[4]}
+	subsetItems := [][]byte{leftLeafs[1], leftLeafs[4]}
+	subsetProof, err := tree.MultiProof(subsetIndices, count)
+	Nil(t, err)
+	True(t, merkle.VerifyMultiProof(contractRoot[:], subsetItems, subsetIndices, count, subsetProof, merkle.SnapshotTreeHeight))
+	False(t, merkle.VerifyMultiProof(contractRoot[:], [][]byte{leftLeafs[4], leftLeafs[1]}, subsetIndices, count, subsetProof, merkle.SnapshotTreeHeight))
+
+	// The proofs in the fixture are checked against MerkleMath.multiProofRoot on-chain by the contracts-core
+	// tests (test_multiProofRoot_goParity), so they must be exactly what MultiProof generates.
+	rawFixture, err := os.ReadFile(multiProofFixturePath)
+	Nil(t, err)
+	var fixture multiProofFixture
+	Nil(t, json.Unmarshal(rawFixture, &fixture))
+
+	fixtureTree := merkle.NewTree(fixture.Height)
+	for _, leaf := range fixture.Leafs {
+		Nil(t, fixtureTree.Insert(leaf))
+	}
+	fixtureRoot, err := fixtureTree.Root(fixture.Count)
+	Nil(t, err)
+	Equal(t, []byte(fixture.Root), fixtureRoot)
+
+	for _, fixtureCase := range fixture.Cases {
+		caseProof, err := fixtureTree.MultiProof(fixtureCase.Indices, fixture.Count)
+		Nil(t, err)
+		Len(t, caseProof, len(fixtureCase.Proof))
+		for i := range caseProof {
+			Equal(t, []byte(fixtureCase.Proof[i]), caseProof[i])
+		}
+	}
+}
+
+// multiProofFixturePath is the multi-leaf proof fixture shared with the contracts-core merkle tests.
+const multiProofFixturePath = "../../packages/contracts-core/test/fixtures/multiproof.json"
+
+// multiProofFixture is a tree and multi-leaf proofs generated by MultiProof.
+type multiProofFixture struct {
+	Height uint32          `json:"height"`
+	Count  uint32          `json:"count"`
+	Root   hexutil.Bytes   `json:"root"`
+	Leafs  []hexutil.Bytes `json:"leafs"`
+	Cases  []struct {
+		Indices []uint32        `json:"indices"`
+		Proof   []hexutil.Bytes `json:"proof"`
+	} `json:"cases"`
+}
+
 /*
 	func VerifySignature(pubkey, digestHash, signature []byte) bool {
 		return secp256k1.VerifySignature(pubkey, digestHash, signature)
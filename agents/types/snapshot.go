@@ -46,8 +46,12 @@ func (s snapshot) SnapshotRootAndProofs() ([32]byte, [][][]byte, error) {
 			return [32]byte{}, nil, fmt.Errorf("failed to hash state: %w", err)
 		}
 
-		tree.Insert(leftLeaf[:])
-		tree.Insert(rightLeaf[:])
+		if err := tree.Insert(leftLeaf[:]); err != nil {
+			return [32]byte{}, nil, fmt.Errorf("failed to insert left leaf: %w", err)
+		}
+		if err := tree.Insert(rightLeaf[:]); err != nil {
+			return [32]byte{}, nil, fmt.Errorf("failed to insert right leaf: %w", err)
+		}
 	}
 
 	snapshotRoot, err := tree.Root(uint32(len(s.states) * 2))
@@ -63,6 +63,23 @@ func (s Store) UpdateSignRequestStatus(ctx context.Context, txid common.Hash, st
 	return nil
 }
 
+// DeleteSignRequest deletes a sign request if its status is one of matchStatuses, returning false if it wasn't deleted.
+func (s Store) DeleteSignRequest(ctx context.Context, txid common.Hash, matchStatuses ...db.SynapseRequestStatus) (bool, error) {
+	inArgs := make([]int, len(matchStatuses))
+	for i := range matchStatuses {
+		inArgs[i] = int(matchStatuses[i].Int())
+	}
+
+	tx := s.DB().WithContext(ctx).
+		Where(fmt.Sprintf("%s = ?", transactionIDFieldName), common.Bytes2Hex(txid[:])).
+		Where(fmt.Sprintf("%s IN ?", statusFieldName), inArgs).
+		Delete(&VerificationRequest{})
+	if tx.Error != nil {
+		return false, fmt.Errorf("could not delete sign request: %w", tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
 // StoreInterchainTransactionReceived stores an interchain transaction received.
 func (s Store) StoreInterchainTransactionReceived(ctx context.Context, originChainID int, sr synapsemodule.SynapseModuleBatchVerificationRequested) error {
 	signRequest := toSignRequest(originChainID, sr)
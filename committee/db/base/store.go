@@ -5,6 +5,7 @@
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ipfs/go-datastore"
 	sqlds "github.com/ipfs/go-ds-sql"
+	"github.com/ipfs/go-ds-sql/postgres"
 	"github.com/ipfs/go-ds-sql/sqlite"
 	"github.com/synapsecns/sanguine/committee/db"
 	"github.com/synapsecns/sanguine/committee/db/mysql/util"
@@ -18,7 +19,7 @@
 
 // Store implements the service.
 type Store struct {
-	listenerDB.ChainListenerDB
+	listenerDB.Service
 	db             *gorm.DB
 	submitterStore submitterDB.Service
 }
@@ -28,9 +29,9 @@ func NewStore(db *gorm.DB, metrics metrics.Handler) *Store {
 	txDB := txdb.NewTXStore(db, metrics)
 
 	return &Store{
-		ChainListenerDB: listenerDB.NewChainListenerStore(db, metrics),
-		db:              db,
-		submitterStore:  txDB,
+		Service:        listenerDB.NewChainListenerStore(db, metrics),
+		db:             db,
+		submitterStore: txDB,
 	}
 }
 
@@ -86,6 +87,19 @@ func (s Store) makeDatastore(name string) (datastore.Batching, error) {
 		}
 
 		return sqlds.NewDatastore(underlyingDB, util.NewQueries(name)), nil
+	case dbcommon.Postgres.String():
+		name = util.NamingStrategy.TableName(name)
+
+		if _, err := underlyingDB.Exec(fmt.Sprintf(`
+			CREATE TABLE IF NOT EXISTS %s (
+				key TEXT PRIMARY KEY,
+				data BYTEA
+			);
+		`, name)); err != nil {
+			return nil, fmt.Errorf("could not ensure table exists: %w", err)
+		}
+
+		return sqlds.NewDatastore(underlyingDB, postgres.NewQueries(name)), nil
 	default:
 		panic("unsupported database")
 	}
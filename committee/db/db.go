@@ -9,6 +9,7 @@
 	"github.com/ipfs/go-datastore"
 	"github.com/synapsecns/sanguine/committee/contracts/synapsemodule"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	listenerDB "github.com/synapsecns/sanguine/ethergo/listener/db"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"math/big"
 )
@@ -26,6 +27,8 @@ type Reader interface {
 type Writer interface {
 	LatestBlockForChain(ctx context.Context, chainID uint64) (uint64, error)
 	UpdateSignRequestStatus(ctx context.Context, txid common.Hash, status SynapseRequestStatus) error
+	// DeleteSignRequest deletes a sign request if its status is one of matchStatuses, returning false if it wasn't deleted.
+	DeleteSignRequest(ctx context.Context, txid common.Hash, matchStatuses ...SynapseRequestStatus) (bool, error)
 	StoreInterchainTransactionReceived(ctx context.Context, originChainID int, sr synapsemodule.SynapseModuleBatchVerificationRequested) error
 }
 
@@ -40,6 +43,8 @@ type Service interface {
 	Reader
 	Writer
 	Datstores
+	// ReorgDB stores the blocks processed by the reorg aware chain listeners.
+	listenerDB.ReorgDB
 	// SubmitterDB returns the submitter database service.
 	SubmitterDB() submitterDB.Service
 }
@@ -0,0 +1,66 @@
+// Package postgres provides a common interface for starting postgres databases
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/committee/db/base"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"time"
+)
+
+var logger = log.Logger("postgres-logger")
+
+// Store is the postgres store. It extends the base store for postgres specific queries.
+type Store struct {
+	*base.Store
+}
+
+// NamingStrategy is the naming strategy for the gorm db. It's exported here for testing.
+var NamingStrategy = schema.NamingStrategy{}
+
+// SetNamingStrategy sets the naming strategy for the gorm db.
+func SetNamingStrategy(ns schema.NamingStrategy) {
+	NamingStrategy = ns
+}
+
+// MaxIdleConns is exported here for testing. Tests execute too slowly with a reconnect each time.
+var MaxIdleConns = 0
+
+// NewPostgresStore creates a new postgres store for a given data store.
+func NewPostgresStore(ctx context.Context, dbURL string, handler metrics.Handler) (*Store, error) {
+	logger.Debug("create postgres store")
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:               dbcommon.GetGormLogger(logger),
+		FullSaveAssociations: true,
+		NamingStrategy:       NamingStrategy,
+		NowFunc:              time.Now,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sql db: %w", err)
+	}
+
+	sqlDB.SetMaxIdleConns(MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(time.Hour)
+
+	handler.AddGormCallbacks(gdb)
+
+	err = gdb.WithContext(ctx).AutoMigrate(base.GetAllModels()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+	}
+
+	return &Store{base.NewStore(gdb, handler)}, nil
+}
@@ -0,0 +1,44 @@
+package db_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/committee/contracts/synapsemodule"
+	"github.com/synapsecns/sanguine/committee/db"
+)
+
+func (d *DBSuite) TestDeleteSignRequest() {
+	d.RunOnAllDBs(func(testDB db.Service) {
+		request := synapsemodule.SynapseModuleBatchVerificationRequested{
+			DstChainId:         2,
+			Batch:              []byte{1, 2, 3},
+			EthSignedBatchHash: common.HexToHash("0x1"),
+		}
+		err := testDB.StoreInterchainTransactionReceived(d.GetTestContext(), 1, request)
+		d.Require().NoError(err)
+
+		err = testDB.UpdateSignRequestStatus(d.GetTestContext(), request.EthSignedBatchHash, db.Broadcast)
+		d.Require().NoError(err)
+
+		// a request that's been submitted isn't deleted.
+		deleted, err := testDB.DeleteSignRequest(d.GetTestContext(), request.EthSignedBatchHash, db.Seen, db.Signed)
+		d.Require().NoError(err)
+		d.False(deleted)
+
+		err = testDB.UpdateSignRequestStatus(d.GetTestContext(), request.EthSignedBatchHash, db.Signed)
+		d.Require().NoError(err)
+		deleted, err = testDB.DeleteSignRequest(d.GetTestContext(), request.EthSignedBatchHash, db.Seen, db.Signed)
+		d.Require().NoError(err)
+		d.True(deleted)
+
+		requests, err := testDB.GetQuoteResultsByStatus(d.GetTestContext(), db.Seen, db.Signed, db.Broadcast)
+		d.Require().NoError(err)
+		d.Empty(requests)
+
+		// the request is stored again if it's mined again.
+		err = testDB.StoreInterchainTransactionReceived(d.GetTestContext(), 1, request)
+		d.Require().NoError(err)
+		requests, err = testDB.GetQuoteResultsByStatus(d.GetTestContext(), db.Seen)
+		d.Require().NoError(err)
+		d.Len(requests, 1)
+	})
+}
@@ -9,6 +9,7 @@
 	"github.com/synapsecns/sanguine/committee/db"
 	"github.com/synapsecns/sanguine/committee/db/connect"
 	"github.com/synapsecns/sanguine/committee/db/mysql"
+	"github.com/synapsecns/sanguine/committee/db/postgres"
 	"github.com/synapsecns/sanguine/committee/metadata"
 	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
@@ -52,6 +53,7 @@ func (d *DBSuite) SetupTest() {
 
 	d.dbs[dbcommon.Sqlite] = sqliteStore
 	d.setupMysqlDB()
+	d.setupPostgresDB()
 
 	// make datastores
 	for name, testDB := range d.dbs {
@@ -87,6 +89,21 @@ func (d *DBSuite) setupMysqlDB() {
 	d.dbs[dbcommon.Mysql] = mysqlStore
 }
 
+func (d *DBSuite) setupPostgresDB() {
+	if os.Getenv(dbcommon.EnablePostgresTestVar) != "true" {
+		return
+	}
+
+	postgres.SetNamingStrategy(schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("committee_%d", d.GetTestID()),
+	})
+
+	postgresStore, err := postgres.NewPostgresStore(d.GetTestContext(), dbcommon.GetTestPostgresConnString(), d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs[dbcommon.Postgres] = postgresStore
+}
+
 func (d *DBSuite) RunOnAllDBs(testFunc func(testDB db.Service)) {
 	runOnAll[db.Service](d, d.dbs, testFunc)
 }
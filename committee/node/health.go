@@ -0,0 +1,69 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synapsecns/sanguine/core/ginhelper"
+	baseServer "github.com/synapsecns/sanguine/core/server"
+	"gorm.io/gorm"
+)
+
+// maxListenerLag is the number of blocks a chain listener can fall behind the rpc before the node is not ready.
+const maxListenerLag = 100
+
+// healthRegistry creates the health registry for the node.
+func (n *Node) healthRegistry() *ginhelper.HealthRegistry {
+	health := ginhelper.NewHealthRegistry()
+	if gormStore, ok := n.db.(interface{ DB() *gorm.DB }); ok {
+		health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+	}
+
+	for chainID := range n.chainListeners {
+		chainID := chainID
+		health.Register(fmt.Sprintf("chain_listener_lag_%d", chainID), ginhelper.ThresholdCheck("chain listener lag", func(ctx context.Context) (uint64, error) {
+			chainClient, err := n.client.GetChainClient(ctx, chainID)
+			if err != nil {
+				return 0, fmt.Errorf("could not get chain client: %w", err)
+			}
+			latestBlock, err := chainClient.BlockNumber(ctx)
+			if err != nil {
+				return 0, fmt.Errorf("could not get latest block: %w", err)
+			}
+			lastIndexed, err := n.db.LatestBlockForChain(ctx, uint64(chainID))
+			if err != nil {
+				return 0, fmt.Errorf("could not get last indexed block: %w", err)
+			}
+			if lastIndexed > latestBlock {
+				return 0, nil
+			}
+			return latestBlock - lastIndexed, nil
+		}, maxListenerLag))
+	}
+
+	health.Register("p2p_peers", func(ctx context.Context) error {
+		if len(n.peerManager.Host().Network().Peers()) == 0 {
+			return fmt.Errorf("no connected peers")
+		}
+		return nil
+	})
+
+	return health
+}
+
+// startHealthServer serves the health endpoints on the configured health port until the context is canceled.
+func (n *Node) startHealthServer(ctx context.Context) error {
+	if n.cfg.HealthPort == 0 {
+		return nil
+	}
+
+	engine := ginhelper.New(logger)
+	n.healthRegistry().Mount(engine)
+
+	connection := baseServer.Server{}
+	err := connection.ListenAndServe(ctx, fmt.Sprintf(":%d", n.cfg.HealthPort), engine)
+	if err != nil {
+		return fmt.Errorf("could not start health server: %w", err)
+	}
+	return nil
+}
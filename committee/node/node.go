@@ -80,7 +80,13 @@ func NewNode(ctx context.Context, handler metrics.Handler, cfg config.Config) (*
 			return nil, fmt.Errorf("could not get block number: %w", err)
 		}
 
-		chainListener, err := listener.NewChainListener(chainClient, node.db, synapseModule, latestBlock, handler)
+		parser, err := synapsemodule.NewParser(synapseModule)
+		if err != nil {
+			return nil, fmt.Errorf("could not get parser: %w", err)
+		}
+		// requests are signed as soon as they're seen, so forget the ones that are reorged out.
+		chainListener, err := listener.NewChainListener(chainClient, node.db, synapseModule, latestBlock, handler,
+			listener.WithRemovedLogHandler(node.removedLogHandler(parser)))
 		if err != nil {
 			return nil, fmt.Errorf("could not get chain listener: %w", err)
 		}
@@ -183,6 +189,10 @@ func (n *Node) Start(parentContext context.Context) error {
 		return fmt.Errorf("could not start p2p: %w", err)
 	}
 
+	g.Go(func() error {
+		return n.startHealthServer(ctx)
+	})
+
 	g.Go(func() error {
 		if n.cfg.ShouldRelay {
 			// nolint: errcheck, wrapcheck
@@ -444,6 +454,32 @@ func (n *Node) runChainIndexer(parentCtx context.Context, chainID int) (err erro
 	return nil
 }
 
+// removedLogHandler forgets the verification requests that were removed by a reorg before they were submitted. If a
+// removed request is mined again, it's stored again when its log is delivered.
+func (n *Node) removedLogHandler(parser synapsemodule.Parser) listener.HandleRemovedLog {
+	return func(ctx context.Context, log types.Log) error {
+		_, parsedEvent, ok := parser.ParseEvent(log)
+		if !ok {
+			return nil
+		}
+
+		// verified events only mark requests completed, they're marked again once the verification is mined again.
+		event, ok := parsedEvent.(*synapsemodule.SynapseModuleBatchVerificationRequested)
+		if !ok {
+			return nil
+		}
+
+		deleted, err := n.db.DeleteSignRequest(ctx, event.EthSignedBatchHash, db.Seen, db.Signed)
+		if err != nil {
+			return fmt.Errorf("could not delete removed request: %w", err)
+		}
+		if !deleted {
+			logger.Warnf("request %s was removed by a reorg after it was submitted", common.Hash(event.EthSignedBatchHash))
+		}
+		return nil
+	}
+}
+
 func (n *Node) handleMessageSent(ctx context.Context, chainID int, event *synapsemodule.SynapseModuleBatchVerificationRequested) error {
 	err := n.db.StoreInterchainTransactionReceived(ctx, chainID, *event)
 	if err != nil {
@@ -0,0 +1,116 @@
+package commandline
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	setCommand     = "set"
+	unsetCommand   = "unset"
+	aliasCommand   = "alias"
+	unaliasCommand = "unalias"
+	// commentPrefix marks a line as a comment. These are skipped, which is useful for annotating scripts.
+	commentPrefix = "#"
+)
+
+// shellState holds the variables and aliases defined in a shell session.
+type shellState struct {
+	// vars are variables defined with set. These are substituted for $VAR or ${VAR}.
+	vars map[string]string
+	// aliases are aliases defined with alias. These replace the first word of a line.
+	aliases map[string]string
+}
+
+func newShellState() *shellState {
+	return &shellState{
+		vars:    make(map[string]string),
+		aliases: make(map[string]string),
+	}
+}
+
+// expand substitutes variables and aliases in the line.
+// Variables that are not set fall back to the environment. Aliases are expanded once, so they cannot recurse.
+func (s *shellState) expand(line string) string {
+	line = os.Expand(line, func(name string) string {
+		if value, ok := s.vars[name]; ok {
+			return value
+		}
+		return os.Getenv(name)
+	})
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return line
+	}
+	if alias, ok := s.aliases[fields[0]]; ok {
+		return strings.TrimSpace(strings.Join(append([]string{alias}, fields[1:]...), " "))
+	}
+	return line
+}
+
+// runBuiltin runs the line if it is a builtin. handled is false if the line is not a builtin.
+func (s *shellState) runBuiltin(line string) (handled bool, err error) {
+	command, args, _ := strings.Cut(strings.TrimSpace(line), " ")
+	args = strings.TrimSpace(args)
+
+	switch command {
+	case setCommand:
+		if args == "" {
+			printSorted(s.vars)
+			return true, nil
+		}
+		name, value, err := parseAssignment(args)
+		if err != nil {
+			return true, fmt.Errorf("could not set variable: %w", err)
+		}
+		s.vars[name] = value
+	case unsetCommand:
+		delete(s.vars, args)
+	case aliasCommand:
+		if args == "" {
+			printSorted(s.aliases)
+			return true, nil
+		}
+		name, value, err := parseAssignment(args)
+		if err != nil {
+			return true, fmt.Errorf("could not set alias: %w", err)
+		}
+		s.aliases[name] = value
+	case unaliasCommand:
+		delete(s.aliases, args)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// parseAssignment parses a NAME=value assignment. Surrounding quotes are stripped from the value.
+func parseAssignment(assignment string) (name, value string, err error) {
+	name, value, ok := strings.Cut(assignment, "=")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" || strings.ContainsAny(name, " \t$") {
+		return "", "", fmt.Errorf("expected NAME=value, got %q", assignment)
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+	return name, value, nil
+}
+
+// printSorted prints a map as NAME=value lines in name order.
+func printSorted(values map[string]string) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Printf("%s=%s\n", name, values[name])
+	}
+}
@@ -0,0 +1,15 @@
+package commandline
+
+// ShellHistory exports shellHistory for testing.
+type ShellHistory interface {
+	Add(line string) error
+	Lines() []string
+}
+
+// NewShellHistory exports newShellHistory for testing.
+func NewShellHistory(path string) (ShellHistory, error) {
+	return newShellHistory(path)
+}
+
+// MaxHistoryLines exports maxHistoryLines for testing.
+const MaxHistoryLines = maxHistoryLines
@@ -0,0 +1,100 @@
+package commandline
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/synapsecns/sanguine/core/config"
+)
+
+// maxHistoryLines is the maximum number of lines kept in the history file.
+const maxHistoryLines = 1000
+
+// shellHistory persists the commands entered into the interactive shell so they are available across sessions.
+type shellHistory struct {
+	// path is the path to the history file. If empty, history is only kept in memory.
+	path string
+	// lines are the lines in the history, oldest first.
+	lines []string
+}
+
+// historyPath gets the path of the history file for the app in the config dir.
+func historyPath(appName string) (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not get config dir: %w", err)
+	}
+	return filepath.Join(configDir, fmt.Sprintf("%s_shell_history", appName)), nil
+}
+
+// newShellHistory loads the history from path. If the file is larger than maxHistoryLines, it is truncated.
+func newShellHistory(path string) (*shellHistory, error) {
+	history := &shellHistory{path: path}
+
+	//nolint: gosec
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open history file: %w", err)
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			history.lines = append(history.lines, line)
+		}
+	}
+	_ = file.Close()
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read history file: %w", err)
+	}
+
+	if len(history.lines) > maxHistoryLines {
+		history.lines = history.lines[len(history.lines)-maxHistoryLines:]
+		err = os.WriteFile(path, []byte(strings.Join(history.lines, "\n")+"\n"), 0600)
+		if err != nil {
+			return nil, fmt.Errorf("could not truncate history file: %w", err)
+		}
+	}
+
+	return history, nil
+}
+
+// Add adds a line to the history and appends it to the history file.
+func (s *shellHistory) Add(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || (len(s.lines) > 0 && s.lines[len(s.lines)-1] == line) {
+		return nil
+	}
+	s.lines = append(s.lines, line)
+
+	if s.path == "" {
+		return nil
+	}
+
+	//nolint: gosec
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open history file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = file.WriteString(line + "\n")
+	if err != nil {
+		return fmt.Errorf("could not write history file: %w", err)
+	}
+	return nil
+}
+
+// Lines returns the lines in the history, oldest first.
+func (s *shellHistory) Lines() []string {
+	return s.lines
+}
@@ -0,0 +1,43 @@
+package commandline_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/commandline"
+)
+
+func TestShellHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+
+	history, err := commandline.NewShellHistory(path)
+	Nil(t, err)
+	Empty(t, history.Lines())
+
+	Nil(t, history.Add("echo one"))
+	// duplicates of the last line and empty lines are skipped
+	Nil(t, history.Add("echo one"))
+	Nil(t, history.Add("  "))
+	Nil(t, history.Add("echo two"))
+
+	reloaded, err := commandline.NewShellHistory(path)
+	Nil(t, err)
+	Equal(t, []string{"echo one", "echo two"}, reloaded.Lines())
+}
+
+func TestShellHistoryTruncates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+
+	history, err := commandline.NewShellHistory(path)
+	Nil(t, err)
+	for i := 0; i < commandline.MaxHistoryLines+10; i++ {
+		Nil(t, history.Add(fmt.Sprintf("echo %d", i)))
+	}
+
+	reloaded, err := commandline.NewShellHistory(path)
+	Nil(t, err)
+	Len(t, reloaded.Lines(), commandline.MaxHistoryLines)
+	Equal(t, "echo 10", reloaded.Lines()[0])
+}
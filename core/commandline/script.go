@@ -0,0 +1,72 @@
+package commandline
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+// stdinScript is the script path used to read commands from stdin.
+const stdinScript = "-"
+
+var scriptFlag = cli.StringFlag{
+	Name:  "script",
+	Usage: fmt.Sprintf("run the commands in a file (or stdin if %q) instead of starting an interactive shell", stdinScript),
+}
+
+var continueOnErrorFlag = cli.BoolFlag{
+	Name:  "continue-on-error",
+	Usage: "keep running a script after a command fails. The script still exits with an error if any command failed",
+}
+
+// runScriptFile runs the commands in the file at path, or stdin if path is stdinScript.
+func (i *interactiveClient) runScriptFile(path string, continueOnError bool) error {
+	if path == stdinScript {
+		return i.runScript(os.Stdin, continueOnError)
+	}
+
+	//nolint: gosec
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open script: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return i.runScript(file, continueOnError)
+}
+
+// runScript runs each line of the script as a shell command.
+// It stops on the first failed command unless continueOnError is set, and stops without error on an exit command.
+func (i *interactiveClient) runScript(script io.Reader, continueOnError bool) error {
+	failed := 0
+
+	scanner := bufio.NewScanner(script)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		shouldExit, err := i.execute(scanner.Text())
+		if shouldExit {
+			break
+		}
+		if err == nil {
+			continue
+		}
+
+		if !continueOnError {
+			return fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		fmt.Printf("line %d: error: %v\n", lineNumber, err)
+		failed++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read script: %w", err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d commands failed", failed)
+	}
+	return nil
+}
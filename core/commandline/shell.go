@@ -32,6 +32,8 @@ func GenerateShellCommand(shellCommands []*cli.Command) *cli.Command {
 		Usage: "start an interactive shell.",
 		Flags: []cli.Flag{
 			&LogLevel,
+			&scriptFlag,
+			&continueOnErrorFlag,
 		},
 		Action: func(c *cli.Context) (err error) {
 			SetLogLevel(c)
@@ -39,10 +41,16 @@ func GenerateShellCommand(shellCommands []*cli.Command) *cli.Command {
 			console := cli.NewApp()
 			console.Commands = capturedCommands
 			console.Action = func(c *cli.Context) error {
-				fmt.Printf("Command not found. Type 'help' for a list of commands or \"%s\", \"%s\" or \"%s\" to exit.\n", quitCommand, exitCommand, quitCommandShort)
+				fmt.Println(commandNotFoundMessage())
 				return nil
 			}
 
+			interactive := newInteractiveClient(c.Context, capturedCommands, console)
+
+			if c.IsSet(scriptFlag.Name) {
+				return interactive.runScriptFile(c.String(scriptFlag.Name), c.Bool(continueOnErrorFlag.Name))
+			}
+
 			if c.Args().Len() == 0 {
 				err := console.RunContext(c.Context, strings.Fields("cmd help"))
 				if err != nil {
@@ -69,7 +77,7 @@ func GenerateShellCommand(shellCommands []*cli.Command) *cli.Command {
 				return nil
 			}
 
-			interactive := newInteractiveClient(c.Context, capturedCommands, console)
+			interactive.history = loadShellHistory(c.App.Name)
 			for {
 				p := prompt.New(
 					interactive.executor,
@@ -77,6 +85,7 @@ func GenerateShellCommand(shellCommands []*cli.Command) *cli.Command {
 					prompt.OptionCompletionWordSeparator(completer.FilePathCompletionSeparator),
 					prompt.OptionMaxSuggestion(3),
 					prompt.OptionLivePrefix(livePrefix),
+					prompt.OptionHistory(interactive.history.Lines()),
 				)
 				p.Run()
 			}
@@ -127,6 +136,10 @@ type interactiveClient struct {
 	ctx context.Context
 	// shellCommands are all shell commands supported by the interactive client
 	shellCommands []*cli.Command
+	// state holds the variables and aliases of the session
+	state *shellState
+	// history is the persistent shell history. This is only set in interactive mode.
+	history *shellHistory
 }
 
 // newInteractiveClient creates a new interactive client.
@@ -135,7 +148,25 @@ func newInteractiveClient(ctx context.Context, shellCommands []*cli.Command, app
 		app:           app,
 		shellCommands: shellCommands,
 		ctx:           ctx,
+		state:         newShellState(),
+	}
+}
+
+// loadShellHistory loads the shell history for the app from the config dir.
+// If the history can't be loaded, history is kept in memory for the session only.
+func loadShellHistory(appName string) *shellHistory {
+	path, err := historyPath(appName)
+	if err != nil {
+		logger.Warnf("could not get history path, history will not be saved: %v", err)
+		return &shellHistory{}
+	}
+
+	history, err := newShellHistory(path)
+	if err != nil {
+		logger.Warnf("could not load history, history will not be saved: %v", err)
+		return &shellHistory{}
 	}
+	return history
 }
 
 // completor handles autocompletion for the interactive client.
@@ -151,6 +182,10 @@ func (i *interactiveClient) completor(in prompt.Document) []prompt.Suggest {
 	// flagPrompts promp flags for each command
 	var flagPrompts []prompt.Suggest
 
+	for _, builtin := range builtinSuggestions {
+		commandPrompts = append(commandPrompts, builtin)
+	}
+
 	for _, command := range i.shellCommands {
 		commandPrompts = append(commandPrompts, prompt.Suggest{
 			Text:        command.Name,
@@ -203,27 +238,84 @@ func longestFlag(flag cli.Flag) (flagName string) {
 
 // executor handles executing interactive commands.
 func (i *interactiveClient) executor(line string) {
-	if line == "" {
-		return
+	if i.history != nil {
+		err := i.history.Add(line)
+		if err != nil {
+			logger.Warnf("could not save history: %v", err)
+		}
 	}
 
-	if line == quitCommand || line == quitCommandShort || line == exitCommand {
+	shouldExit, err := i.execute(line)
+	if shouldExit {
 		os.Exit(0)
 	}
 
-	if line == "shell" {
-		fmt.Println("cannot start a shell from within a shell!")
-		return
+	if errors.Is(err, errCommandNotFound) {
+		fmt.Println(commandNotFoundMessage())
+	} else if err != nil {
+		fmt.Println("error: ", err)
 	}
+}
 
-	err := i.app.RunContext(i.ctx, strings.Fields("cmd "+line))
+// errCommandNotFound is returned when a line does not match a command.
+var errCommandNotFound = errors.New("command not found")
+
+// execute runs a single line after substituting variables and aliases. shouldExit is true if the line is an exit command.
+func (i *interactiveClient) execute(line string) (shouldExit bool, err error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, commentPrefix) {
+		return false, nil
+	}
+
+	line = i.state.expand(line)
+	// a line that was only variables can expand to nothing
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false, nil
+	}
+
+	if line == quitCommand || line == quitCommandShort || line == exitCommand {
+		return true, nil
+	}
+
+	if line == shellCommandName {
+		return false, errors.New("cannot start a shell from within a shell")
+	}
+
+	handled, err := i.state.runBuiltin(line)
+	if handled {
+		return false, err
+	}
+
+	name := fields[0]
+	if name != helpCommand && name != helpCommandShort && i.app.Command(name) == nil {
+		return false, fmt.Errorf("%w: %s", errCommandNotFound, name)
+	}
+
+	err = i.app.RunContext(i.ctx, append([]string{"cmd"}, fields...))
 	if err != nil {
-		fmt.Println("error: ", err)
+		return false, fmt.Errorf("could not run %s: %w", name, err)
 	}
+	return false, nil
+}
+
+// commandNotFoundMessage is the message shown when a command is not found in the interactive shell.
+func commandNotFoundMessage() string {
+	return fmt.Sprintf("Command not found. Type 'help' for a list of commands or \"%s\", \"%s\" or \"%s\" to exit.", quitCommand, exitCommand, quitCommandShort)
 }
 
 const (
 	quitCommand      = "quit"
 	quitCommandShort = "q"
 	exitCommand      = "exit"
+	helpCommand      = "help"
+	helpCommandShort = "h"
 )
+
+// builtinSuggestions are the autocomplete suggestions for the shell builtins.
+var builtinSuggestions = []prompt.Suggest{
+	{Text: setCommand, Description: "Sets a variable with set NAME=value, substituted for $NAME. Lists variables if no argument is given"},
+	{Text: unsetCommand, Description: "Removes a variable"},
+	{Text: aliasCommand, Description: "Sets an alias with alias NAME=command. Lists aliases if no argument is given"},
+	{Text: unaliasCommand, Description: "Removes an alias"},
+}
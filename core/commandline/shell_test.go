@@ -0,0 +1,90 @@
+package commandline_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/commandline"
+	"github.com/urfave/cli/v2"
+)
+
+// newScriptApp creates an app with an echo command that records its args and a fail command that always errors.
+func newScriptApp(calls *[]string) *cli.App {
+	commands := []*cli.Command{
+		{
+			Name: "echo",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "prefix"},
+			},
+			Action: func(c *cli.Context) error {
+				*calls = append(*calls, strings.TrimSpace(c.String("prefix")+" "+strings.Join(c.Args().Slice(), " ")))
+				return nil
+			},
+		},
+		{
+			Name: "fail",
+			Action: func(c *cli.Context) error {
+				return errors.New("failed")
+			},
+		},
+	}
+
+	app := cli.NewApp()
+	app.Commands = append(commands, commandline.GenerateShellCommand(commands))
+	return app
+}
+
+func writeScript(t *testing.T, lines ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "script")
+	err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600)
+	Nil(t, err)
+	return path
+}
+
+func TestShellScript(t *testing.T) {
+	var calls []string
+	app := newScriptApp(&calls)
+
+	script := writeScript(t,
+		"# variables and aliases",
+		"set NAME=world",
+		"set GREETING=\"hello there\"",
+		"alias greet=echo --prefix hi",
+		"echo $NAME",
+		"echo ${GREETING}",
+		"greet $NAME",
+		"unalias greet",
+		"unset NAME",
+		"",
+		"$EMPTY",
+		"${NAME}   ",
+		"echo done $NAME",
+		"exit",
+		"echo unreachable",
+	)
+
+	err := app.Run([]string{"app", "shell", "--script", script})
+	Nil(t, err)
+	Equal(t, []string{"world", "hello there", "hi world", "done"}, calls)
+}
+
+func TestShellScriptStopsOnError(t *testing.T) {
+	var calls []string
+	app := newScriptApp(&calls)
+
+	script := writeScript(t, "echo one", "notacommand", "fail", "echo two")
+
+	err := app.Run([]string{"app", "shell", "--script", script})
+	ErrorContains(t, err, "line 2")
+	Equal(t, []string{"one"}, calls)
+
+	calls = nil
+	err = app.Run([]string{"app", "shell", "--script", script, "--continue-on-error"})
+	ErrorContains(t, err, "2 commands failed")
+	Equal(t, []string{"one", "two"}, calls)
+}
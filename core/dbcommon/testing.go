@@ -21,9 +21,30 @@
 	MysqlPortVar = "MYSQL_PORT"
 )
 
+const (
+	// EnablePostgresTestVar is the environment variable to enable postgres tests.
+	EnablePostgresTestVar = "ENABLE_POSTGRES_TEST"
+	// PostgresDatabaseVar is the environment variable for the postgres database name.
+	PostgresDatabaseVar = "POSTGRES_DB"
+	// PostgresUserVar is the environment variable for the postgres user.
+	PostgresUserVar = "POSTGRES_USER"
+	// PostgresPasswordVar is the environment variable for the postgres password.
+	PostgresPasswordVar = "POSTGRES_PASSWORD"
+	// PostgresHostVar is the environment variable for the postgres host.
+	PostgresHostVar = "POSTGRES_HOST"
+	// PostgresPortVar is the environment variable for the postgres port.
+	PostgresPortVar = "POSTGRES_PORT"
+)
+
 // GetTestConnString returns the connection string for the mysql test database.
 // this is derived from environment variables.
 // TODO: test this in ci.
 func GetTestConnString() string {
 	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", core.GetEnv(MysqlUserVar, "root"), os.Getenv(MysqlPasswordVar), core.GetEnv(MysqlHostVar, "127.0.0.1"), core.GetEnvInt(MysqlPortVar, 3306), os.Getenv(MysqlDatabaseVar))
 }
+
+// GetTestPostgresConnString returns the connection string for the postgres test database.
+// this is derived from environment variables.
+func GetTestPostgresConnString() string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable", core.GetEnv(PostgresHostVar, "127.0.0.1"), core.GetEnv(PostgresUserVar, "postgres"), os.Getenv(PostgresPasswordVar), core.GetEnv(PostgresDatabaseVar, "postgres"), core.GetEnvInt(PostgresPortVar, 5432))
+}
@@ -0,0 +1,21 @@
+package dbcommon_test
+
+import (
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+)
+
+func (s DbSuite) TestDBTypeFromString() {
+	for _, dbType := range dbcommon.AllDBTypes {
+		parsed, err := dbcommon.DBTypeFromString(dbType.String())
+		Nil(s.T(), err)
+		Equal(s.T(), dbType, parsed)
+	}
+
+	parsed, err := dbcommon.DBTypeFromString("POSTGRES")
+	Nil(s.T(), err)
+	Equal(s.T(), dbcommon.Postgres, parsed)
+
+	_, err = dbcommon.DBTypeFromString("oracle")
+	NotNil(s.T(), err)
+}
@@ -0,0 +1,298 @@
+package ginhelper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// HealthzEndpoint reports the result of every registered check.
+	HealthzEndpoint = "/healthz"
+	// ReadyzEndpoint reports whether the service is ready to serve traffic.
+	ReadyzEndpoint = "/readyz"
+	// LivezEndpoint reports whether the service is alive. Failing it should get the service restarted.
+	LivezEndpoint = "/livez"
+)
+
+// CheckFunc returns nil if the component it checks is healthy.
+type CheckFunc func(ctx context.Context) error
+
+// CheckKind decides which endpoints a check is reported on. Every check is reported on HealthzEndpoint.
+type CheckKind uint8
+
+const (
+	// Readiness checks are reported on ReadyzEndpoint.
+	Readiness CheckKind = 1 << iota
+	// Liveness checks are reported on LivezEndpoint.
+	Liveness
+)
+
+const (
+	// StatusOK is the status of a passing check.
+	StatusOK = "ok"
+	// StatusFailed is the status of a failing check.
+	StatusFailed = "failed"
+)
+
+// CheckResult is the result of a single check.
+type CheckResult struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Duration  string    `json:"duration"`
+	CheckedAt time.Time `json:"checked_at"`
+	// Cached is true if the result was served from the cache.
+	Cached bool `json:"cached"`
+}
+
+// HealthReport is the response of the health endpoints.
+type HealthReport struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Healthy returns true if every check passed.
+func (h HealthReport) Healthy() bool {
+	return h.Status == StatusOK
+}
+
+// HealthRegistry holds named checks registered by the components of a service and serves them
+// in a kubernetes compatible form. Results are cached so probes can't overload a dependency.
+type HealthRegistry struct {
+	mux     sync.RWMutex
+	checks  map[string]*registeredCheck
+	ttl     time.Duration
+	timeout time.Duration
+}
+
+type registeredCheck struct {
+	name  string
+	check CheckFunc
+	kinds CheckKind
+	// mux is held while the check is running, so concurrent probes share a single run.
+	mux       sync.Mutex
+	lastErr   error
+	lastRun   time.Time
+	lastTook  time.Duration
+	hasResult bool
+}
+
+// HealthOption configures a HealthRegistry.
+type HealthOption func(*HealthRegistry)
+
+// WithCacheTTL sets how long a check result is reused. Defaults to 5 seconds.
+func WithCacheTTL(ttl time.Duration) HealthOption {
+	return func(h *HealthRegistry) {
+		h.ttl = ttl
+	}
+}
+
+// WithCheckTimeout sets the timeout of a single check. Defaults to 5 seconds.
+func WithCheckTimeout(timeout time.Duration) HealthOption {
+	return func(h *HealthRegistry) {
+		h.timeout = timeout
+	}
+}
+
+// NewHealthRegistry creates a new, empty health registry.
+func NewHealthRegistry(opts ...HealthOption) *HealthRegistry {
+	h := &HealthRegistry{
+		checks:  make(map[string]*registeredCheck),
+		ttl:     5 * time.Second,
+		timeout: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Register adds a named check, replacing any check with the same name.
+// If no kinds are passed, the check is a Readiness check.
+func (h *HealthRegistry) Register(name string, check CheckFunc, kinds ...CheckKind) {
+	var kind CheckKind
+	for _, k := range kinds {
+		kind |= k
+	}
+	if kind == 0 {
+		kind = Readiness
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.checks[name] = &registeredCheck{name: name, check: check, kinds: kind}
+}
+
+// Unregister removes a check.
+func (h *HealthRegistry) Unregister(name string) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	delete(h.checks, name)
+}
+
+// Check runs every check of the kind, or every check if kind is 0, skipping the excluded names.
+// Checks run concurrently.
+func (h *HealthRegistry) Check(ctx context.Context, kind CheckKind, exclude ...string) HealthReport {
+	excluded := make(map[string]bool, len(exclude))
+	for _, name := range exclude {
+		excluded[name] = true
+	}
+
+	h.mux.RLock()
+	var checks []*registeredCheck
+	for _, check := range h.checks {
+		if excluded[check.name] || (kind != 0 && check.kinds&kind == 0) {
+			continue
+		}
+		checks = append(checks, check)
+	}
+	h.mux.RUnlock()
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check *registeredCheck) {
+			defer wg.Done()
+			results[i] = h.run(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	return newReport(results)
+}
+
+// checkByName runs a single check. False is returned if the check does not exist or is not of kind.
+func (h *HealthRegistry) checkByName(ctx context.Context, kind CheckKind, name string) (HealthReport, bool) {
+	h.mux.RLock()
+	check, ok := h.checks[name]
+	h.mux.RUnlock()
+
+	if !ok || (kind != 0 && check.kinds&kind == 0) {
+		return HealthReport{}, false
+	}
+	return newReport([]CheckResult{h.run(ctx, check)}), true
+}
+
+// run runs the check, or returns its cached result.
+func (h *HealthRegistry) run(ctx context.Context, check *registeredCheck) CheckResult {
+	check.mux.Lock()
+	defer check.mux.Unlock()
+
+	cached := check.hasResult && time.Since(check.lastRun) < h.ttl
+	if !cached {
+		checkCtx, cancel := context.WithTimeout(ctx, h.timeout)
+		startTime := time.Now()
+		check.lastErr = runCheck(checkCtx, check.check)
+		check.lastTook = time.Since(startTime)
+		check.lastRun = startTime
+		check.hasResult = true
+		cancel()
+	}
+
+	result := CheckResult{
+		Name:      check.name,
+		Status:    StatusOK,
+		Duration:  check.lastTook.String(),
+		CheckedAt: check.lastRun,
+		Cached:    cached,
+	}
+	if check.lastErr != nil {
+		result.Status = StatusFailed
+		result.Error = check.lastErr.Error()
+	}
+	return result
+}
+
+// runCheck runs the check, turning a panic into an error.
+func runCheck(ctx context.Context, check CheckFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("check panicked: %v", r)
+		}
+	}()
+	return check(ctx)
+}
+
+func newReport(results []CheckResult) HealthReport {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	report := HealthReport{Status: StatusOK, Checks: results}
+	for _, result := range results {
+		if result.Status != StatusOK {
+			report.Status = StatusFailed
+		}
+	}
+	return report
+}
+
+// Mount serves HealthzEndpoint, ReadyzEndpoint and LivezEndpoint on the router.
+// Like kubernetes, each endpoint accepts repeated ?exclude=<check> parameters, and a single check can be
+// queried at <endpoint>/<check>. The status code is 200 if every check passed and 503 otherwise.
+func (h *HealthRegistry) Mount(router gin.IRoutes) {
+	h.mount(router, HealthzEndpoint, 0)
+	h.mount(router, ReadyzEndpoint, Readiness)
+	h.mount(router, LivezEndpoint, Liveness)
+}
+
+func (h *HealthRegistry) mount(router gin.IRoutes, endpoint string, kind CheckKind) {
+	router.GET(endpoint, func(c *gin.Context) {
+		writeReport(c, h.Check(c, kind, c.QueryArray("exclude")...))
+	})
+	router.GET(endpoint+"/:check", func(c *gin.Context) {
+		report, ok := h.checkByName(c, kind, c.Param("check"))
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("check %s not found", c.Param("check"))})
+			return
+		}
+		writeReport(c, report)
+	})
+}
+
+func writeReport(c *gin.Context, report HealthReport) {
+	status := http.StatusOK
+	if !report.Healthy() {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
+
+// DBCheck returns a check that pings the database. Register it for readiness only: restarting the process won't bring
+// the database back, so failing liveness on it just adds a restart loop to an outage.
+func DBCheck(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return fmt.Errorf("could not get database: %w", err)
+		}
+
+		err = sqlDB.PingContext(ctx)
+		if err != nil {
+			return fmt.Errorf("could not ping database: %w", err)
+		}
+		return nil
+	}
+}
+
+// ThresholdCheck returns a check that fails if the value is above max, e.g. a chain listener lag or a submitter backlog.
+func ThresholdCheck(description string, value func(ctx context.Context) (uint64, error), max uint64) CheckFunc {
+	return func(ctx context.Context) error {
+		current, err := value(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get %s: %w", description, err)
+		}
+		if current > max {
+			return fmt.Errorf("%s is %d, above the max of %d", description, current, max)
+		}
+		return nil
+	}
+}
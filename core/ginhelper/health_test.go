@@ -0,0 +1,106 @@
+package ginhelper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/ginhelper"
+)
+
+func (g *GinHelperSuite) serveHealth(engine *gin.Engine, path string) (int, ginhelper.HealthReport) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, req)
+
+	var report ginhelper.HealthReport
+	if recorder.Code != http.StatusNotFound {
+		Nil(g.T(), json.Unmarshal(recorder.Body.Bytes(), &report))
+	}
+	return recorder.Code, report
+}
+
+func (g *GinHelperSuite) TestHealthRegistry() {
+	registry := ginhelper.NewHealthRegistry()
+	engine := ginhelper.New(g.logger)
+	registry.Mount(engine)
+
+	// no checks means healthy.
+	code, report := g.serveHealth(engine, ginhelper.ReadyzEndpoint)
+	Equal(g.T(), http.StatusOK, code)
+	Equal(g.T(), ginhelper.StatusOK, report.Status)
+
+	var dbUp atomic.Bool
+	dbUp.Store(true)
+	registry.Register("db", func(ctx context.Context) error {
+		if !dbUp.Load() {
+			return errors.New("db down")
+		}
+		return nil
+	})
+	registry.Register("loop", func(ctx context.Context) error {
+		return nil
+	}, ginhelper.Liveness)
+	registry.Register("lag", ginhelper.ThresholdCheck("lag", func(ctx context.Context) (uint64, error) {
+		return 10, nil
+	}, 5), ginhelper.Readiness, ginhelper.Liveness)
+
+	code, report = g.serveHealth(engine, ginhelper.ReadyzEndpoint)
+	Equal(g.T(), http.StatusServiceUnavailable, code)
+	Len(g.T(), report.Checks, 2)
+	Equal(g.T(), "db", report.Checks[0].Name)
+	Equal(g.T(), ginhelper.StatusOK, report.Checks[0].Status)
+	Equal(g.T(), "lag", report.Checks[1].Name)
+	Equal(g.T(), "lag is 10, above the max of 5", report.Checks[1].Error)
+
+	code, report = g.serveHealth(engine, ginhelper.ReadyzEndpoint+"?exclude=lag")
+	Equal(g.T(), http.StatusOK, code)
+	Len(g.T(), report.Checks, 1)
+
+	code, report = g.serveHealth(engine, ginhelper.LivezEndpoint+"?exclude=lag")
+	Equal(g.T(), http.StatusOK, code)
+	Equal(g.T(), "loop", report.Checks[0].Name)
+
+	code, report = g.serveHealth(engine, ginhelper.HealthzEndpoint)
+	Equal(g.T(), http.StatusServiceUnavailable, code)
+	Len(g.T(), report.Checks, 3)
+
+	code, _ = g.serveHealth(engine, ginhelper.ReadyzEndpoint+"/db")
+	Equal(g.T(), http.StatusOK, code)
+	// loop is not a readiness check.
+	code, _ = g.serveHealth(engine, ginhelper.ReadyzEndpoint+"/loop")
+	Equal(g.T(), http.StatusNotFound, code)
+
+	// results are cached.
+	dbUp.Store(false)
+	code, report = g.serveHealth(engine, ginhelper.ReadyzEndpoint+"/db")
+	Equal(g.T(), http.StatusOK, code)
+	True(g.T(), report.Checks[0].Cached)
+}
+
+func (g *GinHelperSuite) TestHealthRegistryTimeout() {
+	registry := ginhelper.NewHealthRegistry(ginhelper.WithCacheTTL(0), ginhelper.WithCheckTimeout(10*time.Millisecond))
+	registry.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		//nolint: wrapcheck
+		return ctx.Err()
+	})
+	registry.Register("panics", func(ctx context.Context) error {
+		panic("oops")
+	})
+
+	report := registry.Check(g.GetTestContext(), ginhelper.Readiness)
+	False(g.T(), report.Healthy())
+	Equal(g.T(), "check panicked: oops", report.Checks[0].Error)
+	Equal(g.T(), context.DeadlineExceeded.Error(), report.Checks[1].Error)
+
+	registry.Unregister("slow")
+	registry.Unregister("panics")
+	True(g.T(), registry.Check(g.GetTestContext(), ginhelper.Readiness).Healthy())
+}
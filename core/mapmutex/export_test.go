@@ -24,3 +24,24 @@ func NewTestMapMutex(tb testing.TB) TestMapMutex {
 func (m *untypedMapMutexImpl) GetMa() map[interface{}]*mentry {
 	return m.ma
 }
+
+// TestRWMapMutex extends untypedRWMapMutex for testing.
+type TestRWMapMutex interface {
+	untypedRWMapMutex
+	GetMa() map[interface{}]*mentry
+	CountHeldKeys() int64
+}
+
+// NewTestRWMapMutex wraps a read/write map mutex and casts it to a test map mutex for testing.
+func NewTestRWMapMutex(tb testing.TB, opts ...Option) TestRWMapMutex {
+	tb.Helper()
+	mapMux := newRWMapMutex(opts...)
+	testMapMux, ok := mapMux.(TestRWMapMutex)
+	True(tb, ok)
+	return testMapMux
+}
+
+// CountHeldKeys exports countHeldKeys for testing.
+func (m *untypedMapMutexImpl) CountHeldKeys() int64 {
+	return m.countHeldKeys()
+}
@@ -0,0 +1,5 @@
+package mapmutex
+
+import "github.com/ipfs/go-log"
+
+var logger = log.Logger("mapmutex")
@@ -1,9 +1,12 @@
 package mapmutex
 
 import (
+	"context"
 	"fmt"
-	"github.com/LK4d4/trylock"
 	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
 )
 
 // untypedMapMutex wraps a map of mutexes.  Each key locks separately.
@@ -12,94 +15,218 @@
 // TODO: different implementations need to be moved to ginny: https://flaviocopes.com/golang-generic-generate/
 type untypedMapMutex interface {
 	Lock(key interface{}) Unlocker
+	LockCtx(ctx context.Context, key interface{}) (Unlocker, error)
 	TryLock(key interface{}) (Unlocker, bool)
 }
 
+// untypedRWMapMutex is an untypedMapMutex that also allows many readers to hold the same key.
+type untypedRWMapMutex interface {
+	untypedMapMutex
+	RLock(key interface{}) Unlocker
+	RLockCtx(ctx context.Context, key interface{}) (Unlocker, error)
+	TryRLock(key interface{}) (Unlocker, bool)
+}
+
+// rwMaxReaders is the weight acquired by a write lock on a read/write map mutex.
+// A read lock acquires a weight of 1, so this is also the max number of concurrent readers.
+const rwMaxReaders = 1 << 30
+
 type untypedMapMutexImpl struct {
 	ml sync.Mutex              // lock for entry map
 	ma map[interface{}]*mentry // entry map
+	// writeWeight is the weight acquired by Lock. 1 for a regular mutex, rwMaxReaders for a read/write mutex.
+	writeWeight int64
+	// heldKeys is the number of keys that currently have at least one holder.
+	heldKeys int64
+	// metrics is nil unless instrumentation was requested.
+	metrics *lockMetrics
 }
 
 type mentry struct {
 	// m point back to untypedMapMutexImpl, so we can synchronize removing this mentry when cnt==0
 	m *untypedMapMutexImpl
 	// el is an entry-specific lock
-	el trylock.Mutex
+	el *semaphore.Weighted
 	// cnt is the reference count
 	cnt int
+	// holders is the number of lock holders (as opposed to waiters) of this entry
+	holders int
 	// key is the key of the memory entry
 	key interface{}
 }
 
+// mlock is a single acquisition of an entry.
+type mlock struct {
+	e      *mentry
+	weight int64
+}
+
 // Unlocker provides an Unlock method to release the lock.
 type Unlocker interface {
 	Unlock()
 }
 
 // newMapMutex returns an initialized untypedMapMutexImpl.
-func newMapMutex() untypedMapMutex {
-	return &untypedMapMutexImpl{ma: make(map[interface{}]*mentry)}
+func newMapMutex(opts ...Option) untypedMapMutex {
+	return newMapMutexImpl(1, opts)
+}
+
+// newRWMapMutex returns an initialized untypedMapMutexImpl that supports read locks.
+func newRWMapMutex(opts ...Option) untypedRWMapMutex {
+	return newMapMutexImpl(rwMaxReaders, opts)
+}
+
+func newMapMutexImpl(writeWeight int64, opts []Option) *untypedMapMutexImpl {
+	m := &untypedMapMutexImpl{
+		ma:          make(map[interface{}]*mentry),
+		writeWeight: writeWeight,
+	}
+
+	cfg := makeOptions(opts)
+	if cfg.handler != nil {
+		var err error
+		m.metrics, err = newLockMetrics(cfg.handler, cfg.name, m.countHeldKeys)
+		if err != nil {
+			logger.Warnf("could not setup metrics for map mutex %s: %v", cfg.name, err)
+		}
+	}
+
+	return m
 }
 
 // Lock acquires a lock corresponding to this key.
 // This method will never return nil and Unlock() must be called
 // to release the lock when done.
 func (m *untypedMapMutexImpl) Lock(key interface{}) Unlocker {
+	// acquire lock, will block here until the lock is released by every other holder.
+	// this can't fail since the context is never cancelled.
+	unlocker, _ := m.acquire(context.Background(), key, m.writeWeight)
+	return unlocker
+}
+
+// LockCtx acquires a lock corresponding to this key, giving up when the context is cancelled.
+// Unlock() must be called to release the lock if no error is returned.
+func (m *untypedMapMutexImpl) LockCtx(ctx context.Context, key interface{}) (Unlocker, error) {
+	return m.acquire(ctx, key, m.writeWeight)
+}
+
+// TryLock tries to acquire the lock, if this can't be done instantly false is returned. Otherwise
+// true and the unlocker are returned.
+func (m *untypedMapMutexImpl) TryLock(key interface{}) (Unlocker, bool) {
+	return m.tryAcquire(key, m.writeWeight)
+}
+
+// RLock acquires a read lock corresponding to this key. Read locks can be held by many callers at once,
+// but exclude any Lock on the same key.
+func (m *untypedMapMutexImpl) RLock(key interface{}) Unlocker {
+	unlocker, _ := m.acquire(context.Background(), key, 1)
+	return unlocker
+}
+
+// RLockCtx acquires a read lock corresponding to this key, giving up when the context is cancelled.
+func (m *untypedMapMutexImpl) RLockCtx(ctx context.Context, key interface{}) (Unlocker, error) {
+	return m.acquire(ctx, key, 1)
+}
+
+// TryRLock tries to acquire a read lock, if this can't be done instantly false is returned.
+func (m *untypedMapMutexImpl) TryRLock(key interface{}) (Unlocker, bool) {
+	return m.tryAcquire(key, 1)
+}
+
+// acquire blocks until weight is acquired on the entry for key or ctx is done.
+func (m *untypedMapMutexImpl) acquire(ctx context.Context, key interface{}, weight int64) (Unlocker, error) {
 	// read or create entry for this key atomically
 	m.ml.Lock()
-	e, ok := m.ma[key]
-	if !ok {
-		e = &mentry{m: m, key: key}
-		m.ma[key] = e
-	}
+	e := m.entry(key)
 	e.cnt++ // ref count
 	m.ml.Unlock()
 
-	// acquire lock, will block here until e.cnt==1
-	e.el.Lock()
+	startTime := time.Now()
+	err := e.el.Acquire(ctx, weight)
+	m.metrics.recordWait(ctx, time.Since(startTime), err != nil)
+	if err != nil {
+		// we never got the lock, so we only need to drop our reference.
+		m.release(e, false)
+		return nil, fmt.Errorf("could not lock key %v: %w", key, err)
+	}
 
-	return e
+	m.ml.Lock()
+	m.hold(e)
+	m.ml.Unlock()
+
+	return &mlock{e: e, weight: weight}, nil
 }
 
-// TryLock tries to acquire the lock, if this can't be done instantly false is returned. Otherwise
-// true and the unlocker are returned.
-func (m *untypedMapMutexImpl) TryLock(key interface{}) (Unlocker, bool) {
+// tryAcquire acquires weight on the entry for key if this can be done without blocking.
+func (m *untypedMapMutexImpl) tryAcquire(key interface{}, weight int64) (Unlocker, bool) {
 	// read or create entry for this key atomically
 	m.ml.Lock()
 	defer m.ml.Unlock()
 
+	e := m.entry(key)
+	if e.el.TryAcquire(weight) {
+		e.cnt++
+		m.hold(e)
+		return &mlock{e: e, weight: weight}, true
+	}
+
+	if e.cnt < 1 {
+		delete(m.ma, key)
+	}
+	return nil, false
+}
+
+// entry returns the entry for key, creating it if needed. Must be called with ml held.
+func (m *untypedMapMutexImpl) entry(key interface{}) *mentry {
 	e, ok := m.ma[key]
 	if !ok {
-		e = &mentry{m: m, key: key}
+		e = &mentry{m: m, key: key, el: semaphore.NewWeighted(m.writeWeight)}
 		m.ma[key] = e
 	}
+	return e
+}
 
-	if e.el.TryLock() {
-		e.cnt++
-		return e, true
+// hold marks the entry as held by one more holder. Must be called with ml held.
+func (m *untypedMapMutexImpl) hold(e *mentry) {
+	e.holders++
+	if e.holders == 1 {
+		m.heldKeys++
 	}
-
-	return nil, false
 }
 
-// Unlock releases the lock for this entry.
-func (me *mentry) Unlock() {
-	m := me.m
-
+// release drops a reference to the entry and removes it from the map if this was the last one.
+func (m *untypedMapMutexImpl) release(e *mentry, held bool) {
 	// decrement and if needed remove entry atomically
 	m.ml.Lock()
-	e, ok := m.ma[me.key]
-	if !ok { // entry must exist
-		m.ml.Unlock()
-		panic(fmt.Errorf("unlock requested for key=%v but no entry found", me.key))
+	defer m.ml.Unlock()
+
+	if _, ok := m.ma[e.key]; !ok { // entry must exist
+		panic(fmt.Errorf("unlock requested for key=%v but no entry found", e.key))
+	}
+	if held {
+		e.holders--
+		if e.holders == 0 {
+			m.heldKeys--
+		}
 	}
 	e.cnt--        // ref count
 	if e.cnt < 1 { // if it hits zero then we own it and remove from map
-		delete(m.ma, me.key)
+		delete(m.ma, e.key)
 	}
-	m.ml.Unlock()
+}
+
+// countHeldKeys returns the number of keys that are currently held.
+func (m *untypedMapMutexImpl) countHeldKeys() int64 {
+	m.ml.Lock()
+	defer m.ml.Unlock()
+	return m.heldKeys
+}
+
+// Unlock releases the lock for this entry.
+func (l *mlock) Unlock() {
+	l.e.m.release(l.e, true)
 
 	// now that map stuff is handled, we unlock and let
 	// anything else waiting on this key through
-	e.el.Unlock()
+	l.e.el.Release(l.weight)
 }
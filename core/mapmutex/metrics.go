@@ -0,0 +1,61 @@
+package mapmutex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	meterName         = "github.com/synapsecns/sanguine/core/mapmutex"
+	waitTimeHistogram = "mapmutex_wait_time"
+	heldKeysGauge     = "mapmutex_held_keys"
+	nameAttr          = "mutex"
+	acquiredAttr      = "acquired"
+)
+
+// lockMetrics records lock contention for a single map mutex.
+type lockMetrics struct {
+	waitTime metric.Float64Histogram
+	attrs    attribute.Set
+}
+
+func newLockMetrics(handler metrics.Handler, name string, heldKeys func() int64) (*lockMetrics, error) {
+	meter := handler.Meter(meterName)
+	l := &lockMetrics{
+		attrs: attribute.NewSet(attribute.String(nameAttr, name)),
+	}
+
+	var err error
+	l.waitTime, err = meter.Float64Histogram(waitTimeHistogram, metric.WithDescription("time spent waiting for a key lock"), metric.WithUnit("s"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create histogram: %w", err)
+	}
+
+	heldGauge, err := meter.Int64ObservableGauge(heldKeysGauge, metric.WithDescription("number of currently held keys"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(heldGauge, heldKeys(), metric.WithAttributeSet(l.attrs))
+		return nil
+	}, heldGauge)
+	if err != nil {
+		return nil, fmt.Errorf("could not register callback: %w", err)
+	}
+
+	return l, nil
+}
+
+// recordWait records the time spent waiting for a lock. It is a no-op if metrics are disabled.
+func (l *lockMetrics) recordWait(ctx context.Context, wait time.Duration, cancelled bool) {
+	if l == nil {
+		return
+	}
+	l.waitTime.Record(ctx, wait.Seconds(), metric.WithAttributeSet(l.attrs), metric.WithAttributes(attribute.Bool(acquiredAttr, !cancelled)))
+}
@@ -0,0 +1,28 @@
+package mapmutex
+
+import "github.com/synapsecns/sanguine/core/metrics"
+
+// Option configures a map mutex.
+type Option func(*options)
+
+type options struct {
+	handler metrics.Handler
+	name    string
+}
+
+// WithMetrics instruments the map mutex through the metrics handler. The wait time of every lock
+// and the number of currently held keys are recorded, tagged with name.
+func WithMetrics(handler metrics.Handler, name string) Option {
+	return func(o *options) {
+		o.handler = handler
+		o.name = name
+	}
+}
+
+func makeOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
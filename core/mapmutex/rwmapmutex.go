@@ -0,0 +1,120 @@
+package mapmutex
+
+import (
+	"context"
+	"fmt"
+)
+
+// StringerRWMapMutex is a read/write map mutex for the fmt.Stringer conforming types.
+type StringerRWMapMutex interface {
+	StringerMapMutex
+	RLock(key fmt.Stringer) Unlocker
+	RLockCtx(ctx context.Context, key fmt.Stringer) (Unlocker, error)
+	TryRLock(key fmt.Stringer) (Unlocker, bool)
+}
+
+// stringerRWLockerImpl is the implementation of StringerRWMapMutex.
+type stringerRWLockerImpl struct {
+	stringerLockerImpl
+	rwMux untypedRWMapMutex
+}
+
+// NewStringerRWMapMutex creates an initialized read/write locker that locks on fmt.String.
+func NewStringerRWMapMutex(opts ...Option) StringerRWMapMutex {
+	rwMux := newRWMapMutex(opts...)
+	return &stringerRWLockerImpl{
+		stringerLockerImpl: stringerLockerImpl{mapMux: rwMux},
+		rwMux:              rwMux,
+	}
+}
+
+// RLock read locks on the string.
+func (s stringerRWLockerImpl) RLock(key fmt.Stringer) Unlocker {
+	return s.rwMux.RLock(key.String())
+}
+
+// RLockCtx read locks on the string, giving up when the context is cancelled.
+func (s stringerRWLockerImpl) RLockCtx(ctx context.Context, key fmt.Stringer) (Unlocker, error) {
+	return s.rwMux.RLockCtx(ctx, key.String())
+}
+
+// TryRLock attempts to read lock on the string.
+func (s stringerRWLockerImpl) TryRLock(key fmt.Stringer) (Unlocker, bool) {
+	return s.rwMux.TryRLock(key.String())
+}
+
+// StringRWMapMutex is a read/write map mutex for string typed values.
+type StringRWMapMutex interface {
+	StringMapMutex
+	RLock(key string) Unlocker
+	RLockCtx(ctx context.Context, key string) (Unlocker, error)
+	TryRLock(key string) (Unlocker, bool)
+}
+
+// stringRWMutexImpl read/write locks on a string type.
+type stringRWMutexImpl struct {
+	stringMutexImpl
+	rwMux untypedRWMapMutex
+}
+
+// NewStringRWMapMutex creates a read/write map mutex for the string type.
+func NewStringRWMapMutex(opts ...Option) StringRWMapMutex {
+	rwMux := newRWMapMutex(opts...)
+	return &stringRWMutexImpl{
+		stringMutexImpl: stringMutexImpl{mapMux: rwMux},
+		rwMux:           rwMux,
+	}
+}
+
+// RLock read locks on a string value.
+func (s stringRWMutexImpl) RLock(key string) Unlocker {
+	return s.rwMux.RLock(key)
+}
+
+// RLockCtx read locks on a string value, giving up when the context is cancelled.
+func (s stringRWMutexImpl) RLockCtx(ctx context.Context, key string) (Unlocker, error) {
+	return s.rwMux.RLockCtx(ctx, key)
+}
+
+// TryRLock attempts to read lock on a string value.
+func (s stringRWMutexImpl) TryRLock(key string) (Unlocker, bool) {
+	return s.rwMux.TryRLock(key)
+}
+
+// IntRWMapMutex is a read/write map mutex that allows locking on an int.
+type IntRWMapMutex interface {
+	IntMapMutex
+	RLock(key int) Unlocker
+	RLockCtx(ctx context.Context, key int) (Unlocker, error)
+	TryRLock(key int) (Unlocker, bool)
+}
+
+// intRWMapMux read/write locks on an int.
+type intRWMapMux struct {
+	intMapMux
+	rwMux untypedRWMapMutex
+}
+
+// NewIntRWMapMutex creates a read/write map mutex for locking on an integer.
+func NewIntRWMapMutex(opts ...Option) IntRWMapMutex {
+	rwMux := newRWMapMutex(opts...)
+	return &intRWMapMux{
+		intMapMux: intMapMux{mapMux: rwMux},
+		rwMux:     rwMux,
+	}
+}
+
+// RLock read locks an int map mux.
+func (i intRWMapMux) RLock(key int) Unlocker {
+	return i.rwMux.RLock(key)
+}
+
+// RLockCtx read locks an int map mux, giving up when the context is cancelled.
+func (i intRWMapMux) RLockCtx(ctx context.Context, key int) (Unlocker, error) {
+	return i.rwMux.RLockCtx(ctx, key)
+}
+
+// TryRLock attempts to read lock an int map mux.
+func (i intRWMapMux) TryRLock(key int) (Unlocker, bool) {
+	return i.rwMux.TryRLock(key)
+}
@@ -0,0 +1,141 @@
+package mapmutex_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/mapmutex"
+	"github.com/synapsecns/sanguine/core/metrics"
+)
+
+func (s MapMutexSuite) TestLockCtx() {
+	m := mapmutex.NewStringMapMutex(mapmutex.WithMetrics(metrics.NewNullHandler(), "test"))
+
+	l, err := m.LockCtx(s.GetTestContext(), "key")
+	s.Require().NoError(err)
+
+	// the key is held, so this should give up once the context is done.
+	ctx, cancel := context.WithTimeout(s.GetTestContext(), time.Millisecond*50)
+	defer cancel()
+	_, err = m.LockCtx(ctx, "key")
+	True(s.T(), errors.Is(err, context.DeadlineExceeded))
+
+	// other keys are unaffected.
+	other, err := m.LockCtx(ctx, "other")
+	s.Require().NoError(err)
+	other.Unlock()
+
+	l.Unlock()
+
+	l, err = m.LockCtx(s.GetTestContext(), "key")
+	s.Require().NoError(err)
+	l.Unlock()
+}
+
+func (s MapMutexSuite) TestLockCtxCleanup() {
+	m := mapmutex.NewTestRWMapMutex(s.T())
+
+	l := m.Lock(1)
+
+	ctx, cancel := context.WithCancel(s.GetTestContext())
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.LockCtx(ctx, 1)
+			NotNil(s.T(), err)
+		}()
+	}
+	cancel()
+	wg.Wait()
+
+	Equal(s.T(), int64(1), m.CountHeldKeys())
+	l.Unlock()
+
+	// cancelled waiters should not leave entries behind.
+	Empty(s.T(), m.GetMa())
+	Equal(s.T(), int64(0), m.CountHeldKeys())
+}
+
+func (s MapMutexSuite) TestRWMapMutex() {
+	m := mapmutex.NewTestRWMapMutex(s.T())
+
+	// many readers can hold the same key.
+	r1 := m.RLock("key")
+	r2, ok := m.TryRLock("key")
+	True(s.T(), ok)
+	Equal(s.T(), int64(1), m.CountHeldKeys())
+
+	// but writers have to wait for all of them.
+	_, ok = m.TryLock("key")
+	False(s.T(), ok)
+
+	locked := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		l, err := m.LockCtx(s.GetTestContext(), "key")
+		NoError(s.T(), err)
+		close(locked)
+
+		// readers have to wait for the writer.
+		_, ok := m.TryRLock("key")
+		False(s.T(), ok)
+		l.Unlock()
+	}()
+
+	r1.Unlock()
+	select {
+	case <-locked:
+		s.T().Fatal("writer acquired the lock while a reader still holds it")
+	case <-time.After(time.Millisecond * 50):
+	}
+	r2.Unlock()
+
+	select {
+	case <-locked:
+	case <-s.GetTestContext().Done():
+		s.T().Fatal("writer never acquired the lock")
+	}
+
+	<-done
+	Empty(s.T(), m.GetMa())
+}
+
+func (s MapMutexSuite) TestRWMapMutexTypes() {
+	ctx, cancel := context.WithTimeout(s.GetTestContext(), time.Millisecond*50)
+	defer cancel()
+
+	stringMux := mapmutex.NewStringRWMapMutex()
+	rl := stringMux.RLock("key")
+	_, err := stringMux.LockCtx(ctx, "key")
+	NotNil(s.T(), err)
+	rl, err = stringMux.RLockCtx(s.GetTestContext(), "key")
+	s.Require().NoError(err)
+	rl.Unlock()
+
+	intMux := mapmutex.NewIntRWMapMutex()
+	l := intMux.Lock(1)
+	_, ok := intMux.TryRLock(1)
+	False(s.T(), ok)
+	l.Unlock()
+
+	stringerMux := mapmutex.NewStringerRWMapMutex()
+	key := stringerKey("key")
+	rl, ok = stringerMux.TryRLock(key)
+	True(s.T(), ok)
+	rl.Unlock()
+	l, err = stringerMux.LockCtx(s.GetTestContext(), key)
+	s.Require().NoError(err)
+	l.Unlock()
+}
+
+type stringerKey string
+
+func (s stringerKey) String() string {
+	return string(s)
+}
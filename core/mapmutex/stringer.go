@@ -1,10 +1,14 @@
 package mapmutex
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // StringerMapMutex is an implementation of mapMutex for the fmt.Stringer conforming types.
 type StringerMapMutex interface {
 	Lock(key fmt.Stringer) Unlocker
+	LockCtx(ctx context.Context, key fmt.Stringer) (Unlocker, error)
 	TryLock(key fmt.Stringer) (Unlocker, bool)
 }
 
@@ -22,16 +26,22 @@ func (s stringerLockerImpl) Lock(key fmt.Stringer) Unlocker {
 	return s.mapMux.Lock(key.String())
 }
 
+// LockCtx locks on the string, giving up when the context is cancelled.
+func (s stringerLockerImpl) LockCtx(ctx context.Context, key fmt.Stringer) (Unlocker, error) {
+	return s.mapMux.LockCtx(ctx, key.String())
+}
+
 // NewStringerMapMutex creates an initialized locker that locks on fmt.String.
-func NewStringerMapMutex() StringerMapMutex {
+func NewStringerMapMutex(opts ...Option) StringerMapMutex {
 	return &stringerLockerImpl{
-		mapMux: newMapMutex(),
+		mapMux: newMapMutex(opts...),
 	}
 }
 
 // StringMapMutex is an implementation of map mutex for string typed values.
 type StringMapMutex interface {
 	Lock(key string) Unlocker
+	LockCtx(ctx context.Context, key string) (Unlocker, error)
 	TryLock(key string) (Unlocker, bool)
 }
 
@@ -41,9 +51,9 @@ type stringMutexImpl struct {
 }
 
 // NewStringMapMutex creates a map mutex for the string type.
-func NewStringMapMutex() StringMapMutex {
+func NewStringMapMutex(opts ...Option) StringMapMutex {
 	return &stringMutexImpl{
-		mapMux: newMapMutex(),
+		mapMux: newMapMutex(opts...),
 	}
 }
 
@@ -52,6 +62,11 @@ func (s stringMutexImpl) Lock(key string) Unlocker {
 	return s.mapMux.Lock(key)
 }
 
+// LockCtx locks on a string value, giving up when the context is cancelled.
+func (s stringMutexImpl) LockCtx(ctx context.Context, key string) (Unlocker, error) {
+	return s.mapMux.LockCtx(ctx, key)
+}
+
 // TryLock attempts to lock on a string value.
 func (s stringMutexImpl) TryLock(key string) (Unlocker, bool) {
 	return s.mapMux.TryLock(key)
@@ -60,6 +75,7 @@ func (s stringMutexImpl) TryLock(key string) (Unlocker, bool) {
 // IntMapMutex is a map mutex that allows locking on an int.
 type IntMapMutex interface {
 	Lock(key int) Unlocker
+	LockCtx(ctx context.Context, key int) (Unlocker, error)
 	TryLock(key int) (Unlocker, bool)
 }
 
@@ -77,9 +93,14 @@ func (i intMapMux) Lock(key int) Unlocker {
 	return i.mapMux.Lock(key)
 }
 
+// LockCtx locks an int map mux, giving up when the context is cancelled.
+func (i intMapMux) LockCtx(ctx context.Context, key int) (Unlocker, error) {
+	return i.mapMux.LockCtx(ctx, key)
+}
+
 // NewIntMapMutex creates a map mutex for locking on an integer.
-func NewIntMapMutex() IntMapMutex {
+func NewIntMapMutex(opts ...Option) IntMapMutex {
 	return &intMapMux{
-		mapMux: newMapMutex(),
+		mapMux: newMapMutex(opts...),
 	}
 }
@@ -0,0 +1,3 @@
+// Package gormstore provides a gorm backed merkle.Store so a merkle.HistoricalTree can be persisted
+// in sqlite (or any other gorm supported database) instead of being rebuilt on every start.
+package gormstore
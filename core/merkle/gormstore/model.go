@@ -0,0 +1,30 @@
+package gormstore
+
+// TreeElement is the historical value of a tree element.
+type TreeElement struct {
+	// TreeID identifies the tree the element belongs to.
+	TreeID string `gorm:"column:tree_id;primaryKey;size:256"`
+	// Height is the height of the element (increasing from leafs to root).
+	Height uint32 `gorm:"column:height;primaryKey;autoIncrement:false"`
+	// X is the x-coord of the element (increasing from older leafs to newer).
+	X uint32 `gorm:"column:x;primaryKey;autoIncrement:false"`
+	// LeafCount is the amount of leafs inserted when the element got this value.
+	LeafCount uint32 `gorm:"column:leaf_count;primaryKey;autoIncrement:false"`
+	// Value is the value of the element.
+	Value []byte `gorm:"column:value"`
+}
+
+// TreeMetadata holds the leaf count and the pruned boundary of a tree.
+type TreeMetadata struct {
+	// TreeID identifies the tree.
+	TreeID string `gorm:"column:tree_id;primaryKey;size:256"`
+	// LeafCount is the amount of leafs inserted in the tree.
+	LeafCount uint32 `gorm:"column:leaf_count"`
+	// PrunedBefore is the smallest count historical requests can still be served for.
+	PrunedBefore uint32 `gorm:"column:pruned_before"`
+}
+
+// GetAllModels gets all models to migrate.
+func GetAllModels() []interface{} {
+	return []interface{}{&TreeElement{}, &TreeMetadata{}}
+}
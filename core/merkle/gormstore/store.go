@@ -0,0 +1,191 @@
+package gormstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ipfs/go-log"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/merkle"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var logger = log.Logger("merkle-store")
+
+// batchSize is the amount of elements inserted per statement. This keeps sqlite under its variable limit.
+const batchSize = 100
+
+// Store is a gorm backed merkle.Store. Many trees can share a database, each one is keyed by its tree id.
+type Store struct {
+	db     *gorm.DB
+	treeID string
+}
+
+// NewStore creates a new store for the tree with the given id. Models are expected to be migrated,
+// see GetAllModels.
+func NewStore(db *gorm.DB, treeID string) *Store {
+	return &Store{
+		db:     db,
+		treeID: treeID,
+	}
+}
+
+// NewSqliteStore creates a new sqlite backed store at dbPath and migrates it.
+func NewSqliteStore(ctx context.Context, dbPath string, treeID string) (*Store, error) {
+	err := os.MkdirAll(dbPath, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("could not create sqlite store: %w", err)
+	}
+
+	gdb, err := gorm.Open(sqlite.Open(fmt.Sprintf("%s/%s", dbPath, "merkle.db")), &gorm.Config{
+		Logger:                 dbcommon.GetGormLogger(logger),
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to db %s: %w", dbPath, err)
+	}
+
+	err = gdb.WithContext(ctx).AutoMigrate(GetAllModels()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate models: %w", err)
+	}
+
+	return NewStore(gdb, treeID), nil
+}
+
+// DB gets the underlying gorm db.
+func (s *Store) DB() *gorm.DB {
+	return s.db
+}
+
+// Get returns the value stored for key, or nil if nothing is stored.
+func (s *Store) Get(key merkle.StateKey) ([]byte, error) {
+	var element TreeElement
+	// struct conditions skip zero values, so the coordinates are matched explicitly.
+	err := s.db.Where("tree_id = ? AND height = ? AND x = ? AND leaf_count = ?", s.treeID, key.Height(), key.X(), key.Count()).
+		Limit(1).Find(&element).Error
+	if err != nil {
+		return nil, fmt.Errorf("could not get element: %w", err)
+	}
+	if element.TreeID == "" {
+		return nil, nil
+	}
+	return element.Value, nil
+}
+
+// Commit atomically stores the elements updated by an insertion alongside the new leaf count.
+func (s *Store) Commit(entries []merkle.StateEntry, count uint32) error {
+	elements := make([]TreeElement, len(entries))
+	for i, entry := range entries {
+		elements[i] = TreeElement{
+			TreeID:    s.treeID,
+			Height:    entry.Key.Height(),
+			X:         entry.Key.X(),
+			LeafCount: entry.Key.Count(),
+			Value:     entry.Value,
+		}
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if len(elements) > 0 {
+			err := tx.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(elements, batchSize).Error
+			if err != nil {
+				return fmt.Errorf("could not store elements: %w", err)
+			}
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tree_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"leaf_count"}),
+		}).Create(&TreeMetadata{TreeID: s.treeID, LeafCount: count}).Error
+		if err != nil {
+			return fmt.Errorf("could not store leaf count: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not commit: %w", err)
+	}
+	return nil
+}
+
+// Count returns the leaf count recorded by the last commit.
+func (s *Store) Count() (uint32, error) {
+	metadata, err := s.metadata()
+	if err != nil {
+		return 0, err
+	}
+	return metadata.LeafCount, nil
+}
+
+// PruneBefore removes every non-final element whose count is less than `count`.
+func (s *Store) PruneBefore(count uint32) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// an element is final once all of its children are non-zero: leaf_count == (x + 1) * 2**height.
+		err := tx.Where("tree_id = ? AND leaf_count < ? AND leaf_count <> (x + 1) * (1 << height)", s.treeID, count).
+			Delete(&TreeElement{}).Error
+		if err != nil {
+			return fmt.Errorf("could not delete elements: %w", err)
+		}
+
+		err = tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tree_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"pruned_before"}),
+		}).Create(&TreeMetadata{TreeID: s.treeID, PrunedBefore: count}).Error
+		if err != nil {
+			return fmt.Errorf("could not store pruned boundary: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not prune: %w", err)
+	}
+	return nil
+}
+
+// PrunedBefore returns the boundary recorded by the last PruneBefore.
+func (s *Store) PrunedBefore() (uint32, error) {
+	metadata, err := s.metadata()
+	if err != nil {
+		return 0, err
+	}
+	return metadata.PrunedBefore, nil
+}
+
+// Entries returns every stored element sorted by height, x-coord and count.
+func (s *Store) Entries() ([]merkle.StateEntry, error) {
+	var elements []TreeElement
+	err := s.db.Where(&TreeElement{TreeID: s.treeID}).
+		Order("height asc, x asc, leaf_count asc").
+		Find(&elements).Error
+	if err != nil {
+		return nil, fmt.Errorf("could not get elements: %w", err)
+	}
+
+	entries := make([]merkle.StateEntry, len(elements))
+	for i, element := range elements {
+		entries[i] = merkle.StateEntry{
+			Key:   merkle.NewStateKey(element.Height, element.X, element.LeafCount),
+			Value: element.Value,
+		}
+	}
+	return entries, nil
+}
+
+func (s *Store) metadata() (*TreeMetadata, error) {
+	var metadata TreeMetadata
+	err := s.db.Where(&TreeMetadata{TreeID: s.treeID}).First(&metadata).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &TreeMetadata{TreeID: s.treeID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get tree metadata: %w", err)
+	}
+	return &metadata, nil
+}
+
+var _ merkle.Store = &Store{}
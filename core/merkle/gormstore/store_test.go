@@ -0,0 +1,96 @@
+package gormstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/merkle"
+	"github.com/synapsecns/sanguine/core/merkle/gormstore"
+)
+
+const leafsAmount uint32 = 40
+
+func TestGormStore(t *testing.T) {
+	dbPath := filet.TmpDir(t, "")
+	store, err := gormstore.NewSqliteStore(context.Background(), dbPath, "origin-1")
+	Nil(t, err)
+
+	tree, err := merkle.NewTreeWithStore(merkle.MessageTreeHeight, store)
+	Nil(t, err)
+	memTree := merkle.NewTree(merkle.MessageTreeHeight)
+
+	for i := uint32(0); i < leafsAmount; i++ {
+		leaf := fakeLeaf()
+		Nil(t, tree.Insert(leaf))
+		Nil(t, memTree.Insert(leaf))
+	}
+
+	// A tree that lives in another id should not be affected.
+	otherStore := gormstore.NewStore(store.DB(), "origin-2")
+	otherTree, err := merkle.NewTreeWithStore(merkle.MessageTreeHeight, otherStore)
+	Nil(t, err)
+	Nil(t, otherTree.Insert(fakeLeaf()))
+
+	// Reopen the store and make sure the tree is picked up where it was left.
+	reopened, err := gormstore.NewSqliteStore(context.Background(), dbPath, "origin-1")
+	Nil(t, err)
+	tree, err = merkle.NewTreeWithStore(merkle.MessageTreeHeight, reopened)
+	Nil(t, err)
+	Equal(t, leafsAmount, tree.NumOfItems())
+
+	assertSameTree(t, memTree, tree, 1)
+
+	// Snapshots should be identical regardless of the store.
+	memSnapshot, err := memTree.MarshalBinary()
+	Nil(t, err)
+	snapshot, err := tree.MarshalBinary()
+	Nil(t, err)
+	Equal(t, memSnapshot, snapshot)
+
+	// Prune and make sure the remaining states are identical.
+	const pruneBefore = leafsAmount / 2
+	Nil(t, tree.PruneBefore(pruneBefore))
+	Nil(t, memTree.PruneBefore(pruneBefore))
+	assertSameTree(t, memTree, tree, pruneBefore)
+
+	memSnapshot, err = memTree.MarshalBinary()
+	Nil(t, err)
+	snapshot, err = tree.MarshalBinary()
+	Nil(t, err)
+	Equal(t, memSnapshot, snapshot)
+
+	tree, err = merkle.NewTreeWithStore(merkle.MessageTreeHeight, reopened)
+	Nil(t, err)
+	Equal(t, pruneBefore, tree.PrunedBefore())
+}
+
+func assertSameTree(t *testing.T, expected, actual *merkle.HistoricalTree, fromCount uint32) {
+	t.Helper()
+
+	for count := fromCount; count <= expected.NumOfItems(); count++ {
+		expectedRoot, err := expected.Root(count)
+		Nil(t, err)
+		actualRoot, err := actual.Root(count)
+		Nil(t, err)
+		Equal(t, expectedRoot, actualRoot)
+
+		for index := uint32(0); index < count; index++ {
+			expectedProof, err := expected.MerkleProof(index, count)
+			Nil(t, err)
+			actualProof, err := actual.MerkleProof(index, count)
+			Nil(t, err)
+			Equal(t, expectedProof, actualProof)
+		}
+	}
+}
+
+func fakeLeaf() []byte {
+	leaf := make([]byte, 32)
+	for i := 0; i < 32; i++ {
+		leaf[i] = gofakeit.Uint8()
+	}
+	return leaf
+}
@@ -13,14 +13,40 @@ type StateKey struct {
 	count uint32
 }
 
+// NewStateKey creates a new state key.
+func NewStateKey(h, x, count uint32) StateKey {
+	return StateKey{h: h, x: x, count: count}
+}
+
+// Height is the height of the element (increasing from leafs to root).
+func (s StateKey) Height() uint32 {
+	return s.h
+}
+
+// X is the x-coord of the element (increasing from older leafs to newer).
+func (s StateKey) X() uint32 {
+	return s.x
+}
+
+// Count is the amount of leafs inserted in the merkle tree when the element got this value.
+func (s StateKey) Count() uint32 {
+	return s.count
+}
+
+// IsFinal returns true if this is the last value the element will ever have, i.e. all of
+// its children are non-zero. Final values are needed to serve requests for any later count.
+func (s StateKey) IsFinal() bool {
+	return uint64(s.count) == (uint64(s.x)+1)<<s.h
+}
+
 // HistoricalTree implements a merkle tree with the ability to generate historical
 // state of the tree. This includes historical roots, as well as historical proofs.
 type HistoricalTree struct {
-	// state[stateKey] is the value for a tree element:
+	// store holds state[stateKey], the value for a tree element:
 	//   - With [height = stakeKey.h] (increasing from leafs to root)
 	//   - With [x-coord = stateKey.x] (increasing from older leafs to newer)
 	//   - When stateKey.count leafs were inserted in the merkle tree
-	state map[StateKey][]byte
+	store Store
 	// zeroHashes[H] is the value for a tree element:
 	//   - With [height = H] (increasing from leafs to root)
 	//	 - That doesn't have any non-zero children
@@ -29,6 +55,8 @@ type HistoricalTree struct {
 	treeCount uint32
 	// treeHeight is the height of the merkle tree
 	treeHeight uint32
+	// prunedBefore is the smallest count historical requests can still be served for.
+	prunedBefore uint32
 }
 
 /**
@@ -75,7 +103,7 @@ type HistoricalTree struct {
  * Thus we actually need to store tree element value for N in range (X*(2**H), (X+1)*(2**H)]
  * The amount of "significant" values (stage b) is 2**H.
  *
- * We're using a map to avoid dealing with dynamic arrays.
+ * We're using a key-value Store to avoid dealing with dynamic arrays.
  */
 
 // MessageTreeHeight is the depth of the merkle tree that is used in the messaging contracts.
@@ -84,23 +112,47 @@ type HistoricalTree struct {
 // SnapshotTreeHeight is the depth of the merkle tree that is used in the snapshot contracts.
 const SnapshotTreeHeight uint32 = 6
 
-// NewTree returns an empty Merkle Tree.
+// NewTree returns an empty Merkle Tree backed by an in-memory store.
 func NewTree(treeHeight uint32) *HistoricalTree {
 	return &HistoricalTree{
-		state:      make(map[StateKey][]byte),
+		store:      NewMemoryStore(),
 		zeroHashes: generateZeroHashes(treeHeight),
 		treeCount:  0,
 		treeHeight: treeHeight,
 	}
 }
 
+// NewTreeWithStore returns a Merkle Tree backed by the given store.
+// If the store already holds a tree, its state is picked up where it was left.
+func NewTreeWithStore(treeHeight uint32, store Store) (*HistoricalTree, error) {
+	count, err := store.Count()
+	if err != nil {
+		return nil, fmt.Errorf("could not get tree count: %w", err)
+	}
+
+	prunedBefore, err := store.PrunedBefore()
+	if err != nil {
+		return nil, fmt.Errorf("could not get pruned count: %w", err)
+	}
+
+	return &HistoricalTree{
+		store:        store,
+		zeroHashes:   generateZeroHashes(treeHeight),
+		treeCount:    count,
+		treeHeight:   treeHeight,
+		prunedBefore: prunedBefore,
+	}, nil
+}
+
 // NewTreeFromItems returns a new Merkle Tree from a slice of byte slices.
-func NewTreeFromItems(items [][]byte, treeHeight uint32) *HistoricalTree {
+func NewTreeFromItems(items [][]byte, treeHeight uint32) (*HistoricalTree, error) {
 	tree := NewTree(treeHeight)
 	for _, item := range items {
-		tree.Insert(item)
+		if err := tree.Insert(item); err != nil {
+			return nil, err
+		}
 	}
-	return tree
+	return tree, nil
 }
 
 // BranchRoot calculates the merkle root given the item and the proof.
@@ -131,31 +183,48 @@ func VerifyMerkleProof(root, item []byte, index uint32, proof [][]byte, treeHeig
 }
 
 // Insert inserts a new leaf into the merkle tree. This is done using O(1) time.
-func (m *HistoricalTree) Insert(item []byte) {
+func (m *HistoricalTree) Insert(item []byte) error {
 	x := m.treeCount
 	newCount := x + 1
-	saveElementState(m, 0, x, newCount, item)
+	// updated holds the elements changed by this insertion, they are committed to the store at once.
+	updated := make([]StateEntry, 0, m.treeHeight+1)
+	updated = append(updated, StateEntry{Key: StateKey{0, x, newCount}, Value: item})
 	for h := uint32(1); h <= m.treeHeight; h++ {
 		// Traverse to parent
 		x >>= 1
 		// Children have [height = h - 1]
 		// And X-coordinates [2 * x] and [2 * x + 1]
-		leftChild := fetchTreeElementState(m, h-1, x<<1, newCount)
-		rightChild := fetchTreeElementState(m, h-1, (x<<1)+1, newCount)
+		leftChild, err := m.fetchUpdatedElementState(updated, h-1, x<<1, newCount)
+		if err != nil {
+			return err
+		}
+		rightChild, err := m.fetchUpdatedElementState(updated, h-1, (x<<1)+1, newCount)
+		if err != nil {
+			return err
+		}
 		parent := getParent(leftChild, rightChild)
-		saveElementState(m, h, x, newCount, parent)
+		updated = append(updated, StateEntry{Key: StateKey{h, x, newCount}, Value: parent})
+	}
+
+	if err := m.store.Commit(updated, newCount); err != nil {
+		return fmt.Errorf("could not commit tree state: %w", err)
 	}
 	m.treeCount = newCount
+	return nil
 }
 
 // Items returns the list of items that were inserted in the Merkle tree.
-func (m *HistoricalTree) Items() [][]byte {
+func (m *HistoricalTree) Items() ([][]byte, error) {
 	items := make([][]byte, m.treeCount)
 	for x := uint32(0); x < m.treeCount; x++ {
 		// H=0 is the leaf level.
-		items[x] = fetchTreeElementState(m, 0, x, m.treeCount)
+		item, err := fetchTreeElementState(m, 0, x, m.treeCount)
+		if err != nil {
+			return nil, err
+		}
+		items[x] = item
 	}
-	return items
+	return items, nil
 }
 
 // NumOfItems returns the amount of leafs inserted in the merkle tree.
@@ -169,7 +238,7 @@ func (m *HistoricalTree) Item(index uint32) ([]byte, error) {
 		return nil, fmt.Errorf("not enough leafs; inserted: %d, requested index: %d", m.treeCount, index)
 	}
 	// H=0 is the leaf level.
-	return fetchTreeElementState(m, 0, index, m.treeCount), nil
+	return fetchTreeElementState(m, 0, index, m.treeCount)
 }
 
 // Root returns the merkle root of the tree after a certain amount of leafs were inserted.
@@ -178,8 +247,11 @@ func (m *HistoricalTree) Root(count uint32) ([]byte, error) {
 	if count > m.treeCount {
 		return nil, fmt.Errorf("not enough leafs; inserted: %d, requested root for count: %d", m.treeCount, count)
 	}
+	if count < m.prunedBefore {
+		return nil, fmt.Errorf("historical state was pruned; pruned before: %d, requested root for count: %d", m.prunedBefore, count)
+	}
 	// H=m.treeHeight is the root level.
-	return fetchTreeElementState(m, m.treeHeight, 0, count), nil
+	return fetchTreeElementState(m, m.treeHeight, 0, count)
 }
 
 // MerkleProof returns the proof of inclusion:
@@ -194,18 +266,47 @@ func (m *HistoricalTree) MerkleProof(index uint32, count uint32) ([][]byte, erro
 	if index >= count {
 		return nil, fmt.Errorf("merkle index out of range; count: %d, requested proof for index: %d", count, index)
 	}
+	if count < m.prunedBefore {
+		return nil, fmt.Errorf("historical state was pruned; pruned before: %d, requested proof for count: %d", m.prunedBefore, count)
+	}
 	proof := make([][]byte, m.treeHeight)
 	for h := uint32(0); h < m.treeHeight; h++ {
 		// First, determine X-axis of the element's sibling
 		siblingX := index ^ 1
 		// Get sibling state at the time when `count` leafs were added
-		proof[h] = fetchTreeElementState(m, h, siblingX, count)
+		sibling, err := fetchTreeElementState(m, h, siblingX, count)
+		if err != nil {
+			return nil, err
+		}
+		proof[h] = sibling
 		// Traverse to parent
 		index >>= 1
 	}
 	return proof, nil
 }
 
+// PrunedBefore returns the smallest count historical roots and proofs can still be requested for.
+func (m *HistoricalTree) PrunedBefore() uint32 {
+	return m.prunedBefore
+}
+
+// PruneBefore drops the historical states that are only needed to serve roots and proofs
+// for less than `count` leafs. Roots and proofs for `count` or more leafs stay identical,
+// requests for older states return an error afterwards.
+func (m *HistoricalTree) PruneBefore(count uint32) error {
+	if count > m.treeCount {
+		return fmt.Errorf("not enough leafs; inserted: %d, requested prune before count: %d", m.treeCount, count)
+	}
+	if count <= m.prunedBefore {
+		return nil
+	}
+	if err := m.store.PruneBefore(count); err != nil {
+		return fmt.Errorf("could not prune tree state: %w", err)
+	}
+	m.prunedBefore = count
+	return nil
+}
+
 // generateZeroHashes returns the default "zero" values for elements from bottom to top (leaf to root).
 func generateZeroHashes(treeHeight uint32) [][]byte {
 	zeroHashes := make([][]byte, treeHeight+1)
@@ -223,23 +324,50 @@ func generateZeroHashes(treeHeight uint32) [][]byte {
 //   - With [height = H] (increasing from leafs to root)
 //   - With [x-coord = X] (increasing from older leafs to newer)
 //   - When `count` leafs were inserted in the merkle tree
-func fetchTreeElementState(m *HistoricalTree, h uint32, x uint32, count uint32) []byte {
+func fetchTreeElementState(m *HistoricalTree, h uint32, x uint32, count uint32) ([]byte, error) {
+	key, isZero := stateKeyFor(h, x, count)
+	if isZero {
+		return m.zeroHashes[h], nil
+	}
+
+	value, err := m.store.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not get tree element state: %w", err)
+	}
+	return value, nil
+}
+
+// fetchUpdatedElementState is fetchTreeElementState that also looks at the elements updated
+// by an insertion that was not committed yet.
+func (m *HistoricalTree) fetchUpdatedElementState(updated []StateEntry, h uint32, x uint32, count uint32) ([]byte, error) {
+	key, isZero := stateKeyFor(h, x, count)
+	if !isZero {
+		for _, entry := range updated {
+			if entry.Key == key {
+				return entry.Value, nil
+			}
+		}
+	}
+	return fetchTreeElementState(m, h, x, count)
+}
+
+// stateKeyFor returns the key that holds the element state when `count` leafs were inserted.
+// isZero is true if the element is still a "zero" element.
+func stateKeyFor(h uint32, x uint32, count uint32) (key StateKey, isZero bool) {
 	// We do cast to uint64 here, as (1 << 32) overflows uint32
 	firstChildLeafIndex := uint64(x) << h // x * (2**H)
 	childLeafsAmount := uint64(1) << h    // 2**H
 	switch {
 	case uint64(count) <= firstChildLeafIndex:
 		// Stage A: not enough leafs were inserted, element is still zero.
-		return m.zeroHashes[h]
+		return StateKey{}, true
 	case uint64(count) <= firstChildLeafIndex+childLeafsAmount:
 		// Stage B: tree element was updated after last leaf insertion.
-		key := StateKey{h, x, count}
-		return m.state[key]
+		return StateKey{h, x, count}, false
 	default:
 		// Stage C: tree element was not updated after last leaf insertion.
 		// Use last saved value.
-		key := StateKey{h, x, uint32(firstChildLeafIndex + childLeafsAmount)}
-		return m.state[key]
+		return StateKey{h, x, uint32(firstChildLeafIndex + childLeafsAmount)}, false
 	}
 }
 
@@ -255,9 +383,3 @@ func getParent(leftChild []byte, rightChild []byte) []byte {
 
 	return crypto.Keccak256(append(leftChild, rightChild...))
 }
-
-// saveElementState stores the historical value for a given tree node.
-func saveElementState(m *HistoricalTree, h uint32, x uint32, count uint32, item []byte) {
-	key := StateKey{h, x, count}
-	m.state[key] = item
-}
@@ -23,11 +23,12 @@ func TestMerkleTree(t *testing.T) {
 	}
 	// Insert test leafs
 	for i := uint32(0); i < leafsAmount; i++ {
-		tree.Insert(leafs[i])
+		Nil(t, tree.Insert(leafs[i]))
 		Equal(t, tree.NumOfItems(), i+1)
 	}
 	// Check Items()
-	items := tree.Items()
+	items, err := tree.Items()
+	Nil(t, err)
 	Equal(t, len(items), int(leafsAmount))
 	for i := uint32(0); i < leafsAmount; i++ {
 		Equal(t, items[i], leafs[i])
@@ -72,7 +73,7 @@ func TestIncorrectRequests(t *testing.T) {
 	}
 	// Insert test leafs.
 	for i := uint32(0); i < leafsAmount; i++ {
-		tree.Insert(leafs[i])
+		Nil(t, tree.Insert(leafs[i]))
 	}
 	// Check Item() with index out of bound.
 	item, err := tree.Item(leafsAmount)
@@ -130,12 +131,14 @@ func TestNewTreeFromItems(t *testing.T) {
 	}
 	// Insert test leafs
 	for i := uint32(0); i < leafsAmount; i++ {
-		tree.Insert(leafs[i])
+		Nil(t, tree.Insert(leafs[i]))
 		Equal(t, tree.NumOfItems(), i+1)
 	}
 	// Get items and generate a new tree from them
-	items := tree.Items()
-	newTree := merkle.NewTreeFromItems(items, merkle.MessageTreeHeight)
+	items, err := tree.Items()
+	Nil(t, err)
+	newTree, err := merkle.NewTreeFromItems(items, merkle.MessageTreeHeight)
+	Nil(t, err)
 	// Check that the number of items are the same
 	Equal(t, tree.NumOfItems(), newTree.NumOfItems())
 	// Check that the new tree has the same root
@@ -146,6 +149,74 @@ func TestNewTreeFromItems(t *testing.T) {
 	Equal(t, root, newRoot)
 }
 
+func TestMultiProof(t *testing.T) {
+	tree := merkle.NewTree(merkle.MessageTreeHeight)
+	leafs := make([][]byte, leafsAmount)
+	for i := range leafs {
+		leafs[i] = fakeLeaf()
+		Nil(t, tree.Insert(leafs[i]))
+	}
+
+	for count := uint32(1); count <= leafsAmount; count += 7 {
+		root, err := tree.Root(count)
+		Nil(t, err)
+
+		// Pick a random, strictly increasing subset of indices.
+		var indices []uint32
+		var items [][]byte
+		singleProofsLength := 0
+		for index := uint32(0); index < count; index++ {
+			if index != count-1 && gofakeit.Bool() {
+				continue
+			}
+			indices = append(indices, index)
+			items = append(items, leafs[index])
+			singleProofsLength += int(merkle.MessageTreeHeight)
+		}
+
+		proof, err := tree.MultiProof(indices, count)
+		Nil(t, err)
+		// Shared and "zero" siblings should be de-duplicated.
+		Less(t, len(proof), singleProofsLength)
+
+		multiProofRoot, err := merkle.MultiProofRoot(items, indices, count, proof, merkle.MessageTreeHeight)
+		Nil(t, err)
+		Equal(t, root, multiProofRoot)
+		True(t, merkle.VerifyMultiProof(root, items, indices, count, proof, merkle.MessageTreeHeight))
+
+		// Tampering with an item should fail verification.
+		tampered := append([][]byte{}, items...)
+		tampered[0] = fakeLeaf()
+		False(t, merkle.VerifyMultiProof(root, tampered, indices, count, proof, merkle.MessageTreeHeight))
+		// Malformed proofs should fail verification.
+		False(t, merkle.VerifyMultiProof(root, items, indices, count, append(proof, fakeLeaf()), merkle.MessageTreeHeight))
+		if len(proof) > 0 {
+			False(t, merkle.VerifyMultiProof(root, items, indices, count, proof[1:], merkle.MessageTreeHeight))
+		}
+	}
+
+	// A single index multi proof should verify the same way as a regular proof.
+	proof, err := tree.MultiProof([]uint32{3}, leafsAmount)
+	Nil(t, err)
+	root, err := tree.Root(leafsAmount)
+	Nil(t, err)
+	True(t, merkle.VerifyMultiProof(root, [][]byte{leafs[3]}, []uint32{3}, leafsAmount, proof, merkle.MessageTreeHeight))
+
+	// Incorrect requests.
+	_, err = tree.MultiProof(nil, leafsAmount)
+	NotNil(t, err)
+	_, err = tree.MultiProof([]uint32{2, 1}, leafsAmount)
+	NotNil(t, err)
+	_, err = tree.MultiProof([]uint32{1, 1}, leafsAmount)
+	NotNil(t, err)
+	_, err = tree.MultiProof([]uint32{leafsAmount}, leafsAmount)
+	NotNil(t, err)
+	_, err = tree.MultiProof([]uint32{0}, leafsAmount+1)
+	NotNil(t, err)
+	_, err = merkle.MultiProofRoot([][]byte{leafs[0]}, []uint32{0, 1}, leafsAmount, proof, merkle.MessageTreeHeight)
+	NotNil(t, err)
+}
+
 func fakeLeaf() []byte {
 	leaf := make([]byte, 32)
 	for i := 0; i < 32; i++ {
@@ -0,0 +1,147 @@
+package merkle
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// MultiProof returns a single proof of inclusion for several leafs:
+//   - For leafs with given `indices` (must be strictly increasing)
+//   - At the time when `count` leafs have been inserted
+//
+// Siblings that can be computed from the proven leafs are not part of the proof, and neither
+// are "zero" siblings, since the verifier knows `count` as well. The proof elements are ordered
+// from leafs to root, and by x-coord within the same height.
+func (m *HistoricalTree) MultiProof(indices []uint32, count uint32) ([][]byte, error) {
+	if count > m.treeCount {
+		return nil, fmt.Errorf("not enough leafs; inserted: %d, requested proof for count: %d", m.treeCount, count)
+	}
+	if count < m.prunedBefore {
+		return nil, fmt.Errorf("historical state was pruned; pruned before: %d, requested proof for count: %d", m.prunedBefore, count)
+	}
+	if err := checkMultiProofIndices(indices, count); err != nil {
+		return nil, err
+	}
+
+	var proof [][]byte
+	level := append([]uint32{}, indices...)
+	for h := uint32(0); h < m.treeHeight; h++ {
+		parents := make([]uint32, 0, len(level))
+		for i := 0; i < len(level); i++ {
+			x := level[i]
+			siblingX := x ^ 1
+			switch {
+			case hasSiblingAt(level, i):
+				// Sibling is known to the verifier, skip it.
+				i++
+			case isZeroElement(h, siblingX, count):
+				// Sibling is a "zero" element, verifier can derive it.
+			default:
+				sibling, err := fetchTreeElementState(m, h, siblingX, count)
+				if err != nil {
+					return nil, err
+				}
+				proof = append(proof, sibling)
+			}
+			// Traverse to parent
+			parents = append(parents, x>>1)
+		}
+		level = parents
+	}
+	return proof, nil
+}
+
+// MultiProofRoot calculates the merkle root given the items, their indices, the amount of inserted
+// leafs and a proof generated by MultiProof.
+func MultiProofRoot(items [][]byte, indices []uint32, count uint32, proof [][]byte, treeHeight uint32) ([]byte, error) {
+	if len(items) != len(indices) {
+		return nil, fmt.Errorf("items and indices length mismatch: %d != %d", len(items), len(indices))
+	}
+	if treeHeight < 32 && uint64(count) > uint64(1)<<treeHeight {
+		return nil, fmt.Errorf("count %d does not fit into a tree of height %d", count, treeHeight)
+	}
+	if err := checkMultiProofIndices(indices, count); err != nil {
+		return nil, err
+	}
+
+	zeroHashes := generateZeroHashes(treeHeight)
+	nodes := append([][]byte{}, items...)
+	level := append([]uint32{}, indices...)
+	proofIndex := 0
+
+	for h := uint32(0); h < treeHeight; h++ {
+		parents := make([]uint32, 0, len(level))
+		parentNodes := make([][]byte, 0, len(level))
+		for i := 0; i < len(level); i++ {
+			x := level[i]
+			node := nodes[i]
+
+			var sibling []byte
+			switch {
+			case hasSiblingAt(level, i):
+				i++
+				sibling = nodes[i]
+			case isZeroElement(h, x^1, count):
+				sibling = zeroHashes[h]
+			default:
+				if proofIndex >= len(proof) {
+					return nil, fmt.Errorf("proof is too short: %d elements", len(proof))
+				}
+				sibling = proof[proofIndex]
+				proofIndex++
+			}
+
+			if x&1 == 0 {
+				// We were the left child
+				parentNodes = append(parentNodes, getParent(node, sibling))
+			} else {
+				// We were the right child
+				parentNodes = append(parentNodes, getParent(sibling, node))
+			}
+			parents = append(parents, x>>1)
+		}
+		level = parents
+		nodes = parentNodes
+	}
+
+	if proofIndex != len(proof) {
+		return nil, fmt.Errorf("proof is too long: used %d out of %d elements", proofIndex, len(proof))
+	}
+	return nodes[0], nil
+}
+
+// VerifyMultiProof verifies a proof generated by MultiProof against a root of a tree.
+func VerifyMultiProof(root []byte, items [][]byte, indices []uint32, count uint32, proof [][]byte, treeHeight uint32) bool {
+	multiProofRoot, err := MultiProofRoot(items, indices, count, proof, treeHeight)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(root, multiProofRoot)
+}
+
+// checkMultiProofIndices makes sure indices are non-empty, strictly increasing and less than count.
+func checkMultiProofIndices(indices []uint32, count uint32) error {
+	if len(indices) == 0 {
+		return fmt.Errorf("no indices requested")
+	}
+	for i, index := range indices {
+		if index >= count {
+			return fmt.Errorf("merkle index out of range; count: %d, requested proof for index: %d", count, index)
+		}
+		if i > 0 && index <= indices[i-1] {
+			return fmt.Errorf("indices must be strictly increasing; got %d after %d", index, indices[i-1])
+		}
+	}
+	return nil
+}
+
+// hasSiblingAt returns true if level[i] is a left child and its right sibling is level[i+1].
+func hasSiblingAt(level []uint32, i int) bool {
+	return level[i]&1 == 0 && i+1 < len(level) && level[i+1] == level[i]^1
+}
+
+// isZeroElement returns true if the element with [height = H] and [x-coord = X] is still
+// a "zero" element when `count` leafs were inserted.
+func isZeroElement(h uint32, x uint32, count uint32) bool {
+	return uint64(count) <= uint64(x)<<h
+}
@@ -0,0 +1,154 @@
+package merkle
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// snapshotVersion is the version of the binary snapshot format.
+const snapshotVersion uint8 = 1
+
+// snapshotEntryHeaderSize is the size of an entry's fixed fields: height, x-coord, count and value length.
+const snapshotEntryHeaderSize = 16
+
+// MarshalBinary serializes the tree into a snapshot. The snapshot holds every stored element
+// so it can be restored into any Store with UnmarshalBinary.
+//
+// Layout (big endian): version (1 byte), tree height, tree count, pruned before, entry count (4 bytes each),
+// followed by every entry as height, x-coord, count, value length (4 bytes each) and the value.
+func (m *HistoricalTree) MarshalBinary() ([]byte, error) {
+	entries, err := m.store.Entries()
+	if err != nil {
+		return nil, fmt.Errorf("could not get tree entries: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(snapshotVersion)
+	for _, field := range []uint32{m.treeHeight, m.treeCount, m.prunedBefore, uint32(len(entries))} {
+		writeUint32(&buf, field)
+	}
+
+	for _, entry := range entries {
+		writeUint32(&buf, entry.Key.h)
+		writeUint32(&buf, entry.Key.x)
+		writeUint32(&buf, entry.Key.count)
+		writeUint32(&buf, uint32(len(entry.Value)))
+		buf.Write(entry.Value)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary restores a snapshot created by MarshalBinary. If the tree has no store yet
+// (e.g. a zero value HistoricalTree), an in-memory store is used. The store must be empty, and the snapshot is
+// rejected otherwise.
+func (m *HistoricalTree) UnmarshalBinary(data []byte) error {
+	reader := bytes.NewReader(data)
+
+	version, err := reader.ReadByte()
+	if err != nil {
+		return fmt.Errorf("could not read snapshot version: %w", err)
+	}
+	if version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version: %d", version)
+	}
+
+	var header [4]uint32
+	for i := range header {
+		header[i], err = readUint32(reader)
+		if err != nil {
+			return fmt.Errorf("could not read snapshot header: %w", err)
+		}
+	}
+	treeHeight, treeCount, prunedBefore, entryCount := header[0], header[1], header[2], header[3]
+
+	// the header is untrusted, so it's checked before it's used to size anything.
+	if treeHeight > MessageTreeHeight {
+		return fmt.Errorf("snapshot tree height %d exceeds the max height %d", treeHeight, MessageTreeHeight)
+	}
+	if int64(entryCount)*snapshotEntryHeaderSize > int64(reader.Len()) {
+		return fmt.Errorf("snapshot has %d entries but only %d bytes left: %w", entryCount, reader.Len(), io.ErrUnexpectedEOF)
+	}
+
+	entries := make([]StateEntry, 0, entryCount)
+	for i := uint32(0); i < entryCount; i++ {
+		var fields [4]uint32
+		for j := range fields {
+			fields[j], err = readUint32(reader)
+			if err != nil {
+				return fmt.Errorf("could not read snapshot entry %d: %w", i, err)
+			}
+		}
+
+		if int64(fields[3]) > int64(reader.Len()) {
+			return fmt.Errorf("could not read snapshot entry %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		value := make([]byte, fields[3])
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return fmt.Errorf("could not read snapshot entry %d: %w", i, err)
+		}
+
+		entries = append(entries, StateEntry{Key: StateKey{fields[0], fields[1], fields[2]}, Value: value})
+	}
+
+	if reader.Len() != 0 {
+		return errors.New("unexpected trailing bytes in snapshot")
+	}
+
+	if m.store == nil {
+		m.store = NewMemoryStore()
+	}
+
+	storeCount, err := m.store.Count()
+	if err != nil {
+		return fmt.Errorf("could not get store count: %w", err)
+	}
+	storePrunedBefore, err := m.store.PrunedBefore()
+	if err != nil {
+		return fmt.Errorf("could not get store pruned count: %w", err)
+	}
+	if storeCount != 0 || storePrunedBefore != 0 {
+		return errors.New("cannot restore a snapshot into a non-empty store")
+	}
+
+	if err := m.store.Commit(entries, treeCount); err != nil {
+		return fmt.Errorf("could not restore tree state: %w", err)
+	}
+	// recording the boundary is enough, pruned entries are not part of the snapshot.
+	if prunedBefore > 0 {
+		if err := m.store.PruneBefore(prunedBefore); err != nil {
+			return fmt.Errorf("could not restore pruned boundary: %w", err)
+		}
+	}
+
+	m.zeroHashes = generateZeroHashes(treeHeight)
+	m.treeHeight = treeHeight
+	m.treeCount = treeCount
+	m.prunedBefore = prunedBefore
+
+	return nil
+}
+
+func writeUint32(buf *bytes.Buffer, value uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], value)
+	buf.Write(b[:])
+}
+
+func readUint32(reader io.Reader) (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(reader, b[:]); err != nil {
+		//nolint: wrapcheck
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+var (
+	_ encoding.BinaryMarshaler   = &HistoricalTree{}
+	_ encoding.BinaryUnmarshaler = &HistoricalTree{}
+)
@@ -0,0 +1,97 @@
+package merkle
+
+import (
+	"sort"
+)
+
+// StateEntry is the value of a tree element at a given historical state.
+type StateEntry struct {
+	Key   StateKey
+	Value []byte
+}
+
+// Store is a key-value store that holds the historical state of a HistoricalTree.
+type Store interface {
+	// Get returns the value stored for key, or nil if nothing is stored.
+	Get(key StateKey) ([]byte, error)
+	// Commit atomically stores the elements updated by an insertion alongside the new leaf count.
+	Commit(entries []StateEntry, count uint32) error
+	// Count returns the leaf count recorded by the last commit.
+	Count() (uint32, error)
+	// PruneBefore removes every non-final element whose count is less than `count`
+	// (see StateKey.IsFinal) and records `count` as the new pruned boundary.
+	PruneBefore(count uint32) error
+	// PrunedBefore returns the boundary recorded by the last PruneBefore.
+	PrunedBefore() (uint32, error)
+	// Entries returns every stored element sorted by height, x-coord and count.
+	Entries() ([]StateEntry, error)
+}
+
+// memoryStore is an in-memory Store.
+type memoryStore struct {
+	state        map[StateKey][]byte
+	count        uint32
+	prunedBefore uint32
+}
+
+// NewMemoryStore creates a new in-memory store.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		state: make(map[StateKey][]byte),
+	}
+}
+
+func (m *memoryStore) Get(key StateKey) ([]byte, error) {
+	return m.state[key], nil
+}
+
+func (m *memoryStore) Commit(entries []StateEntry, count uint32) error {
+	for _, entry := range entries {
+		m.state[entry.Key] = entry.Value
+	}
+	m.count = count
+	return nil
+}
+
+func (m *memoryStore) Count() (uint32, error) {
+	return m.count, nil
+}
+
+func (m *memoryStore) PruneBefore(count uint32) error {
+	for key := range m.state {
+		if key.count < count && !key.IsFinal() {
+			delete(m.state, key)
+		}
+	}
+	m.prunedBefore = count
+	return nil
+}
+
+func (m *memoryStore) PrunedBefore() (uint32, error) {
+	return m.prunedBefore, nil
+}
+
+func (m *memoryStore) Entries() ([]StateEntry, error) {
+	entries := make([]StateEntry, 0, len(m.state))
+	for key, value := range m.state {
+		entries = append(entries, StateEntry{Key: key, Value: value})
+	}
+	SortEntries(entries)
+	return entries, nil
+}
+
+// SortEntries sorts entries by height, x-coord and count.
+func SortEntries(entries []StateEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].Key, entries[j].Key
+		if a.h != b.h {
+			return a.h < b.h
+		}
+		if a.x != b.x {
+			return a.x < b.x
+		}
+		return a.count < b.count
+	})
+}
+
+var _ Store = &memoryStore{}
@@ -0,0 +1,133 @@
+package merkle_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/merkle"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	tree := merkle.NewTree(merkle.MessageTreeHeight)
+	for i := uint32(0); i < leafsAmount; i++ {
+		Nil(t, tree.Insert(fakeLeaf()))
+	}
+
+	snapshot, err := tree.MarshalBinary()
+	Nil(t, err)
+
+	var restored merkle.HistoricalTree
+	Nil(t, restored.UnmarshalBinary(snapshot))
+	Equal(t, tree.NumOfItems(), restored.NumOfItems())
+
+	for count := uint32(1); count <= leafsAmount; count++ {
+		root, err := tree.Root(count)
+		Nil(t, err)
+		restoredRoot, err := restored.Root(count)
+		Nil(t, err)
+		Equal(t, root, restoredRoot)
+	}
+
+	// Inserting into the restored tree should keep both trees in sync.
+	leaf := fakeLeaf()
+	Nil(t, tree.Insert(leaf))
+	Nil(t, restored.Insert(leaf))
+
+	root, err := tree.Root(leafsAmount + 1)
+	Nil(t, err)
+	restoredRoot, err := restored.Root(leafsAmount + 1)
+	Nil(t, err)
+	Equal(t, root, restoredRoot)
+
+	// Corrupted snapshots should be rejected.
+	NotNil(t, (&merkle.HistoricalTree{}).UnmarshalBinary(snapshot[:len(snapshot)-1]))
+	NotNil(t, (&merkle.HistoricalTree{}).UnmarshalBinary(append(snapshot, 0)))
+}
+
+func TestPruneBefore(t *testing.T) {
+	tree := merkle.NewTree(merkle.MessageTreeHeight)
+	leafs := make([][]byte, leafsAmount)
+	for i := range leafs {
+		leafs[i] = fakeLeaf()
+		Nil(t, tree.Insert(leafs[i]))
+	}
+
+	// Record roots and proofs before pruning.
+	const pruneBefore = leafsAmount / 2
+	roots := make(map[uint32][]byte)
+	proofs := make(map[uint32][][][]byte)
+	for count := pruneBefore; count <= leafsAmount; count++ {
+		root, err := tree.Root(count)
+		Nil(t, err)
+		roots[count] = root
+		for index := uint32(0); index < count; index++ {
+			proof, err := tree.MerkleProof(index, count)
+			Nil(t, err)
+			proofs[count] = append(proofs[count], proof)
+		}
+	}
+
+	beforePrune, err := tree.MarshalBinary()
+	Nil(t, err)
+
+	Nil(t, tree.PruneBefore(pruneBefore))
+	Equal(t, pruneBefore, tree.PrunedBefore())
+	NotNil(t, tree.PruneBefore(leafsAmount+1))
+
+	afterPrune, err := tree.MarshalBinary()
+	Nil(t, err)
+	Less(t, len(afterPrune), len(beforePrune))
+
+	// Roots and proofs that can still be requested must stay identical.
+	for count := pruneBefore; count <= leafsAmount; count++ {
+		root, err := tree.Root(count)
+		Nil(t, err)
+		Equal(t, roots[count], root)
+		for index := uint32(0); index < count; index++ {
+			proof, err := tree.MerkleProof(index, count)
+			Nil(t, err)
+			Equal(t, proofs[count][index], proof)
+		}
+	}
+
+	// Older states can no longer be requested.
+	_, err = tree.Root(pruneBefore - 1)
+	NotNil(t, err)
+	_, err = tree.MerkleProof(0, pruneBefore-1)
+	NotNil(t, err)
+
+	// Items are never pruned.
+	items, err := tree.Items()
+	Nil(t, err)
+	Equal(t, leafs, items)
+
+	// The boundary survives a snapshot.
+	var restored merkle.HistoricalTree
+	Nil(t, restored.UnmarshalBinary(afterPrune))
+	Equal(t, pruneBefore, restored.PrunedBefore())
+}
+
+func TestUnmarshalBinaryUntrustedHeader(t *testing.T) {
+	tree := merkle.NewTree(merkle.MessageTreeHeight)
+	Nil(t, tree.Insert(fakeLeaf()))
+	snapshot, err := tree.MarshalBinary()
+	Nil(t, err)
+
+	// header fields are big endian uint32s after the version byte: height, count, pruned before, entry count.
+	withField := func(offset int, value uint32) []byte {
+		corrupted := append([]byte{}, snapshot...)
+		binary.BigEndian.PutUint32(corrupted[1+4*offset:], value)
+		return corrupted
+	}
+
+	NotNil(t, (&merkle.HistoricalTree{}).UnmarshalBinary(withField(0, merkle.MessageTreeHeight+1)))
+	NotNil(t, (&merkle.HistoricalTree{}).UnmarshalBinary(withField(3, math.MaxUint32)))
+
+	// snapshots are only restored into empty stores.
+	NotNil(t, tree.UnmarshalBinary(snapshot))
+
+	var restored merkle.HistoricalTree
+	Nil(t, restored.UnmarshalBinary(snapshot))
+}
@@ -64,15 +64,23 @@ func (b *baseHandler) Start(ctx context.Context) error {
 
 	// TODO: allow this to be customizable, separate from the tracer provider.
 	// in a way that's still usable.
-	b.meter = sdkmetric.NewMeterProvider(
+	meterProvider := sdkmetric.NewMeterProvider(
 		sdkmetric.WithResource(b.resource),
 		// TODO: figure out how to provide a sample interval here
 		// see: https://github.com/open-telemetry/opentelemetry-go/issues/3244
 		// for more on the trade-off space
 		sdkmetric.WithReader(reader),
 	)
+
+	b.startMeter(ctx, meterProvider, promhttp.Handler())
+	return nil
+}
+
+// startMeter registers the meter provider globally and serves handler on the metrics port.
+func (b *baseHandler) startMeter(ctx context.Context, meterProvider MeterProvider, handler http.Handler) {
+	b.meter = meterProvider
 	otel.SetMeterProvider(b.meter)
-	b.handler = promhttp.Handler()
+	b.handler = handler
 
 	go func() {
 		<-ctx.Done()
@@ -83,8 +91,6 @@ func (b *baseHandler) Start(ctx context.Context) error {
 	go func() {
 		b.startMetricsServer(ctx)
 	}()
-
-	return nil
 }
 
 const (
@@ -0,0 +1,253 @@
+package metrics
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// exemplarTraceIDLabel is the exemplar label holding the trace id, as expected by grafana.
+	exemplarTraceIDLabel = "trace_id"
+	// exemplarSpanIDLabel is the exemplar label holding the span id.
+	exemplarSpanIDLabel = "span_id"
+	// maxExemplarsPerSeries is the number of exemplars kept for each histogram series.
+	// prometheus keeps at most one exemplar per bucket, so a handful is enough.
+	maxExemplarsPerSeries = 10
+	// maxExemplarSeries is the number of timeseries exemplars are kept for. Once reached, the least recently
+	// recorded series is evicted, so memory stays bounded with high label cardinality.
+	maxExemplarSeries = 10_000
+	// maxExemplarAge is how long a series keeps its exemplars without new measurements. Stale series are evicted
+	// when metrics are collected.
+	maxExemplarAge = 15 * time.Minute
+)
+
+// exemplarKey identifies a single timeseries of an instrument.
+type exemplarKey struct {
+	scope      string
+	instrument string
+	attributes attribute.Distinct
+}
+
+// exemplarStore keeps the most recent exemplars of every timeseries, so they can be attached when metrics are collected.
+type exemplarStore struct {
+	mux sync.Mutex
+	// series holds the list element of each timeseries.
+	series map[exemplarKey]*list.Element
+	// recency orders the timeseries from most to least recently recorded.
+	recency *list.List
+	// maxSeries is the number of timeseries exemplars are kept for.
+	maxSeries int
+}
+
+// exemplarSeries is the exemplars of a timeseries.
+type exemplarSeries struct {
+	key       exemplarKey
+	exemplars []prometheus.Exemplar
+}
+
+func newExemplarStore() *exemplarStore {
+	return &exemplarStore{
+		series:    make(map[exemplarKey]*list.Element),
+		recency:   list.New(),
+		maxSeries: maxExemplarSeries,
+	}
+}
+
+// record stores an exemplar for the measurement if ctx holds a sampled span.
+func (e *exemplarStore) record(ctx context.Context, key exemplarKey, value float64) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsSampled() {
+		return
+	}
+
+	exemplar := prometheus.Exemplar{
+		Value: value,
+		Labels: prometheus.Labels{
+			exemplarTraceIDLabel: spanContext.TraceID().String(),
+			exemplarSpanIDLabel:  spanContext.SpanID().String(),
+		},
+		Timestamp: time.Now(),
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	element, ok := e.series[key]
+	if !ok {
+		if e.recency.Len() >= e.maxSeries {
+			e.remove(e.recency.Back())
+		}
+		element = e.recency.PushFront(&exemplarSeries{key: key})
+		e.series[key] = element
+	}
+	e.recency.MoveToFront(element)
+
+	series := element.Value.(*exemplarSeries)
+	series.exemplars = append(series.exemplars, exemplar)
+	if len(series.exemplars) > maxExemplarsPerSeries {
+		series.exemplars = series.exemplars[len(series.exemplars)-maxExemplarsPerSeries:]
+	}
+}
+
+// get returns a copy of the exemplars of the timeseries.
+func (e *exemplarStore) get(key exemplarKey) []prometheus.Exemplar {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	element, ok := e.series[key]
+	if !ok {
+		return nil
+	}
+	return append([]prometheus.Exemplar(nil), element.Value.(*exemplarSeries).exemplars...)
+}
+
+// evictBefore evicts the timeseries that have not recorded an exemplar since cutoff.
+func (e *exemplarStore) evictBefore(cutoff time.Time) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	for element := e.recency.Back(); element != nil; element = e.recency.Back() {
+		exemplars := element.Value.(*exemplarSeries).exemplars
+		if !exemplars[len(exemplars)-1].Timestamp.Before(cutoff) {
+			return
+		}
+		e.remove(element)
+	}
+}
+
+// seriesCount returns the number of timeseries exemplars are kept for.
+func (e *exemplarStore) seriesCount() int {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	return e.recency.Len()
+}
+
+// remove removes a timeseries. The lock must be held.
+func (e *exemplarStore) remove(element *list.Element) {
+	e.recency.Remove(element)
+	delete(e.series, element.Value.(*exemplarSeries).key)
+}
+
+// exemplarMeterProvider wraps a meter provider so counters and histograms record exemplars.
+type exemplarMeterProvider struct {
+	MeterProvider
+	store *exemplarStore
+}
+
+func newExemplarMeterProvider(meterProvider MeterProvider, store *exemplarStore) MeterProvider {
+	return &exemplarMeterProvider{
+		MeterProvider: meterProvider,
+		store:         store,
+	}
+}
+
+func (e *exemplarMeterProvider) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	return &exemplarMeter{
+		Meter: e.MeterProvider.Meter(name, opts...),
+		scope: name,
+		store: e.store,
+	}
+}
+
+// exemplarMeter wraps synchronous counters and histograms. Other instruments are passed through.
+type exemplarMeter struct {
+	metric.Meter
+	scope string
+	store *exemplarStore
+}
+
+func (e *exemplarMeter) key(instrument string, attributes attribute.Set) exemplarKey {
+	return exemplarKey{
+		scope:      e.scope,
+		instrument: instrument,
+		attributes: attributes.Equivalent(),
+	}
+}
+
+//nolint:wrapcheck
+func (e *exemplarMeter) Int64Counter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	counter, err := e.Meter.Int64Counter(name, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &int64CounterWithExemplars{Int64Counter: counter, meter: e, name: name}, nil
+}
+
+//nolint:wrapcheck
+func (e *exemplarMeter) Float64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	counter, err := e.Meter.Float64Counter(name, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &float64CounterWithExemplars{Float64Counter: counter, meter: e, name: name}, nil
+}
+
+//nolint:wrapcheck
+func (e *exemplarMeter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	histogram, err := e.Meter.Int64Histogram(name, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &int64HistogramWithExemplars{Int64Histogram: histogram, meter: e, name: name}, nil
+}
+
+//nolint:wrapcheck
+func (e *exemplarMeter) Float64Histogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	histogram, err := e.Meter.Float64Histogram(name, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &float64HistogramWithExemplars{Float64Histogram: histogram, meter: e, name: name}, nil
+}
+
+type int64CounterWithExemplars struct {
+	metric.Int64Counter
+	meter *exemplarMeter
+	name  string
+}
+
+func (c *int64CounterWithExemplars) Add(ctx context.Context, incr int64, options ...metric.AddOption) {
+	c.Int64Counter.Add(ctx, incr, options...)
+	c.meter.store.record(ctx, c.meter.key(c.name, metric.NewAddConfig(options).Attributes()), float64(incr))
+}
+
+type float64CounterWithExemplars struct {
+	metric.Float64Counter
+	meter *exemplarMeter
+	name  string
+}
+
+func (c *float64CounterWithExemplars) Add(ctx context.Context, incr float64, options ...metric.AddOption) {
+	c.Float64Counter.Add(ctx, incr, options...)
+	c.meter.store.record(ctx, c.meter.key(c.name, metric.NewAddConfig(options).Attributes()), incr)
+}
+
+type int64HistogramWithExemplars struct {
+	metric.Int64Histogram
+	meter *exemplarMeter
+	name  string
+}
+
+func (h *int64HistogramWithExemplars) Record(ctx context.Context, incr int64, options ...metric.RecordOption) {
+	h.Int64Histogram.Record(ctx, incr, options...)
+	h.meter.store.record(ctx, h.meter.key(h.name, metric.NewRecordConfig(options).Attributes()), float64(incr))
+}
+
+type float64HistogramWithExemplars struct {
+	metric.Float64Histogram
+	meter *exemplarMeter
+	name  string
+}
+
+func (h *float64HistogramWithExemplars) Record(ctx context.Context, incr float64, options ...metric.RecordOption) {
+	h.Float64Histogram.Record(ctx, incr, options...)
+	h.meter.store.record(ctx, h.meter.key(h.name, metric.NewRecordConfig(options).Attributes()), incr)
+}
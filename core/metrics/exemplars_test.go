@@ -0,0 +1,58 @@
+package metrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sampledContext() context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
+
+func TestExemplarStoreEvictsLeastRecentSeries(t *testing.T) {
+	store := metrics.NewExemplarStore(2)
+
+	attributes := make([]attribute.Set, 3)
+	for i := range attributes {
+		attributes[i] = attribute.NewSet(attribute.String("chain", fmt.Sprint(i)))
+	}
+
+	// measurements outside of a sampled span are not stored.
+	store.Record(context.Background(), "test", attributes[0], 1)
+	Equal(t, 0, store.SeriesCount())
+
+	store.Record(sampledContext(), "test", attributes[0], 1)
+	store.Record(sampledContext(), "test", attributes[1], 1)
+	// recording again makes the first series the most recent one.
+	store.Record(sampledContext(), "test", attributes[0], 1)
+	store.Record(sampledContext(), "test", attributes[2], 1)
+
+	Equal(t, 2, store.SeriesCount())
+	Equal(t, 2, store.Len("test", attributes[0]))
+	Equal(t, 0, store.Len("test", attributes[1]))
+	Equal(t, 1, store.Len("test", attributes[2]))
+}
+
+func TestExemplarStoreEvictsStaleSeries(t *testing.T) {
+	store := metrics.NewExemplarStore(10)
+	attributes := attribute.NewSet(attribute.String("chain", "1"))
+
+	store.Record(sampledContext(), "test", attributes, 1)
+	store.EvictBefore(time.Now().Add(-time.Minute))
+	Equal(t, 1, store.SeriesCount())
+
+	store.EvictBefore(time.Now().Add(time.Minute))
+	Equal(t, 0, store.SeriesCount())
+	Equal(t, 0, store.Len("test", attributes))
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ExemplarStore exports the exemplar store for testing.
+type ExemplarStore struct {
+	store *exemplarStore
+}
+
+// NewExemplarStore creates an exemplar store that keeps exemplars for at most maxSeries timeseries.
+func NewExemplarStore(maxSeries int) *ExemplarStore {
+	store := newExemplarStore()
+	store.maxSeries = maxSeries
+	return &ExemplarStore{store: store}
+}
+
+// Record records an exemplar for the instrument with the given attributes.
+func (e *ExemplarStore) Record(ctx context.Context, instrument string, attributes attribute.Set, value float64) {
+	e.store.record(ctx, exemplarKey{instrument: instrument, attributes: attributes.Equivalent()}, value)
+}
+
+// Len returns the number of exemplars kept for the instrument with the given attributes.
+func (e *ExemplarStore) Len(instrument string, attributes attribute.Set) int {
+	return len(e.store.get(exemplarKey{instrument: instrument, attributes: attributes.Equivalent()}))
+}
+
+// SeriesCount returns the number of timeseries exemplars are kept for.
+func (e *ExemplarStore) SeriesCount() int {
+	return e.store.seriesCount()
+}
+
+// EvictBefore evicts the timeseries that have not recorded an exemplar since cutoff.
+func (e *ExemplarStore) EvictBefore(cutoff time.Time) {
+	e.store.evictBefore(cutoff)
+}
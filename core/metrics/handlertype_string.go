@@ -11,11 +11,13 @@ func _() {
 	_ = x[OTLP-1]
 	_ = x[Jaeger-2]
 	_ = x[Null-3]
+	_ = x[Prometheus-4]
+	_ = x[File-5]
 }
 
-const _HandlerType_name = "OTLPJaegerNull"
+const _HandlerType_name = "OTLPJaegerNullPrometheusFile"
 
-var _HandlerType_index = [...]uint8{0, 4, 10, 14}
+var _HandlerType_index = [...]uint8{0, 4, 10, 14, 24, 28}
 
 func (i HandlerType) String() string {
 	i -= 1
@@ -69,6 +69,10 @@ func init() {
 	Jaeger // Jaeger
 	// Null is a null data type handler.
 	Null // Null
+	// Prometheus serves metrics directly to prometheus and exports traces over otlp.
+	Prometheus // Prometheus
+	// File records spans and metric points in memory and, if RecorderFileEnv is set, to a JSONL file.
+	File // File
 )
 
 // Lower gets the lowercase version of the handler type. Useful for comparison
@@ -93,6 +97,10 @@ func NewFromEnv(ctx context.Context, buildInfo config.BuildInfo) (handler Handle
 		ht = Jaeger
 	case Null.Lower():
 		ht = Null
+	case Prometheus.Lower():
+		ht = Prometheus
+	case File.Lower():
+		ht = File
 	default:
 		ht = Null
 	}
@@ -110,6 +118,10 @@ func NewByType(ctx context.Context, buildInfo config.BuildInfo, ht HandlerType)
 		handler = NewJaegerHandler(buildInfo)
 	case Null:
 		handler = NewNullHandler()
+	case Prometheus:
+		handler = NewPrometheusMetricsHandler(buildInfo)
+	case File:
+		handler = NewRecorderHandler(buildInfo, os.Getenv(RecorderFileEnv))
 	default:
 		handler = NewNullHandler()
 	}
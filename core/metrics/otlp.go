@@ -27,23 +27,12 @@ func NewOTLPMetricsHandler(buildInfo config.BuildInfo) Handler {
 }
 
 func (n *otlpHandler) Start(ctx context.Context) (err error) {
-	var client otlptrace.Client
-	transport := transportFromString(core.GetEnv(otlpTransportEnv, otlpTransportGRPC.String()))
-	switch transport {
-	case otlpTransportHTTP:
-		client = otlptracehttp.NewClient()
-	case otlpTransportGRPC:
-		client = otlptracegrpc.NewClient()
-	default:
-		return fmt.Errorf("unknown transport type: %s", os.Getenv(otlpTransportEnv))
-	}
-
-	exporter, err := otlptrace.New(ctx, client)
+	tracerOpts, err := otlpTracerProviderOptions(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create otlp exporter: %w", err)
+		return err
 	}
 
-	n.baseHandler = newBaseHandler(n.buildInfo, tracesdk.WithBatcher(exporter, tracesdk.WithMaxQueueSize(1000000), tracesdk.WithMaxExportBatchSize(2000)), tracesdk.WithSampler(tracesdk.AlwaysSample()))
+	n.baseHandler = newBaseHandler(n.buildInfo, tracerOpts...)
 
 	// start the new parent
 	err = n.baseHandler.Start(ctx)
@@ -58,6 +47,40 @@ func (n *otlpHandler) Type() HandlerType {
 	return OTLP
 }
 
+// otlpTracerProviderOptions creates an otlp trace exporter from the environment and returns
+// the tracer provider options exporting to it.
+func otlpTracerProviderOptions(ctx context.Context) ([]tracesdk.TracerProviderOption, error) {
+	exporter, err := newOTLPExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []tracesdk.TracerProviderOption{
+		tracesdk.WithBatcher(exporter, tracesdk.WithMaxQueueSize(1000000), tracesdk.WithMaxExportBatchSize(2000)),
+		tracesdk.WithSampler(tracesdk.AlwaysSample()),
+	}, nil
+}
+
+// newOTLPExporter creates an otlp trace exporter using the transport from the environment.
+func newOTLPExporter(ctx context.Context) (*otlptrace.Exporter, error) {
+	var client otlptrace.Client
+	transport := transportFromString(core.GetEnv(otlpTransportEnv, otlpTransportGRPC.String()))
+	switch transport {
+	case otlpTransportHTTP:
+		client = otlptracehttp.NewClient()
+	case otlpTransportGRPC:
+		client = otlptracegrpc.NewClient()
+	default:
+		return nil, fmt.Errorf("unknown transport type: %s", os.Getenv(otlpTransportEnv))
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+	}
+	return exporter, nil
+}
+
 const (
 	otlpTransportEnv = "OTEL_EXPORTER_OTLP_TRANSPORT"
 )
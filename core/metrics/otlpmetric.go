@@ -0,0 +1,354 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/synapsecns/sanguine/core"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// the otlp metric exporters of the otel sdk require a newer sdk/metric than the prometheus exporter supports,
+// so metrics are exported with the otlp protos directly. The exporter follows the otlp exporter environment variables.
+const (
+	otlpEndpointEnv        = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otlpMetricsEndpointEnv = "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT"
+	otlpHeadersEnv         = "OTEL_EXPORTER_OTLP_HEADERS"
+	otlpMetricsHeadersEnv  = "OTEL_EXPORTER_OTLP_METRICS_HEADERS"
+	otlpInsecureEnv        = "OTEL_EXPORTER_OTLP_INSECURE"
+	otlpTimeoutEnv         = "OTEL_EXPORTER_OTLP_TIMEOUT"
+
+	otlpGRPCEndpointDefault = "localhost:4317"
+	otlpHTTPEndpointDefault = "http://localhost:4318"
+	otlpHTTPMetricsPath     = "/v1/metrics"
+	// otlpTimeoutDefault is the default export timeout, in milliseconds.
+	otlpTimeoutDefault = 10000
+)
+
+// otlpMetricExporter exports metrics over otlp grpc or http.
+type otlpMetricExporter struct {
+	headers map[string]string
+	timeout time.Duration
+	// conn and client are set for the grpc transport.
+	conn   *grpc.ClientConn
+	client colmetricpb.MetricsServiceClient
+	// endpoint is the url metrics are posted to with the http transport.
+	endpoint string
+}
+
+// newOTLPMetricExporter creates an otlp metric exporter using the transport from the environment.
+func newOTLPMetricExporter(ctx context.Context) (*otlpMetricExporter, error) {
+	exporter := &otlpMetricExporter{
+		headers: parseOTLPHeaders(core.GetEnv(otlpMetricsHeadersEnv, os.Getenv(otlpHeadersEnv))),
+		timeout: time.Duration(core.GetEnvInt(otlpTimeoutEnv, otlpTimeoutDefault)) * time.Millisecond,
+	}
+	endpoint := core.GetEnv(otlpMetricsEndpointEnv, os.Getenv(otlpEndpointEnv))
+
+	transport := transportFromString(core.GetEnv(otlpTransportEnv, otlpTransportGRPC.String()))
+	switch transport {
+	case otlpTransportHTTP:
+		if endpoint == "" {
+			endpoint = otlpHTTPEndpointDefault
+		}
+		// the signal specific endpoint is used as is, the generic one gets the metrics path.
+		if os.Getenv(otlpMetricsEndpointEnv) == "" {
+			endpoint = strings.TrimSuffix(endpoint, "/") + otlpHTTPMetricsPath
+		}
+		exporter.endpoint = endpoint
+	case otlpTransportGRPC:
+		if endpoint == "" {
+			endpoint = otlpGRPCEndpointDefault
+		}
+		target, creds, err := otlpGRPCTarget(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		//nolint: staticcheck
+		exporter.conn, err = grpc.DialContext(ctx, target, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("could not dial otlp endpoint: %w", err)
+		}
+		exporter.client = colmetricpb.NewMetricsServiceClient(exporter.conn)
+	default:
+		return nil, fmt.Errorf("unknown transport type: %s", os.Getenv(otlpTransportEnv))
+	}
+
+	return exporter, nil
+}
+
+// otlpGRPCTarget gets the grpc target and credentials of an endpoint, which is either host:port or a url.
+func otlpGRPCTarget(endpoint string) (string, credentials.TransportCredentials, error) {
+	useInsecure := core.GetEnvBool(otlpInsecureEnv, false)
+	if !strings.Contains(endpoint, "://") {
+		if useInsecure {
+			return endpoint, insecure.NewCredentials(), nil
+		}
+		return endpoint, credentials.NewTLS(nil), nil
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not parse otlp endpoint: %w", err)
+	}
+	if parsed.Scheme == "http" || useInsecure {
+		return parsed.Host, insecure.NewCredentials(), nil
+	}
+	return parsed.Host, credentials.NewTLS(nil), nil
+}
+
+// parseOTLPHeaders parses headers in the key1=value1,key2=value2 format of the otlp environment variables.
+func parseOTLPHeaders(raw string) map[string]string {
+	headers := make(map[string]string)
+	for _, header := range strings.Split(raw, ",") {
+		key, value, ok := strings.Cut(header, "=")
+		if !ok {
+			continue
+		}
+		key, keyErr := url.QueryUnescape(strings.TrimSpace(key))
+		value, valueErr := url.QueryUnescape(strings.TrimSpace(value))
+		if keyErr != nil || valueErr != nil || key == "" {
+			continue
+		}
+		headers[key] = value
+	}
+	return headers
+}
+
+func (o *otlpMetricExporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(kind)
+}
+
+func (o *otlpMetricExporter) Aggregation(kind sdkmetric.InstrumentKind) aggregation.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+func (o *otlpMetricExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	req := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{resourceMetricsToProto(rm)},
+	}
+
+	if o.client != nil {
+		_, err := o.client.Export(metadata.NewOutgoingContext(ctx, metadata.New(o.headers)), req)
+		if err != nil {
+			return fmt.Errorf("could not export metrics: %w", err)
+		}
+		return nil
+	}
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("could not encode metrics: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, o.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	for key, value := range o.headers {
+		httpReq.Header.Set(key, value)
+	}
+
+	//nolint: bodyclose
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("could not export metrics: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("could not export metrics: %s", resp.Status)
+	}
+	return nil
+}
+
+func (o *otlpMetricExporter) ForceFlush(_ context.Context) error {
+	return nil
+}
+
+func (o *otlpMetricExporter) Shutdown(_ context.Context) error {
+	if o.conn == nil {
+		return nil
+	}
+
+	err := o.conn.Close()
+	if err != nil {
+		return fmt.Errorf("could not close connection: %w", err)
+	}
+	return nil
+}
+
+var _ sdkmetric.Exporter = &otlpMetricExporter{}
+
+func resourceMetricsToProto(rm *metricdata.ResourceMetrics) *metricpb.ResourceMetrics {
+	res := &metricpb.ResourceMetrics{Resource: &resourcepb.Resource{}}
+	if rm.Resource != nil {
+		res.Resource.Attributes = attributesToProto(rm.Resource.Attributes())
+		res.SchemaUrl = rm.Resource.SchemaURL()
+	}
+
+	for _, scopeMetrics := range rm.ScopeMetrics {
+		scope := &metricpb.ScopeMetrics{
+			Scope:     &commonpb.InstrumentationScope{Name: scopeMetrics.Scope.Name, Version: scopeMetrics.Scope.Version},
+			SchemaUrl: scopeMetrics.Scope.SchemaURL,
+		}
+		for _, m := range scopeMetrics.Metrics {
+			metric := &metricpb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				metric.Data = sumToProto(data)
+			case metricdata.Sum[float64]:
+				metric.Data = sumToProto(data)
+			case metricdata.Gauge[int64]:
+				metric.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: dataPointsToProto(data.DataPoints)}}
+			case metricdata.Gauge[float64]:
+				metric.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: dataPointsToProto(data.DataPoints)}}
+			case metricdata.Histogram[int64]:
+				metric.Data = histogramToProto(data)
+			case metricdata.Histogram[float64]:
+				metric.Data = histogramToProto(data)
+			default:
+				continue
+			}
+			scope.Metrics = append(scope.Metrics, metric)
+		}
+		res.ScopeMetrics = append(res.ScopeMetrics, scope)
+	}
+	return res
+}
+
+func sumToProto[N int64 | float64](sum metricdata.Sum[N]) *metricpb.Metric_Sum {
+	return &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+		AggregationTemporality: temporalityToProto(sum.Temporality),
+		IsMonotonic:            sum.IsMonotonic,
+		DataPoints:             dataPointsToProto(sum.DataPoints),
+	}}
+}
+
+func dataPointsToProto[N int64 | float64](dataPoints []metricdata.DataPoint[N]) []*metricpb.NumberDataPoint {
+	res := make([]*metricpb.NumberDataPoint, len(dataPoints))
+	for i, dp := range dataPoints {
+		res[i] = &metricpb.NumberDataPoint{
+			Attributes:        attributesToProto(dp.Attributes.ToSlice()),
+			StartTimeUnixNano: timeToProto(dp.StartTime),
+			TimeUnixNano:      timeToProto(dp.Time),
+		}
+		switch value := any(dp.Value).(type) {
+		case int64:
+			res[i].Value = &metricpb.NumberDataPoint_AsInt{AsInt: value}
+		case float64:
+			res[i].Value = &metricpb.NumberDataPoint_AsDouble{AsDouble: value}
+		}
+	}
+	return res
+}
+
+func histogramToProto[N int64 | float64](histogram metricdata.Histogram[N]) *metricpb.Metric_Histogram {
+	dataPoints := make([]*metricpb.HistogramDataPoint, len(histogram.DataPoints))
+	for i, dp := range histogram.DataPoints {
+		sum := float64(dp.Sum)
+		dataPoints[i] = &metricpb.HistogramDataPoint{
+			Attributes:        attributesToProto(dp.Attributes.ToSlice()),
+			StartTimeUnixNano: timeToProto(dp.StartTime),
+			TimeUnixNano:      timeToProto(dp.Time),
+			Count:             dp.Count,
+			Sum:               &sum,
+			BucketCounts:      dp.BucketCounts,
+			ExplicitBounds:    dp.Bounds,
+		}
+		if value, ok := dp.Min.Value(); ok {
+			min := float64(value)
+			dataPoints[i].Min = &min
+		}
+		if value, ok := dp.Max.Value(); ok {
+			max := float64(value)
+			dataPoints[i].Max = &max
+		}
+	}
+
+	return &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+		AggregationTemporality: temporalityToProto(histogram.Temporality),
+		DataPoints:             dataPoints,
+	}}
+}
+
+func temporalityToProto(temporality metricdata.Temporality) metricpb.AggregationTemporality {
+	switch temporality {
+	case metricdata.DeltaTemporality:
+		return metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+	case metricdata.CumulativeTemporality:
+		return metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+	default:
+		return metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED
+	}
+}
+
+func timeToProto(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+func attributesToProto(kvs []attribute.KeyValue) []*commonpb.KeyValue {
+	res := make([]*commonpb.KeyValue, len(kvs))
+	for i, kv := range kvs {
+		res[i] = &commonpb.KeyValue{Key: string(kv.Key), Value: attributeValueToProto(kv.Value)}
+	}
+	return res
+}
+
+func attributeValueToProto(value attribute.Value) *commonpb.AnyValue {
+	switch value.Type() {
+	case attribute.BOOL:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: value.AsBool()}}
+	case attribute.INT64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value.AsInt64()}}
+	case attribute.FLOAT64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: value.AsFloat64()}}
+	case attribute.BOOLSLICE:
+		return arrayValueToProto(value.AsBoolSlice(), attribute.BoolValue)
+	case attribute.INT64SLICE:
+		return arrayValueToProto(value.AsInt64Slice(), attribute.Int64Value)
+	case attribute.FLOAT64SLICE:
+		return arrayValueToProto(value.AsFloat64Slice(), attribute.Float64Value)
+	case attribute.STRINGSLICE:
+		return arrayValueToProto(value.AsStringSlice(), attribute.StringValue)
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value.Emit()}}
+	}
+}
+
+func arrayValueToProto[T any](items []T, toValue func(T) attribute.Value) *commonpb.AnyValue {
+	values := make([]*commonpb.AnyValue, len(items))
+	for i, item := range items {
+		values[i] = attributeValueToProto(toValue(item))
+	}
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}}
+}
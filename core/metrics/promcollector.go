@@ -0,0 +1,215 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// counterSuffix is added to monotonic sums, the same way the otel prometheus exporter does it.
+	counterSuffix   = "_total"
+	targetInfoName  = "target_info"
+	targetInfoHelp  = "Target metadata"
+	scopeNameLabel  = "otel_scope_name"
+	scopeVersionKey = "otel_scope_version"
+)
+
+// unitSuffixes maps otel units to prometheus name suffixes.
+var unitSuffixes = map[string]string{
+	"1":  "_ratio",
+	"By": "_bytes",
+	"ms": "_milliseconds",
+	"s":  "_seconds",
+}
+
+// otelCollector is a prometheus collector that reads metrics from an otel reader on every scrape.
+// It differs from the otel prometheus exporter in that it attaches exemplars from the exemplarStore.
+type otelCollector struct {
+	reader    sdkmetric.Reader
+	exemplars *exemplarStore
+}
+
+func newOtelCollector(reader sdkmetric.Reader, exemplars *exemplarStore) prometheus.Collector {
+	return &otelCollector{
+		reader:    reader,
+		exemplars: exemplars,
+	}
+}
+
+// Describe implements prometheus.Collector.
+// Metrics are not known upfront, so this is an "unchecked" collector.
+func (c *otelCollector) Describe(_ chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (c *otelCollector) Collect(ch chan<- prometheus.Metric) {
+	c.exemplars.evictBefore(time.Now().Add(-maxExemplarAge))
+
+	var rm metricdata.ResourceMetrics
+	err := c.reader.Collect(context.Background(), &rm)
+	if err != nil {
+		logger.Warnf("could not collect metrics: %v", err)
+		return
+	}
+
+	if rm.Resource != nil {
+		keys, values := promLabels(*rm.Resource.Set())
+		targetInfo, err := prometheus.NewConstMetric(prometheus.NewDesc(targetInfoName, targetInfoHelp, keys, nil), prometheus.GaugeValue, 1, values...)
+		if err == nil {
+			ch <- targetInfo
+		}
+	}
+
+	for _, scopeMetrics := range rm.ScopeMetrics {
+		scope := [2]string{scopeMetrics.Scope.Name, scopeMetrics.Scope.Version}
+		for _, m := range scopeMetrics.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Histogram[int64]:
+				collectHistogram(ch, c.exemplars, scope, m, data)
+			case metricdata.Histogram[float64]:
+				collectHistogram(ch, c.exemplars, scope, m, data)
+			case metricdata.Sum[int64]:
+				collectSum(ch, c.exemplars, scope, m, data)
+			case metricdata.Sum[float64]:
+				collectSum(ch, c.exemplars, scope, m, data)
+			case metricdata.Gauge[int64]:
+				collectGauge(ch, scope, m, data)
+			case metricdata.Gauge[float64]:
+				collectGauge(ch, scope, m, data)
+			}
+		}
+	}
+}
+
+func collectHistogram[N int64 | float64](ch chan<- prometheus.Metric, exemplars *exemplarStore, scope [2]string, m metricdata.Metrics, histogram metricdata.Histogram[N]) {
+	name := promName(m)
+	for _, dp := range histogram.DataPoints {
+		keys, values := promScopedLabels(dp.Attributes, scope)
+
+		buckets := make(map[float64]uint64, len(dp.Bounds))
+		cumulativeCount := uint64(0)
+		for i, bound := range dp.Bounds {
+			cumulativeCount += dp.BucketCounts[i]
+			buckets[bound] = cumulativeCount
+		}
+
+		metric, err := prometheus.NewConstHistogram(prometheus.NewDesc(name, m.Description, keys, nil), dp.Count, float64(dp.Sum), buckets, values...)
+		if err != nil {
+			logger.Warnf("could not create histogram %s: %v", name, err)
+			continue
+		}
+		ch <- withExemplars(metric, exemplars.get(exemplarKey{scope: scope[0], instrument: m.Name, attributes: dp.Attributes.Equivalent()}))
+	}
+}
+
+func collectSum[N int64 | float64](ch chan<- prometheus.Metric, exemplars *exemplarStore, scope [2]string, m metricdata.Metrics, sum metricdata.Sum[N]) {
+	name := promName(m)
+	valueType := prometheus.GaugeValue
+	if sum.IsMonotonic {
+		name += counterSuffix
+		valueType = prometheus.CounterValue
+	}
+
+	for _, dp := range sum.DataPoints {
+		keys, values := promScopedLabels(dp.Attributes, scope)
+
+		metric, err := prometheus.NewConstMetric(prometheus.NewDesc(name, m.Description, keys, nil), valueType, float64(dp.Value), values...)
+		if err != nil {
+			logger.Warnf("could not create sum %s: %v", name, err)
+			continue
+		}
+
+		if sum.IsMonotonic {
+			// counters only carry a single exemplar, use the latest one.
+			series := exemplars.get(exemplarKey{scope: scope[0], instrument: m.Name, attributes: dp.Attributes.Equivalent()})
+			if len(series) > 0 {
+				metric = withExemplars(metric, series[len(series)-1:])
+			}
+		}
+		ch <- metric
+	}
+}
+
+func collectGauge[N int64 | float64](ch chan<- prometheus.Metric, scope [2]string, m metricdata.Metrics, gauge metricdata.Gauge[N]) {
+	name := promName(m)
+	for _, dp := range gauge.DataPoints {
+		keys, values := promScopedLabels(dp.Attributes, scope)
+
+		metric, err := prometheus.NewConstMetric(prometheus.NewDesc(name, m.Description, keys, nil), prometheus.GaugeValue, float64(dp.Value), values...)
+		if err != nil {
+			logger.Warnf("could not create gauge %s: %v", name, err)
+			continue
+		}
+		ch <- metric
+	}
+}
+
+// withExemplars attaches the exemplars to the metric, returning the metric unchanged if this is not possible.
+func withExemplars(metric prometheus.Metric, exemplars []prometheus.Exemplar) prometheus.Metric {
+	if len(exemplars) == 0 {
+		return metric
+	}
+
+	metricWithExemplars, err := prometheus.NewMetricWithExemplars(metric, exemplars...)
+	if err != nil {
+		logger.Warnf("could not add exemplars: %v", err)
+		return metric
+	}
+	return metricWithExemplars
+}
+
+// promName returns the sanitized metric name, suffixed with the unit.
+func promName(m metricdata.Metrics) string {
+	name := strings.Map(sanitizeRune, m.Name)
+	if len(name) > 0 && unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+	if suffix, ok := unitSuffixes[m.Unit]; ok && !strings.HasSuffix(name, suffix) {
+		name += suffix
+	}
+	return name
+}
+
+// promScopedLabels returns the labels of the attribute set, plus the instrumentation scope labels.
+func promScopedLabels(attrs attribute.Set, scope [2]string) (keys []string, values []string) {
+	keys, values = promLabels(attrs)
+	return append(keys, scopeNameLabel, scopeVersionKey), append(values, scope[0], scope[1])
+}
+
+// promLabels converts an attribute set to prometheus label keys and values.
+// Keys that collide after sanitizing have their values joined with ";".
+func promLabels(attrs attribute.Set) (keys []string, values []string) {
+	labels := make(map[string][]string)
+	iter := attrs.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		key := strings.Map(sanitizeRune, string(kv.Key))
+		labels[key] = append(labels[key], kv.Value.Emit())
+	}
+
+	keys = make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values = make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, strings.Join(labels[key], ";"))
+	}
+	return keys, values
+}
+
+func sanitizeRune(r rune) rune {
+	if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == ':' || r == '_' {
+		return r
+	}
+	return '_'
+}
@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/synapsecns/sanguine/core/config"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type prometheusHandler struct {
+	*baseHandler
+	buildInfo config.BuildInfo
+	// registry is the registry served on the metrics port.
+	registry *prometheus.Registry
+}
+
+// NewPrometheusMetricsHandler creates a handler that serves metrics in the OpenMetrics format on the metrics port,
+// so they can be scraped by prometheus without an otel collector. Histograms and counters recorded within a sampled span
+// carry an exemplar linking to the trace. Traces are still exported over OTLP.
+func NewPrometheusMetricsHandler(buildInfo config.BuildInfo) Handler {
+	return &prometheusHandler{
+		buildInfo:   buildInfo,
+		baseHandler: newBaseHandler(buildInfo),
+	}
+}
+
+func (p *prometheusHandler) Start(ctx context.Context) error {
+	tracerOpts, err := otlpTracerProviderOptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.baseHandler = newBaseHandler(p.buildInfo, tracerOpts...)
+
+	exemplars := newExemplarStore()
+	reader := sdkmetric.NewManualReader()
+
+	p.registry = prometheus.NewRegistry()
+	err = p.registry.Register(collectors.NewGoCollector())
+	if err != nil {
+		return fmt.Errorf("could not register go collector: %w", err)
+	}
+	err = p.registry.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	if err != nil {
+		return fmt.Errorf("could not register process collector: %w", err)
+	}
+	err = p.registry.Register(newOtelCollector(reader, exemplars))
+	if err != nil {
+		return fmt.Errorf("could not register otel collector: %w", err)
+	}
+
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(p.resource),
+		sdkmetric.WithReader(reader),
+	)
+
+	handler := promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{
+		// exemplars are only exposed in the OpenMetrics format.
+		EnableOpenMetrics: true,
+		// a single bad metric should not hide every other one.
+		ErrorHandling: promhttp.ContinueOnError,
+		ErrorLog:      promErrorLogger{},
+	})
+
+	p.startMeter(ctx, newExemplarMeterProvider(meterProvider, exemplars), handler)
+	return nil
+}
+
+func (p *prometheusHandler) Type() HandlerType {
+	return Prometheus
+}
+
+// promErrorLogger logs errors from the prometheus http handler.
+type promErrorLogger struct{}
+
+func (promErrorLogger) Println(v ...interface{}) {
+	logger.Warn(v...)
+}
@@ -0,0 +1,65 @@
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/config"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func TestPrometheusHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Setenv(metrics.MetricsPortEnabledEnv, "false")
+	t.Setenv(metrics.HandlerEnv, metrics.Prometheus.String())
+
+	handler, err := metrics.NewFromEnv(ctx, config.NewBuildInfo(config.DefaultVersion, config.DefaultCommit, config.AppName, config.DefaultDate))
+	Nil(t, err)
+	Equal(t, metrics.Prometheus, handler.Type())
+
+	meter := handler.Meter("github.com/synapsecns/sanguine/core/metrics_test")
+	counter, err := meter.Int64Counter("test_counter")
+	Nil(t, err)
+	histogram, err := meter.Float64Histogram("test_latency", metric.WithUnit("s"))
+	Nil(t, err)
+	gauge, err := meter.Int64ObservableGauge("test_gauge")
+	Nil(t, err)
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(gauge, 7)
+		return nil
+	}, gauge)
+	Nil(t, err)
+
+	// measurements outside of a span have no exemplar.
+	counter.Add(ctx, 1, metric.WithAttributes(attribute.String("chain", "1")))
+
+	spanCtx, span := handler.Tracer().Start(ctx, "test")
+	counter.Add(spanCtx, 1, metric.WithAttributes(attribute.String("chain", "1")))
+	histogram.Record(spanCtx, 0.2, metric.WithAttributes(attribute.String("chain", "1")))
+	span.End()
+	traceID := span.SpanContext().TraceID().String()
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	recorder := httptest.NewRecorder()
+	handler.Handler().ServeHTTP(recorder, req)
+
+	Equal(t, http.StatusOK, recorder.Code)
+	Contains(t, recorder.Header().Get("Content-Type"), "application/openmetrics-text")
+
+	body, err := io.ReadAll(recorder.Body)
+	Nil(t, err)
+
+	Contains(t, string(body), `test_counter_total{chain="1",otel_scope_name="github.com/synapsecns/sanguine/core/metrics_test",otel_scope_version=""} 2.0 # {`)
+	Contains(t, string(body), "test_latency_seconds_bucket")
+	Contains(t, string(body), "test_gauge{")
+	Contains(t, string(body), traceID)
+}
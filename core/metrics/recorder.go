@@ -0,0 +1,483 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/config"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	// RecorderFileEnv is the environment variable holding the file the File handler appends records to.
+	// If it is not set, records are only kept in memory.
+	RecorderFileEnv = "METRICS_RECORDER_FILE"
+	// recorderIntervalEnv controls how often metric points are recorded.
+	recorderIntervalEnv = "METRICS_RECORDER_INTERVAL"
+	// recorderIntervalDefault is the default interval, in seconds.
+	recorderIntervalDefault = 10
+	// RecorderMaxRecordsEnv is the environment variable holding how many spans and how many metric points are kept
+	// in memory. Older records are dropped, the file keeps every record.
+	RecorderMaxRecordsEnv = "METRICS_RECORDER_MAX_RECORDS"
+	// recorderMaxRecordsDefault is the default number of spans and metric points kept in memory.
+	recorderMaxRecordsDefault = 10000
+	// replayBatchSize is the number of records exported at once when replaying a file.
+	replayBatchSize = 1000
+)
+
+// RecorderHandler is a handler that records every finished span and metric point in-process,
+// either in memory or in memory and to a JSONL file. Only the most recent records are kept in memory.
+// This is useful for asserting on traces in tests, or for debugging without a collector.
+type RecorderHandler interface {
+	Handler
+	// Spans returns the recorded spans that are still kept in memory.
+	Spans() []SpanRecord
+	// SpansByName returns all spans with the given name.
+	SpansByName(name string) []SpanRecord
+	// SpanAttributes returns the attributes of every span with the given name.
+	SpanAttributes(name string) ([]map[string]attribute.Value, error)
+	// MetricPoints returns the recorded data points of the metric with the given name that are still kept in memory.
+	// Metrics are recorded periodically, call Flush to record the latest points.
+	MetricPoints(name string) []MetricRecord
+	// Flush records the current metric points.
+	Flush(ctx context.Context) error
+}
+
+type recorderHandler struct {
+	*baseHandler
+	buildInfo     config.BuildInfo
+	recorder      *recorder
+	meterProvider *sdkmetric.MeterProvider
+}
+
+// NewRecorderHandler creates a new File handler. If path is empty records are only kept in memory.
+func NewRecorderHandler(buildInfo config.BuildInfo, path string) RecorderHandler {
+	maxRecords := core.GetEnvInt(RecorderMaxRecordsEnv, recorderMaxRecordsDefault)
+	return &recorderHandler{
+		buildInfo:   buildInfo,
+		baseHandler: newBaseHandler(buildInfo),
+		recorder: &recorder{
+			path:    path,
+			spans:   newRingBuffer[SpanRecord](maxRecords),
+			metrics: newRingBuffer[MetricRecord](maxRecords),
+		},
+	}
+}
+
+func (r *recorderHandler) Start(ctx context.Context) error {
+	err := r.recorder.open()
+	if err != nil {
+		return err
+	}
+
+	r.baseHandler = newBaseHandler(r.buildInfo, tracesdk.WithSyncer(r.recorder), tracesdk.WithSampler(tracesdk.AlwaysSample()))
+
+	interval := time.Duration(core.GetEnvInt(recorderIntervalEnv, recorderIntervalDefault)) * time.Second
+	r.meterProvider = sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(r.resource),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(&recorderMetricExporter{r.recorder}, sdkmetric.WithInterval(interval))),
+	)
+	r.startMeter(ctx, r.meterProvider, promhttp.Handler())
+
+	go func() {
+		<-ctx.Done()
+		r.recorder.close()
+	}()
+
+	return nil
+}
+
+func (r *recorderHandler) Type() HandlerType {
+	return File
+}
+
+func (r *recorderHandler) Spans() []SpanRecord {
+	r.recorder.mux.Lock()
+	defer r.recorder.mux.Unlock()
+
+	return r.recorder.spans.items()
+}
+
+func (r *recorderHandler) SpansByName(name string) (spans []SpanRecord) {
+	for _, span := range r.Spans() {
+		if span.Name == name {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+func (r *recorderHandler) SpanAttributes(name string) ([]map[string]attribute.Value, error) {
+	spans := r.SpansByName(name)
+	res := make([]map[string]attribute.Value, len(spans))
+	for i, span := range spans {
+		attributes, err := span.AttributeMap()
+		if err != nil {
+			return nil, fmt.Errorf("could not decode attributes of span %s: %w", span.SpanID, err)
+		}
+		res[i] = attributes
+	}
+	return res, nil
+}
+
+func (r *recorderHandler) MetricPoints(name string) (points []MetricRecord) {
+	r.recorder.mux.Lock()
+	defer r.recorder.mux.Unlock()
+
+	for _, point := range r.recorder.metrics.items() {
+		if point.Name == name {
+			points = append(points, point)
+		}
+	}
+	return points
+}
+
+func (r *recorderHandler) Flush(ctx context.Context) error {
+	if r.meterProvider == nil {
+		return fmt.Errorf("handler not started")
+	}
+
+	err := r.meterProvider.ForceFlush(ctx)
+	if err != nil {
+		return fmt.Errorf("could not flush metrics: %w", err)
+	}
+	return nil
+}
+
+var _ RecorderHandler = &recorderHandler{}
+
+// recorder stores the most recent spans and metric points in memory and, optionally, appends every record to a file.
+// It is the span exporter of the File handler.
+type recorder struct {
+	mux     sync.Mutex
+	path    string
+	file    *os.File
+	encoder *json.Encoder
+	closed  bool
+	spans   *ringBuffer[SpanRecord]
+	metrics *ringBuffer[MetricRecord]
+}
+
+// ringBuffer keeps the most recent items, up to its size.
+type ringBuffer[T any] struct {
+	buffer []T
+	// next is the index the next item is written to once the buffer is full.
+	next int
+	size int
+}
+
+func newRingBuffer[T any](size int) *ringBuffer[T] {
+	if size < 1 {
+		size = 1
+	}
+	return &ringBuffer[T]{size: size}
+}
+
+// push adds an item, dropping the oldest one if the buffer is full.
+func (r *ringBuffer[T]) push(item T) {
+	if len(r.buffer) < r.size {
+		r.buffer = append(r.buffer, item)
+		return
+	}
+	r.buffer[r.next] = item
+	r.next = (r.next + 1) % r.size
+}
+
+// items returns a copy of the items, oldest first.
+func (r *ringBuffer[T]) items() []T {
+	items := make([]T, 0, len(r.buffer))
+	items = append(items, r.buffer[r.next:]...)
+	return append(items, r.buffer[:r.next]...)
+}
+
+func (r *recorder) open() error {
+	if r.path == "" {
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(r.path), 0o750)
+	if err != nil {
+		return fmt.Errorf("could not create recorder directory: %w", err)
+	}
+
+	r.file, err = os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not open recorder file: %w", err)
+	}
+	r.encoder = json.NewEncoder(r.file)
+	return nil
+}
+
+func (r *recorder) close() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	r.closed = true
+	if r.file != nil {
+		_ = r.file.Close()
+	}
+}
+
+// write appends the record to the file. Must be called with the lock held.
+func (r *recorder) write(record Record) error {
+	if r.encoder == nil || r.closed {
+		return nil
+	}
+
+	err := r.encoder.Encode(record)
+	if err != nil {
+		return fmt.Errorf("could not write record: %w", err)
+	}
+	return nil
+}
+
+// ExportSpans implements tracesdk.SpanExporter.
+func (r *recorder) ExportSpans(_ context.Context, spans []tracesdk.ReadOnlySpan) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, span := range spans {
+		record := newSpanRecord(span)
+		r.spans.push(record)
+		if err := r.write(Record{Span: &record}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown implements tracesdk.SpanExporter.
+func (r *recorder) Shutdown(_ context.Context) error {
+	r.close()
+	return nil
+}
+
+// recorderMetricExporter records metric points on the recorder.
+type recorderMetricExporter struct {
+	*recorder
+}
+
+func (r *recorderMetricExporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(kind)
+}
+
+func (r *recorderMetricExporter) Aggregation(kind sdkmetric.InstrumentKind) aggregation.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+func (r *recorderMetricExporter) Export(_ context.Context, rm *metricdata.ResourceMetrics) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for _, point := range newMetricRecords(rm) {
+		point := point
+		r.metrics.push(point)
+		if err := r.write(Record{Metric: &point}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *recorderMetricExporter) ForceFlush(_ context.Context) error {
+	return nil
+}
+
+func (r *recorderMetricExporter) Shutdown(_ context.Context) error {
+	return nil
+}
+
+// ReadRecordFile reads the spans and metric points of a file written by the File handler.
+// Every record is loaded in memory, use ScanRecordFile for large files.
+func ReadRecordFile(path string) (spans []SpanRecord, points []MetricRecord, err error) {
+	err = ScanRecordFile(path, func(record Record) error {
+		if record.Span != nil {
+			spans = append(spans, *record.Span)
+		}
+		if record.Metric != nil {
+			points = append(points, *record.Metric)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return spans, points, nil
+}
+
+// ScanRecordFile calls fn with every record of a file written by the File handler, in the order they were written.
+// Records are read one at a time, so files larger than memory can be scanned.
+func ScanRecordFile(path string, fn func(record Record) error) error {
+	//nolint: gosec
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open recorder file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	// spans with many attributes can get long.
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+		decoder := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&record); err != nil {
+			return fmt.Errorf("could not decode record: %w", err)
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read recorder file: %w", err)
+	}
+	return nil
+}
+
+// ReplaySpans exports every span of a file written by the File handler to the exporter.
+func ReplaySpans(ctx context.Context, path string, exporter tracesdk.SpanExporter) error {
+	var stubs tracetest.SpanStubs
+	export := func() error {
+		if len(stubs) == 0 {
+			return nil
+		}
+		err := exporter.ExportSpans(ctx, stubs.Snapshots())
+		if err != nil {
+			return fmt.Errorf("could not export spans: %w", err)
+		}
+		stubs = stubs[:0]
+		return nil
+	}
+
+	err := ScanRecordFile(path, func(record Record) error {
+		if record.Span == nil {
+			return nil
+		}
+
+		stub, err := record.Span.SpanStub()
+		if err != nil {
+			return fmt.Errorf("could not convert span %s: %w", record.Span.SpanID, err)
+		}
+		stubs = append(stubs, stub)
+		if len(stubs) < replayBatchSize {
+			return nil
+		}
+		return export()
+	})
+	if err != nil {
+		return err
+	}
+	return export()
+}
+
+// ReplayMetrics exports every metric point of a file written by the File handler to the exporter.
+func ReplayMetrics(ctx context.Context, path string, exporter sdkmetric.Exporter) error {
+	var points []MetricRecord
+	export := func() error {
+		if len(points) == 0 {
+			return nil
+		}
+		rm, err := newResourceMetrics(points)
+		if err != nil {
+			return err
+		}
+		err = exporter.Export(ctx, rm)
+		if err != nil {
+			return fmt.Errorf("could not export metrics: %w", err)
+		}
+		points = points[:0]
+		return nil
+	}
+
+	err := ScanRecordFile(path, func(record Record) error {
+		if record.Metric == nil {
+			return nil
+		}
+
+		// every export holds the points of a single resource.
+		if len(points) > 0 && !sameAttributes(points[0].Resource, record.Metric.Resource) {
+			if err := export(); err != nil {
+				return err
+			}
+		}
+		points = append(points, *record.Metric)
+		if len(points) < replayBatchSize {
+			return nil
+		}
+		return export()
+	})
+	if err != nil {
+		return err
+	}
+	return export()
+}
+
+// sameAttributes checks whether two attribute records hold the same attributes.
+func sameAttributes(a, b []AttributeRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].Type != b[i].Type || fmt.Sprint(a[i].Value) != fmt.Sprint(b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// ReplayToOTLP exports every span and metric point of a file written by the File handler over OTLP, configured from
+// the environment the same way as the OTLP handler.
+func ReplayToOTLP(ctx context.Context, path string) error {
+	exporter, err := newOTLPExporter(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = ReplaySpans(ctx, path, exporter)
+	if err != nil {
+		return err
+	}
+
+	err = exporter.Shutdown(ctx)
+	if err != nil {
+		return fmt.Errorf("could not shutdown exporter: %w", err)
+	}
+
+	metricExporter, err := newOTLPMetricExporter(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = ReplayMetrics(ctx, path, metricExporter)
+	if err != nil {
+		return err
+	}
+
+	err = metricExporter.Shutdown(ctx)
+	if err != nil {
+		return fmt.Errorf("could not shutdown metric exporter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/config"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRecorderHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recordFile := filepath.Join(t.TempDir(), "records", "spans.jsonl")
+	t.Setenv(metrics.MetricsPortEnabledEnv, "false")
+	t.Setenv(metrics.HandlerEnv, metrics.File.String())
+	t.Setenv(metrics.RecorderFileEnv, recordFile)
+
+	handler, err := metrics.NewFromEnv(ctx, config.NewBuildInfo(config.DefaultVersion, config.DefaultCommit, config.AppName, config.DefaultDate))
+	Nil(t, err)
+	Equal(t, metrics.File, handler.Type())
+
+	recorder, ok := handler.(metrics.RecorderHandler)
+	True(t, ok)
+
+	parentCtx, parent := handler.Tracer().Start(ctx, "parent")
+	_, child := handler.Tracer().Start(parentCtx, "child", trace.WithAttributes(
+		attribute.String("string", "value"),
+		attribute.Int64("int", 1<<60),
+		attribute.Int64Slice("ints", []int64{1, 2}),
+		attribute.Bool("bool", true),
+	))
+	child.AddEvent("event", trace.WithAttributes(attribute.Float64("float", 1.5)))
+	metrics.EndSpanWithErr(child, errors.New("child failed"))
+	metrics.EndSpanWithErr(parent, nil)
+
+	counter, err := handler.Meter("test").Int64Counter("test_counter")
+	Nil(t, err)
+	counter.Add(ctx, 3, metric.WithAttributes(attribute.String("chain", "1")))
+	Nil(t, recorder.Flush(ctx))
+
+	children := recorder.SpansByName("child")
+	Len(t, children, 1)
+	Equal(t, recorder.SpansByName("parent")[0].SpanID, children[0].ParentSpanID)
+
+	attributes, err := recorder.SpanAttributes("child")
+	Nil(t, err)
+	Len(t, attributes, 1)
+	Equal(t, attribute.StringValue("value"), attributes[0]["string"])
+	Equal(t, attribute.Int64Value(1<<60), attributes[0]["int"])
+	Equal(t, attribute.BoolValue(true), attributes[0]["bool"])
+
+	points := recorder.MetricPoints("test_counter")
+	NotEmpty(t, points)
+	Equal(t, float64(3), points[len(points)-1].Value)
+	Equal(t, metrics.MetricKindSum, points[len(points)-1].Kind)
+
+	// the file should contain the same records, and replay to an exporter with their attributes intact.
+	spans, filePoints, err := metrics.ReadRecordFile(recordFile)
+	Nil(t, err)
+	Len(t, spans, 2)
+	NotEmpty(t, filePoints)
+
+	exporter := tracetest.NewInMemoryExporter()
+	Nil(t, metrics.ReplaySpans(ctx, recordFile, exporter))
+
+	replayed := exporter.GetSpans()
+	Len(t, replayed, 2)
+	Equal(t, "child", replayed[0].Name)
+	Equal(t, children[0].TraceID, replayed[0].SpanContext.TraceID().String())
+	Equal(t, children[0].ParentSpanID, replayed[0].Parent.SpanID().String())
+	Equal(t, codes.Unset, replayed[0].Status.Code)
+	ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("string", "value"),
+		attribute.Int64("int", 1<<60),
+		attribute.Int64Slice("ints", []int64{1, 2}),
+		attribute.Bool("bool", true),
+	}, replayed[0].Attributes)
+	// RecordError adds an exception event after our own event.
+	Len(t, replayed[0].Events, 2)
+	Equal(t, []attribute.KeyValue{attribute.Float64("float", 1.5)}, replayed[0].Events[0].Attributes)
+}
+
+func TestRecorderKeepsMostRecentRecords(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recordFile := filepath.Join(t.TempDir(), "spans.jsonl")
+	t.Setenv(metrics.MetricsPortEnabledEnv, "false")
+	t.Setenv(metrics.HandlerEnv, metrics.File.String())
+	t.Setenv(metrics.RecorderFileEnv, recordFile)
+	t.Setenv(metrics.RecorderMaxRecordsEnv, "2")
+
+	handler, err := metrics.NewFromEnv(ctx, config.NewBuildInfo(config.DefaultVersion, config.DefaultCommit, config.AppName, config.DefaultDate))
+	Nil(t, err)
+	recorder, ok := handler.(metrics.RecorderHandler)
+	True(t, ok)
+
+	for i := 0; i < 3; i++ {
+		_, span := handler.Tracer().Start(ctx, fmt.Sprintf("span-%d", i))
+		span.End()
+	}
+
+	// only the most recent spans are kept in memory, the file has all of them.
+	spans := recorder.Spans()
+	Len(t, spans, 2)
+	Equal(t, "span-1", spans[0].Name)
+	Equal(t, "span-2", spans[1].Name)
+
+	fileSpans, _, err := metrics.ReadRecordFile(recordFile)
+	Nil(t, err)
+	Len(t, fileSpans, 3)
+}
+
+func TestReplayToOTLP(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recordFile := filepath.Join(t.TempDir(), "records.jsonl")
+	t.Setenv(metrics.MetricsPortEnabledEnv, "false")
+	t.Setenv(metrics.HandlerEnv, metrics.File.String())
+	t.Setenv(metrics.RecorderFileEnv, recordFile)
+
+	handler, err := metrics.NewFromEnv(ctx, config.NewBuildInfo(config.DefaultVersion, config.DefaultCommit, config.AppName, config.DefaultDate))
+	Nil(t, err)
+	recorder, ok := handler.(metrics.RecorderHandler)
+	True(t, ok)
+
+	_, span := handler.Tracer().Start(ctx, "span")
+	span.End()
+	counter, err := handler.Meter("test").Int64Counter("test_counter")
+	Nil(t, err)
+	counter.Add(ctx, 3, metric.WithAttributes(attribute.String("chain", "1")))
+	Nil(t, recorder.Flush(ctx))
+
+	var mux sync.Mutex
+	var traceRequests int
+	var metricRequests []*colmetricpb.ExportMetricsServiceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+
+		body, err := io.ReadAll(r.Body)
+		Nil(t, err)
+
+		switch r.URL.Path {
+		case "/v1/traces":
+			traceRequests++
+		case "/v1/metrics":
+			var req colmetricpb.ExportMetricsServiceRequest
+			Nil(t, proto.Unmarshal(body, &req))
+			metricRequests = append(metricRequests, &req)
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer server.Close()
+
+	t.Setenv("OTEL_EXPORTER_OTLP_TRANSPORT", "http")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", server.URL)
+	Nil(t, metrics.ReplayToOTLP(ctx, recordFile))
+
+	mux.Lock()
+	defer mux.Unlock()
+
+	Equal(t, 1, traceRequests)
+	Len(t, metricRequests, 1)
+
+	var values []float64
+	for _, resourceMetrics := range metricRequests[0].GetResourceMetrics() {
+		NotEmpty(t, resourceMetrics.GetResource().GetAttributes())
+		for _, scopeMetrics := range resourceMetrics.GetScopeMetrics() {
+			for _, m := range scopeMetrics.GetMetrics() {
+				if m.GetName() != "test_counter" {
+					continue
+				}
+				True(t, m.GetSum().GetIsMonotonic())
+				for _, dp := range m.GetSum().GetDataPoints() {
+					Equal(t, "chain", dp.GetAttributes()[0].GetKey())
+					values = append(values, dp.GetAsDouble())
+				}
+			}
+		}
+	}
+	Equal(t, []float64{3}, values)
+}
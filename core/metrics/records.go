@@ -0,0 +1,525 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Record is a single line of a recorder file. Exactly one of Span or Metric is set.
+type Record struct {
+	Span   *SpanRecord   `json:"span,omitempty"`
+	Metric *MetricRecord `json:"metric,omitempty"`
+}
+
+// AttributeRecord is a typed attribute that survives a json round trip.
+type AttributeRecord struct {
+	Key string `json:"key"`
+	// Type is the attribute.Type of the value, e.g. STRING or INT64SLICE.
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// SpanEventRecord is an event added to a span.
+type SpanEventRecord struct {
+	Name       string            `json:"name"`
+	Time       time.Time         `json:"time"`
+	Attributes []AttributeRecord `json:"attributes,omitempty"`
+}
+
+// SpanRecord is a finished span.
+type SpanRecord struct {
+	Name              string            `json:"name"`
+	TraceID           string            `json:"trace_id"`
+	SpanID            string            `json:"span_id"`
+	ParentSpanID      string            `json:"parent_span_id,omitempty"`
+	Kind              string            `json:"kind"`
+	StartTime         time.Time         `json:"start_time"`
+	EndTime           time.Time         `json:"end_time"`
+	Attributes        []AttributeRecord `json:"attributes,omitempty"`
+	Events            []SpanEventRecord `json:"events,omitempty"`
+	StatusCode        string            `json:"status_code"`
+	StatusDescription string            `json:"status_description,omitempty"`
+	Scope             string            `json:"scope"`
+	Resource          []AttributeRecord `json:"resource,omitempty"`
+}
+
+// MetricKind is the kind of aggregation a MetricRecord holds.
+type MetricKind string
+
+const (
+	// MetricKindSum is a counter or up/down counter.
+	MetricKindSum MetricKind = "sum"
+	// MetricKindGauge is a gauge.
+	MetricKindGauge MetricKind = "gauge"
+	// MetricKindHistogram is a histogram.
+	MetricKindHistogram MetricKind = "histogram"
+)
+
+// MetricRecord is a single metric data point.
+type MetricRecord struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Unit        string            `json:"unit,omitempty"`
+	Scope       string            `json:"scope"`
+	Kind        MetricKind        `json:"kind"`
+	Monotonic   bool              `json:"monotonic,omitempty"`
+	StartTime   time.Time         `json:"start_time,omitempty"`
+	Time        time.Time         `json:"time"`
+	Attributes  []AttributeRecord `json:"attributes,omitempty"`
+	Resource    []AttributeRecord `json:"resource,omitempty"`
+	// Value is set for sums and gauges.
+	Value float64 `json:"value,omitempty"`
+	// Count, Sum, Bounds and BucketCounts are set for histograms.
+	Count        uint64    `json:"count,omitempty"`
+	Sum          float64   `json:"sum,omitempty"`
+	Bounds       []float64 `json:"bounds,omitempty"`
+	BucketCounts []uint64  `json:"bucket_counts,omitempty"`
+}
+
+// AttributeMap returns the attributes of the span keyed by name.
+func (s SpanRecord) AttributeMap() (map[string]attribute.Value, error) {
+	kvs, err := attributesFromRecords(s.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		res[string(kv.Key)] = kv.Value
+	}
+	return res, nil
+}
+
+// KeyValue converts the record back to an attribute.
+func (a AttributeRecord) KeyValue() (attribute.KeyValue, error) {
+	key := attribute.Key(a.Key)
+	switch a.Type {
+	case attribute.BOOL.String():
+		v, ok := a.Value.(bool)
+		if !ok {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s is not a bool", a.Key)
+		}
+		return key.Bool(v), nil
+	case attribute.INT64.String():
+		v, err := toInt64(a.Value)
+		if err != nil {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s: %w", a.Key, err)
+		}
+		return key.Int64(v), nil
+	case attribute.FLOAT64.String():
+		v, err := toFloat64(a.Value)
+		if err != nil {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s: %w", a.Key, err)
+		}
+		return key.Float64(v), nil
+	case attribute.STRING.String():
+		v, ok := a.Value.(string)
+		if !ok {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s is not a string", a.Key)
+		}
+		return key.String(v), nil
+	case attribute.BOOLSLICE.String():
+		v, err := toSlice(a.Value, func(i interface{}) (bool, error) {
+			b, ok := i.(bool)
+			if !ok {
+				return false, fmt.Errorf("not a bool: %v", i)
+			}
+			return b, nil
+		})
+		if err != nil {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s: %w", a.Key, err)
+		}
+		return key.BoolSlice(v), nil
+	case attribute.INT64SLICE.String():
+		v, err := toSlice(a.Value, toInt64)
+		if err != nil {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s: %w", a.Key, err)
+		}
+		return key.Int64Slice(v), nil
+	case attribute.FLOAT64SLICE.String():
+		v, err := toSlice(a.Value, toFloat64)
+		if err != nil {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s: %w", a.Key, err)
+		}
+		return key.Float64Slice(v), nil
+	case attribute.STRINGSLICE.String():
+		v, err := toSlice(a.Value, func(i interface{}) (string, error) {
+			s, ok := i.(string)
+			if !ok {
+				return "", fmt.Errorf("not a string: %v", i)
+			}
+			return s, nil
+		})
+		if err != nil {
+			return attribute.KeyValue{}, fmt.Errorf("attribute %s: %w", a.Key, err)
+		}
+		return key.StringSlice(v), nil
+	default:
+		return attribute.KeyValue{}, fmt.Errorf("unknown attribute type %s for %s", a.Type, a.Key)
+	}
+}
+
+// SpanStub converts the record back to a span stub, e.g. for replaying it to an exporter.
+func (s SpanRecord) SpanStub() (tracetest.SpanStub, error) {
+	traceID, err := trace.TraceIDFromHex(s.TraceID)
+	if err != nil {
+		return tracetest.SpanStub{}, fmt.Errorf("could not parse trace id: %w", err)
+	}
+	spanID, err := trace.SpanIDFromHex(s.SpanID)
+	if err != nil {
+		return tracetest.SpanStub{}, fmt.Errorf("could not parse span id: %w", err)
+	}
+
+	var parent trace.SpanContext
+	if s.ParentSpanID != "" {
+		parentID, err := trace.SpanIDFromHex(s.ParentSpanID)
+		if err != nil {
+			return tracetest.SpanStub{}, fmt.Errorf("could not parse parent span id: %w", err)
+		}
+		parent = trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: parentID, TraceFlags: trace.FlagsSampled})
+	}
+
+	attributes, err := attributesFromRecords(s.Attributes)
+	if err != nil {
+		return tracetest.SpanStub{}, err
+	}
+	resourceAttributes, err := attributesFromRecords(s.Resource)
+	if err != nil {
+		return tracetest.SpanStub{}, err
+	}
+
+	events := make([]tracesdk.Event, len(s.Events))
+	for i, event := range s.Events {
+		eventAttributes, err := attributesFromRecords(event.Attributes)
+		if err != nil {
+			return tracetest.SpanStub{}, err
+		}
+		events[i] = tracesdk.Event{Name: event.Name, Time: event.Time, Attributes: eventAttributes}
+	}
+
+	var statusCode codes.Code
+	if err := statusCode.UnmarshalJSON([]byte(strconv.Quote(s.StatusCode))); err != nil {
+		return tracetest.SpanStub{}, fmt.Errorf("could not parse status code: %w", err)
+	}
+
+	return tracetest.SpanStub{
+		Name:                   s.Name,
+		SpanContext:            trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled}),
+		Parent:                 parent,
+		SpanKind:               spanKindFromString(s.Kind),
+		StartTime:              s.StartTime,
+		EndTime:                s.EndTime,
+		Attributes:             attributes,
+		Events:                 events,
+		Status:                 tracesdk.Status{Code: statusCode, Description: s.StatusDescription},
+		Resource:               resource.NewSchemaless(resourceAttributes...),
+		InstrumentationLibrary: instrumentation.Library{Name: s.Scope},
+	}, nil
+}
+
+func newSpanRecord(span tracesdk.ReadOnlySpan) SpanRecord {
+	record := SpanRecord{
+		Name:              span.Name(),
+		TraceID:           span.SpanContext().TraceID().String(),
+		SpanID:            span.SpanContext().SpanID().String(),
+		Kind:              span.SpanKind().String(),
+		StartTime:         span.StartTime(),
+		EndTime:           span.EndTime(),
+		Attributes:        attributesToRecords(span.Attributes()),
+		StatusCode:        span.Status().Code.String(),
+		StatusDescription: span.Status().Description,
+		Scope:             span.InstrumentationScope().Name,
+	}
+	if span.Parent().IsValid() {
+		record.ParentSpanID = span.Parent().SpanID().String()
+	}
+	if span.Resource() != nil {
+		record.Resource = attributesToRecords(span.Resource().Attributes())
+	}
+	for _, event := range span.Events() {
+		record.Events = append(record.Events, SpanEventRecord{
+			Name:       event.Name,
+			Time:       event.Time,
+			Attributes: attributesToRecords(event.Attributes),
+		})
+	}
+	return record
+}
+
+// newMetricRecords flattens the collected metrics into one record per data point.
+func newMetricRecords(rm *metricdata.ResourceMetrics) (records []MetricRecord) {
+	for _, scopeMetrics := range rm.ScopeMetrics {
+		for _, m := range scopeMetrics.Metrics {
+			base := MetricRecord{
+				Name:        m.Name,
+				Description: m.Description,
+				Unit:        m.Unit,
+				Scope:       scopeMetrics.Scope.Name,
+			}
+			if rm.Resource != nil {
+				base.Resource = attributesToRecords(rm.Resource.Attributes())
+			}
+
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				records = append(records, sumRecords(base, data)...)
+			case metricdata.Sum[float64]:
+				records = append(records, sumRecords(base, data)...)
+			case metricdata.Gauge[int64]:
+				records = append(records, gaugeRecords(base, data)...)
+			case metricdata.Gauge[float64]:
+				records = append(records, gaugeRecords(base, data)...)
+			case metricdata.Histogram[int64]:
+				records = append(records, histogramRecords(base, data)...)
+			case metricdata.Histogram[float64]:
+				records = append(records, histogramRecords(base, data)...)
+			}
+		}
+	}
+	return records
+}
+
+func sumRecords[N int64 | float64](base MetricRecord, sum metricdata.Sum[N]) (records []MetricRecord) {
+	for _, dp := range sum.DataPoints {
+		record := base
+		record.Kind = MetricKindSum
+		record.Monotonic = sum.IsMonotonic
+		record.StartTime = dp.StartTime
+		record.Time = dp.Time
+		record.Attributes = attributesToRecords(dp.Attributes.ToSlice())
+		record.Value = float64(dp.Value)
+		records = append(records, record)
+	}
+	return records
+}
+
+func gaugeRecords[N int64 | float64](base MetricRecord, gauge metricdata.Gauge[N]) (records []MetricRecord) {
+	for _, dp := range gauge.DataPoints {
+		record := base
+		record.Kind = MetricKindGauge
+		record.StartTime = dp.StartTime
+		record.Time = dp.Time
+		record.Attributes = attributesToRecords(dp.Attributes.ToSlice())
+		record.Value = float64(dp.Value)
+		records = append(records, record)
+	}
+	return records
+}
+
+func histogramRecords[N int64 | float64](base MetricRecord, histogram metricdata.Histogram[N]) (records []MetricRecord) {
+	for _, dp := range histogram.DataPoints {
+		record := base
+		record.Kind = MetricKindHistogram
+		record.StartTime = dp.StartTime
+		record.Time = dp.Time
+		record.Attributes = attributesToRecords(dp.Attributes.ToSlice())
+		record.Count = dp.Count
+		record.Sum = float64(dp.Sum)
+		record.Bounds = dp.Bounds
+		record.BucketCounts = dp.BucketCounts
+		records = append(records, record)
+	}
+	return records
+}
+
+// newResourceMetrics converts metric records back to the metrics of a single collection, e.g. for replaying them
+// to an exporter. Values are replayed as float64 and sums and histograms as cumulative, the temporality the File
+// handler records them with.
+func newResourceMetrics(records []MetricRecord) (*metricdata.ResourceMetrics, error) {
+	rm := &metricdata.ResourceMetrics{Resource: resource.Empty()}
+	if len(records) == 0 {
+		return rm, nil
+	}
+
+	resourceAttributes, err := attributesFromRecords(records[0].Resource)
+	if err != nil {
+		return nil, err
+	}
+	rm.Resource = resource.NewSchemaless(resourceAttributes...)
+
+	// records of the same metric are merged, keeping the order they were recorded in.
+	scopes := make(map[string]int)
+	metrics := make(map[[2]string]int)
+	for _, record := range records {
+		scopeIndex, ok := scopes[record.Scope]
+		if !ok {
+			scopeIndex = len(rm.ScopeMetrics)
+			scopes[record.Scope] = scopeIndex
+			rm.ScopeMetrics = append(rm.ScopeMetrics, metricdata.ScopeMetrics{Scope: instrumentation.Scope{Name: record.Scope}})
+		}
+		scopeMetrics := &rm.ScopeMetrics[scopeIndex]
+
+		key := [2]string{record.Scope, record.Name}
+		metricIndex, ok := metrics[key]
+		if !ok {
+			metricIndex = len(scopeMetrics.Metrics)
+			metrics[key] = metricIndex
+			scopeMetrics.Metrics = append(scopeMetrics.Metrics, metricdata.Metrics{
+				Name:        record.Name,
+				Description: record.Description,
+				Unit:        record.Unit,
+			})
+		}
+		m := &scopeMetrics.Metrics[metricIndex]
+
+		m.Data, err = addMetricRecord(m.Data, record)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert metric %s: %w", record.Name, err)
+		}
+	}
+	return rm, nil
+}
+
+// addMetricRecord adds the data point of the record to the aggregation, creating it if data is nil.
+func addMetricRecord(data metricdata.Aggregation, record MetricRecord) (metricdata.Aggregation, error) {
+	attributes, err := attributesFromRecords(record.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	dataPoint := metricdata.DataPoint[float64]{
+		Attributes: attribute.NewSet(attributes...),
+		StartTime:  record.StartTime,
+		Time:       record.Time,
+		Value:      record.Value,
+	}
+
+	switch record.Kind {
+	case MetricKindSum:
+		sum, ok := data.(metricdata.Sum[float64])
+		if data != nil && !ok {
+			return nil, fmt.Errorf("metric is a sum and a %T", data)
+		}
+		sum.Temporality = metricdata.CumulativeTemporality
+		sum.IsMonotonic = record.Monotonic
+		sum.DataPoints = append(sum.DataPoints, dataPoint)
+		return sum, nil
+	case MetricKindGauge:
+		gauge, ok := data.(metricdata.Gauge[float64])
+		if data != nil && !ok {
+			return nil, fmt.Errorf("metric is a gauge and a %T", data)
+		}
+		gauge.DataPoints = append(gauge.DataPoints, dataPoint)
+		return gauge, nil
+	case MetricKindHistogram:
+		histogram, ok := data.(metricdata.Histogram[float64])
+		if data != nil && !ok {
+			return nil, fmt.Errorf("metric is a histogram and a %T", data)
+		}
+		histogram.Temporality = metricdata.CumulativeTemporality
+		histogram.DataPoints = append(histogram.DataPoints, metricdata.HistogramDataPoint[float64]{
+			Attributes:   dataPoint.Attributes,
+			StartTime:    record.StartTime,
+			Time:         record.Time,
+			Count:        record.Count,
+			Sum:          record.Sum,
+			Bounds:       record.Bounds,
+			BucketCounts: record.BucketCounts,
+		})
+		return histogram, nil
+	default:
+		return nil, fmt.Errorf("unknown metric kind %s", record.Kind)
+	}
+}
+
+func attributesToRecords(kvs []attribute.KeyValue) []AttributeRecord {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	records := make([]AttributeRecord, len(kvs))
+	for i, kv := range kvs {
+		records[i] = AttributeRecord{
+			Key:   string(kv.Key),
+			Type:  kv.Value.Type().String(),
+			Value: kv.Value.AsInterface(),
+		}
+	}
+	return records
+}
+
+func attributesFromRecords(records []AttributeRecord) ([]attribute.KeyValue, error) {
+	kvs := make([]attribute.KeyValue, len(records))
+	for i, record := range records {
+		kv, err := record.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		kvs[i] = kv
+	}
+	return kvs, nil
+}
+
+func spanKindFromString(kind string) trace.SpanKind {
+	for _, spanKind := range []trace.SpanKind{trace.SpanKindInternal, trace.SpanKindServer, trace.SpanKindClient, trace.SpanKindProducer, trace.SpanKindConsumer} {
+		if spanKind.String() == kind {
+			return spanKind
+		}
+	}
+	return trace.SpanKindUnspecified
+}
+
+// toInt64 converts a decoded json value to an int64. Records are decoded with UseNumber, so
+// large integers don't lose precision.
+func toInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("could not parse int64: %w", err)
+		}
+		return i, nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("not an int64: %v", value)
+	}
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("could not parse float64: %w", err)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("not a float64: %v", value)
+	}
+}
+
+func toSlice[T any](value interface{}, convert func(interface{}) (T, error)) ([]T, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		// values that were never serialized keep their original slice type.
+		if typed, ok := value.([]T); ok {
+			return typed, nil
+		}
+		return nil, fmt.Errorf("not a slice: %v", value)
+	}
+
+	res := make([]T, len(items))
+	for i, item := range items {
+		converted, err := convert(item)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = converted
+	}
+	return res, nil
+}
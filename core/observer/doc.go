@@ -1,2 +1,4 @@
-// Package observer provides an observer implementation
+// Package observer provides an observer implementation.
+// Observer is a typed, generic event manager with per-subscriber buffers and backpressure policies.
+// KeyObserver and StringObserver are the older, untyped implementations.
 package observer
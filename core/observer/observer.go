@@ -13,7 +13,7 @@
 // is emitted, all listeners listening on channel "e" will receive that event.
 // see https://flaviocopes.com/golang-event-listeners/ for details.
 //
-// Deprecated: this should be replaced by ethereums's event subscription: https://pkg.go.dev/github.com/ethereum/go-ethereum@v1.10.5/event#Subscription
+// Deprecated: use Observer, which owns its channels and never blocks on a subscriber that went away.
 type KeyObserver struct {
 	listeners map[Key][]chan interface{}
 	mux       sync.RWMutex
@@ -0,0 +1,292 @@
+package observer
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// BackpressurePolicy decides what happens when a subscriber's buffer is full.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=BackpressurePolicy -linecomment
+type BackpressurePolicy uint8
+
+const (
+	// Block makes Emit wait until the subscriber has room in its buffer or unsubscribes.
+	Block BackpressurePolicy = iota // block
+	// DropOldest discards the oldest buffered event to make room for the new one.
+	DropOldest // drop-oldest
+	// DropNewest discards the new event.
+	DropNewest // drop-newest
+)
+
+// defaultBufferSize is the buffer size of a subscription unless WithBufferSize is used.
+const defaultBufferSize = 16
+
+// Event is an event delivered to wildcard subscribers.
+type Event[K comparable, V any] struct {
+	// Key is the key the event was emitted on.
+	Key K
+	// Value is the emitted value.
+	Value V
+}
+
+// Subscription is a handle to a subscription created by Subscribe or SubscribeAll.
+type Subscription interface {
+	// Unsubscribe stops delivery and closes the subscription channel. It is safe to call more than once.
+	Unsubscribe()
+	// Dropped returns the number of events that were not delivered to this subscriber.
+	Dropped() uint64
+}
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(*subscribeConfig)
+
+type subscribeConfig struct {
+	bufferSize int
+	policy     BackpressurePolicy
+}
+
+// WithBufferSize sets the number of events buffered for the subscriber.
+func WithBufferSize(size int) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.bufferSize = size
+	}
+}
+
+// WithPolicy sets what happens when the subscriber's buffer is full.
+func WithPolicy(policy BackpressurePolicy) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.policy = policy
+	}
+}
+
+// Observer is a typed event manager. Subscribers receive events emitted on a key on their own buffered channel,
+// or every event if they subscribed with SubscribeAll. Unlike KeyObserver, the observer owns the channels,
+// so a subscriber that goes away never blocks Emit.
+type Observer[K comparable, V any] struct {
+	mux      sync.RWMutex
+	nextID   uint64
+	keyed    map[K]map[uint64]*subscriber[V]
+	wildcard map[uint64]*subscriber[Event[K, V]]
+	closed   bool
+	// dropped is the number of events dropped across all subscribers.
+	dropped atomic.Uint64
+}
+
+// NewObserver creates a new observer.
+func NewObserver[K comparable, V any]() *Observer[K, V] {
+	return &Observer[K, V]{
+		keyed:    make(map[K]map[uint64]*subscriber[V]),
+		wildcard: make(map[uint64]*subscriber[Event[K, V]]),
+	}
+}
+
+// Subscribe returns a channel receiving every event emitted on key.
+// The channel is closed once the subscription is unsubscribed or the observer is closed.
+func (o *Observer[K, V]) Subscribe(key K, opts ...SubscribeOption) (<-chan V, Subscription) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+
+	o.nextID++
+	id := o.nextID
+	sub := newSubscriber[V](&o.dropped, opts, func() {
+		o.mux.Lock()
+		defer o.mux.Unlock()
+		delete(o.keyed[key], id)
+		if len(o.keyed[key]) == 0 {
+			delete(o.keyed, key)
+		}
+	})
+
+	if o.closed {
+		sub.close()
+		return sub.ch, sub
+	}
+
+	if o.keyed[key] == nil {
+		o.keyed[key] = make(map[uint64]*subscriber[V])
+	}
+	o.keyed[key][id] = sub
+	return sub.ch, sub
+}
+
+// SubscribeAll returns a channel receiving every event emitted on any key.
+// The channel is closed once the subscription is unsubscribed or the observer is closed.
+func (o *Observer[K, V]) SubscribeAll(opts ...SubscribeOption) (<-chan Event[K, V], Subscription) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+
+	o.nextID++
+	id := o.nextID
+	sub := newSubscriber[Event[K, V]](&o.dropped, opts, func() {
+		o.mux.Lock()
+		defer o.mux.Unlock()
+		delete(o.wildcard, id)
+	})
+
+	if o.closed {
+		sub.close()
+		return sub.ch, sub
+	}
+
+	o.wildcard[id] = sub
+	return sub.ch, sub
+}
+
+// Emit delivers value to every subscriber of key and to every wildcard subscriber.
+// Emit only blocks if a subscriber with the Block policy has a full buffer.
+func (o *Observer[K, V]) Emit(key K, value V) {
+	// copy the subscribers so we don't hold the lock while delivering, otherwise a blocked
+	// subscriber could never unsubscribe.
+	o.mux.RLock()
+	keyed := make([]*subscriber[V], 0, len(o.keyed[key]))
+	for _, sub := range o.keyed[key] {
+		keyed = append(keyed, sub)
+	}
+	wildcard := make([]*subscriber[Event[K, V]], 0, len(o.wildcard))
+	for _, sub := range o.wildcard {
+		wildcard = append(wildcard, sub)
+	}
+	o.mux.RUnlock()
+
+	for _, sub := range keyed {
+		sub.send(value)
+	}
+	for _, sub := range wildcard {
+		sub.send(Event[K, V]{Key: key, Value: value})
+	}
+}
+
+// Dropped returns the number of events dropped across all subscribers.
+func (o *Observer[K, V]) Dropped() uint64 {
+	return o.dropped.Load()
+}
+
+// Close unsubscribes every subscriber. Subscriptions created after Close are closed immediately.
+func (o *Observer[K, V]) Close() {
+	o.mux.Lock()
+	o.closed = true
+	keyed := o.keyed
+	wildcard := o.wildcard
+	o.keyed = make(map[K]map[uint64]*subscriber[V])
+	o.wildcard = make(map[uint64]*subscriber[Event[K, V]])
+	o.mux.Unlock()
+
+	for _, subs := range keyed {
+		for _, sub := range subs {
+			sub.close()
+		}
+	}
+	for _, sub := range wildcard {
+		sub.close()
+	}
+}
+
+// subscriber is a single subscription delivering values of type T.
+type subscriber[T any] struct {
+	// mux serializes sends with closing the channel.
+	mux    sync.Mutex
+	ch     chan T
+	policy BackpressurePolicy
+	// done is closed on unsubscribe to unblock a pending send.
+	done      chan struct{}
+	closed    bool
+	closeOnce sync.Once
+	// remove removes the subscriber from the observer.
+	remove func()
+
+	dropped      atomic.Uint64
+	totalDropped *atomic.Uint64
+}
+
+func newSubscriber[T any](totalDropped *atomic.Uint64, opts []SubscribeOption, remove func()) *subscriber[T] {
+	cfg := subscribeConfig{
+		bufferSize: defaultBufferSize,
+		policy:     Block,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	// dropping events needs somewhere to drop them from.
+	if cfg.bufferSize < 1 && cfg.policy != Block {
+		cfg.bufferSize = 1
+	}
+	if cfg.bufferSize < 0 {
+		cfg.bufferSize = 0
+	}
+
+	return &subscriber[T]{
+		ch:           make(chan T, cfg.bufferSize),
+		policy:       cfg.policy,
+		done:         make(chan struct{}),
+		remove:       remove,
+		totalDropped: totalDropped,
+	}
+}
+
+func (s *subscriber[T]) send(value T) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	switch s.policy {
+	case Block:
+		select {
+		case s.ch <- value:
+		case <-s.done:
+			s.drop()
+		}
+	case DropNewest:
+		select {
+		case s.ch <- value:
+		default:
+			s.drop()
+		}
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- value:
+				return
+			default:
+			}
+
+			// the buffer is full, make room. the receiver might have emptied it in the meantime.
+			select {
+			case <-s.ch:
+				s.drop()
+			default:
+			}
+		}
+	}
+}
+
+func (s *subscriber[T]) drop() {
+	s.dropped.Add(1)
+	s.totalDropped.Add(1)
+}
+
+// Unsubscribe stops delivery and closes the subscription channel.
+func (s *subscriber[T]) Unsubscribe() {
+	s.remove()
+	s.close()
+}
+
+// close unblocks pending sends and closes the channel.
+func (s *subscriber[T]) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		s.closed = true
+		close(s.ch)
+	})
+}
+
+// Dropped returns the number of events that were not delivered to this subscriber.
+func (s *subscriber[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
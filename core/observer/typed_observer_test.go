@@ -0,0 +1,155 @@
+package observer_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/observer"
+)
+
+func (s *ObserverSuite) TestObserverSubscribe() {
+	testObserver := observer.NewObserver[string, int]()
+
+	key := gofakeit.Word()
+	ch, sub := testObserver.Subscribe(key)
+	otherCh, otherSub := testObserver.Subscribe(key + "-other")
+	defer otherSub.Unsubscribe()
+
+	for i := 0; i < 10; i++ {
+		testObserver.Emit(key, i)
+	}
+	for i := 0; i < 10; i++ {
+		Equal(s.T(), i, <-ch)
+	}
+	Empty(s.T(), otherCh)
+
+	sub.Unsubscribe()
+	// emitting after unsubscribing should be a no-op.
+	testObserver.Emit(key, 10)
+	_, ok := <-ch
+	False(s.T(), ok)
+	// unsubscribing twice is fine.
+	NotPanics(s.T(), sub.Unsubscribe)
+}
+
+func (s *ObserverSuite) TestObserverSubscribeAll() {
+	testObserver := observer.NewObserver[int, string]()
+
+	ch, sub := testObserver.SubscribeAll()
+	defer sub.Unsubscribe()
+
+	testObserver.Emit(1, "a")
+	testObserver.Emit(2, "b")
+
+	Equal(s.T(), observer.Event[int, string]{Key: 1, Value: "a"}, <-ch)
+	Equal(s.T(), observer.Event[int, string]{Key: 2, Value: "b"}, <-ch)
+}
+
+func (s *ObserverSuite) TestObserverDropNewest() {
+	testObserver := observer.NewObserver[string, int]()
+
+	ch, sub := testObserver.Subscribe("key", observer.WithBufferSize(2), observer.WithPolicy(observer.DropNewest))
+	defer sub.Unsubscribe()
+
+	for i := 0; i < 5; i++ {
+		testObserver.Emit("key", i)
+	}
+
+	Equal(s.T(), 0, <-ch)
+	Equal(s.T(), 1, <-ch)
+	Empty(s.T(), ch)
+	Equal(s.T(), uint64(3), sub.Dropped())
+	Equal(s.T(), uint64(3), testObserver.Dropped())
+}
+
+func (s *ObserverSuite) TestObserverDropOldest() {
+	testObserver := observer.NewObserver[string, int]()
+
+	ch, sub := testObserver.Subscribe("key", observer.WithBufferSize(2), observer.WithPolicy(observer.DropOldest))
+	defer sub.Unsubscribe()
+
+	for i := 0; i < 5; i++ {
+		testObserver.Emit("key", i)
+	}
+
+	Equal(s.T(), 3, <-ch)
+	Equal(s.T(), 4, <-ch)
+	Equal(s.T(), uint64(3), sub.Dropped())
+}
+
+// TestObserverBlockUnsubscribe makes sure a blocked Emit returns once the slow subscriber goes away.
+func (s *ObserverSuite) TestObserverBlockUnsubscribe() {
+	testObserver := observer.NewObserver[string, int]()
+
+	_, sub := testObserver.Subscribe("key", observer.WithBufferSize(1), observer.WithPolicy(observer.Block))
+	testObserver.Emit("key", 0)
+
+	emitted := make(chan struct{})
+	go func() {
+		defer close(emitted)
+		testObserver.Emit("key", 1)
+	}()
+
+	select {
+	case <-emitted:
+		s.T().Fatal("emit should block while the buffer is full")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	sub.Unsubscribe()
+	select {
+	case <-emitted:
+	case <-s.GetTestContext().Done():
+		s.T().Fatal("emit never returned")
+	}
+	Equal(s.T(), uint64(1), sub.Dropped())
+}
+
+func (s *ObserverSuite) TestObserverClose() {
+	testObserver := observer.NewObserver[string, int]()
+
+	ch, _ := testObserver.Subscribe("key")
+	allCh, _ := testObserver.SubscribeAll()
+
+	testObserver.Close()
+	_, ok := <-ch
+	False(s.T(), ok)
+	_, ok = <-allCh
+	False(s.T(), ok)
+
+	ch, _ = testObserver.Subscribe("key")
+	_, ok = <-ch
+	False(s.T(), ok)
+}
+
+func (s *ObserverSuite) TestObserverConcurrentEmit() {
+	const subscriberCount = 20
+	const emitCount = 100
+
+	testObserver := observer.NewObserver[string, int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < subscriberCount; i++ {
+		ch, sub := testObserver.Subscribe("key", observer.WithBufferSize(1))
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			received := 0
+			for range ch {
+				received++
+				// half of the subscribers go away early, this should never block Emit.
+				if i%2 == 0 && received == emitCount/2 {
+					sub.Unsubscribe()
+				}
+			}
+		}(i)
+	}
+
+	for i := 0; i < emitCount; i++ {
+		testObserver.Emit("key", i)
+	}
+	testObserver.Close()
+	wg.Wait()
+}
@@ -0,0 +1,295 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// BreakerState is the state of a circuit breaker.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=BreakerState -linecomment
+type BreakerState uint8
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = iota // closed
+	// BreakerOpen rejects every call until the open timeout elapses.
+	BreakerOpen // open
+	// BreakerHalfOpen lets a limited number of probe calls through.
+	BreakerHalfOpen // half-open
+)
+
+const (
+	breakerMeterName      = "github.com/synapsecns/sanguine/core/retry"
+	breakerStateGauge     = "retry_breaker_state"
+	breakerTransitionName = "retry_breaker_transitions"
+	breakerNameAttr       = "breaker"
+)
+
+// Breaker is a circuit breaker that can be shared between many WithBackoff callers.
+// After failureThreshold consecutive failures the breaker opens and every caller fails fast.
+// Once openTimeout has elapsed the breaker goes half-open and lets halfOpenMaxCalls probes through:
+// a successful probe closes the breaker, a failed one opens it again.
+type Breaker struct {
+	name string
+	mux  sync.Mutex
+
+	state            BreakerState
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight int
+	// halfOpenPeriod is incremented each time the breaker goes half-open, so a probe from an earlier period
+	// can't release a slot claimed in a later one.
+	halfOpenPeriod int
+
+	failureThreshold int
+	openTimeout      time.Duration
+	halfOpenMaxCalls int
+
+	handler     metrics.Handler
+	transitions metric.Int64Counter
+	// now is overridable for testing.
+	now func() time.Time
+}
+
+// BreakerOption configures a Breaker.
+type BreakerOption func(*Breaker)
+
+// WithFailureThreshold sets the number of consecutive failures that opens the breaker.
+func WithFailureThreshold(threshold int) BreakerOption {
+	return func(b *Breaker) {
+		b.failureThreshold = threshold
+	}
+}
+
+// WithOpenTimeout sets how long the breaker stays open before letting probes through.
+func WithOpenTimeout(timeout time.Duration) BreakerOption {
+	return func(b *Breaker) {
+		b.openTimeout = timeout
+	}
+}
+
+// WithHalfOpenMaxCalls sets the number of concurrent probe calls allowed while half-open.
+func WithHalfOpenMaxCalls(maxCalls int) BreakerOption {
+	return func(b *Breaker) {
+		b.halfOpenMaxCalls = maxCalls
+	}
+}
+
+// WithBreakerMetrics exports the breaker state and its transitions through the metrics handler.
+func WithBreakerMetrics(handler metrics.Handler) BreakerOption {
+	return func(b *Breaker) {
+		b.handler = handler
+	}
+}
+
+// NewBreaker creates a new, unshared circuit breaker. Most callers should use GetBreaker instead.
+func NewBreaker(name string, opts ...BreakerOption) (*Breaker, error) {
+	b := &Breaker{
+		name:             name,
+		state:            BreakerClosed,
+		failureThreshold: 5,
+		openTimeout:      30 * time.Second,
+		halfOpenMaxCalls: 1,
+		now:              time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	if b.handler != nil {
+		if err := b.setupMetrics(); err != nil {
+			return nil, fmt.Errorf("could not setup breaker metrics: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+var (
+	breakersMux sync.Mutex
+	breakers    = make(map[string]*Breaker)
+)
+
+// GetBreaker returns the shared breaker registered under name, creating it if it does not exist.
+// Options are only applied when the breaker is created.
+func GetBreaker(name string, opts ...BreakerOption) (*Breaker, error) {
+	breakersMux.Lock()
+	defer breakersMux.Unlock()
+
+	if b, ok := breakers[name]; ok {
+		return b, nil
+	}
+
+	b, err := NewBreaker(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	breakers[name] = b
+	return b, nil
+}
+
+// Name returns the name of the breaker.
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.maybeHalfOpen()
+	return b.state
+}
+
+// Reset forces the breaker back into the closed state.
+func (b *Breaker) Reset() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.failures = 0
+	b.halfOpenInFlight = 0
+	b.transition(BreakerClosed)
+}
+
+// allow returns true if a call may go through. The returned release func must be called once the call has
+// returned, on every exit path: it frees the probe slot the call claimed while half-open, unless recordSuccess or
+// recordFailure already did.
+func (b *Breaker) allow() (release func(), ok bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.maybeHalfOpen()
+
+	switch b.state {
+	case BreakerClosed:
+		return func() {}, true
+	case BreakerHalfOpen:
+		if b.halfOpenInFlight >= b.halfOpenMaxCalls {
+			return nil, false
+		}
+		b.halfOpenInFlight++
+		return b.releaser(b.halfOpenPeriod), true
+	default:
+		return nil, false
+	}
+}
+
+// releaser returns a func that frees a probe slot claimed during period, at most once.
+func (b *Breaker) releaser(period int) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mux.Lock()
+			defer b.mux.Unlock()
+
+			if b.state == BreakerHalfOpen && b.halfOpenPeriod == period && b.halfOpenInFlight > 0 {
+				b.halfOpenInFlight--
+			}
+		})
+	}
+}
+
+// recordSuccess records a healthy response. It is a no-op on a nil breaker.
+func (b *Breaker) recordSuccess() {
+	if b == nil {
+		return
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.failures = 0
+	if b.state == BreakerHalfOpen {
+		b.halfOpenInFlight = 0
+		b.transition(BreakerClosed)
+	}
+}
+
+// recordFailure records a failed call. It is a no-op on a nil breaker.
+func (b *Breaker) recordFailure() {
+	if b == nil {
+		return
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.state {
+	case BreakerHalfOpen:
+		b.halfOpenInFlight = 0
+		b.open()
+	case BreakerClosed:
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.open()
+		}
+	case BreakerOpen:
+	}
+}
+
+// open opens the breaker. Must be called with the lock held.
+func (b *Breaker) open() {
+	b.failures = 0
+	b.openedAt = b.now()
+	b.transition(BreakerOpen)
+}
+
+// maybeHalfOpen moves an open breaker to half-open once the timeout elapsed. Must be called with the lock held.
+func (b *Breaker) maybeHalfOpen() {
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.openTimeout {
+		b.halfOpenInFlight = 0
+		b.halfOpenPeriod++
+		b.transition(BreakerHalfOpen)
+	}
+}
+
+// transition sets the state and records the change. Must be called with the lock held.
+func (b *Breaker) transition(to BreakerState) {
+	from := b.state
+	if from == to {
+		return
+	}
+	b.state = to
+
+	if b.transitions != nil {
+		b.transitions.Add(context.Background(), 1, metric.WithAttributes(
+			attribute.String(breakerNameAttr, b.name),
+			attribute.String("from", from.String()),
+			attribute.String("to", to.String()),
+		))
+	}
+}
+
+func (b *Breaker) setupMetrics() (err error) {
+	meter := b.handler.Meter(breakerMeterName)
+
+	b.transitions, err = meter.Int64Counter(breakerTransitionName, metric.WithDescription("circuit breaker state transitions"))
+	if err != nil {
+		return fmt.Errorf("could not create counter: %w", err)
+	}
+
+	stateGauge, err := meter.Int64ObservableGauge(breakerStateGauge, metric.WithDescription("circuit breaker state: 0 closed, 1 open, 2 half-open"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(stateGauge, int64(b.State()), metric.WithAttributes(attribute.String(breakerNameAttr, b.name)))
+		return nil
+	}, stateGauge)
+	if err != nil {
+		return fmt.Errorf("could not register callback: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/retry"
+)
+
+func TestBreaker(t *testing.T) {
+	breaker, err := retry.NewBreaker(gofakeit.Word(), retry.WithFailureThreshold(2), retry.WithOpenTimeout(time.Minute), retry.WithBreakerMetrics(metrics.NewNullHandler()))
+	Nil(t, err)
+
+	now := time.Now()
+	retry.SetBreakerClock(breaker, func() time.Time {
+		return now
+	})
+
+	var calls int
+	failingFunc := func(ctx context.Context) error {
+		calls++
+		return errors.New("upstream down")
+	}
+
+	// two failures should open the breaker.
+	err = retry.WithBackoff(context.Background(), failingFunc, retry.WithBreaker(breaker), retry.WithMaxAttempts(5), retry.WithMin(time.Millisecond), retry.WithMax(time.Millisecond))
+	ErrorIs(t, err, retry.ErrBreakerOpen)
+	Equal(t, 2, calls)
+	Equal(t, retry.BreakerOpen, breaker.State())
+
+	// while open, callers fail fast.
+	err = retry.WithBackoff(context.Background(), failingFunc, retry.WithBreaker(breaker))
+	ErrorIs(t, err, retry.ErrBreakerOpen)
+	Equal(t, 2, calls)
+
+	// after the timeout, a successful probe closes the breaker.
+	now = now.Add(time.Minute)
+	Equal(t, retry.BreakerHalfOpen, breaker.State())
+
+	err = retry.WithBackoff(context.Background(), func(ctx context.Context) error {
+		return nil
+	}, retry.WithBreaker(breaker))
+	Nil(t, err)
+	Equal(t, retry.BreakerClosed, breaker.State())
+}
+
+func TestBreakerPermanentErrorsDoNotTrip(t *testing.T) {
+	breaker, err := retry.NewBreaker(gofakeit.Word(), retry.WithFailureThreshold(1))
+	Nil(t, err)
+
+	err = retry.WithBackoff(context.Background(), func(ctx context.Context) error {
+		return retry.Permanent(errors.New("reverted"))
+	}, retry.WithBreaker(breaker))
+	NotNil(t, err)
+	Equal(t, retry.BreakerClosed, breaker.State())
+}
+
+func TestGetBreaker(t *testing.T) {
+	name := gofakeit.UUID()
+
+	first, err := retry.GetBreaker(name, retry.WithFailureThreshold(1))
+	Nil(t, err)
+
+	second, err := retry.GetBreaker(name)
+	Nil(t, err)
+
+	Same(t, first, second)
+	Equal(t, name, second.Name())
+	Equal(t, "half-open", retry.BreakerHalfOpen.String())
+}
+
+func TestBreakerCanceledProbeReleasesSlot(t *testing.T) {
+	breaker, err := retry.NewBreaker(gofakeit.Word(), retry.WithFailureThreshold(1), retry.WithOpenTimeout(time.Minute))
+	Nil(t, err)
+
+	now := time.Now()
+	retry.SetBreakerClock(breaker, func() time.Time {
+		return now
+	})
+
+	err = retry.WithBackoff(context.Background(), func(ctx context.Context) error {
+		return errors.New("upstream down")
+	}, retry.WithBreaker(breaker), retry.WithMaxAttempts(1))
+	ErrorIs(t, err, retry.ErrBreakerOpen)
+
+	now = now.Add(time.Minute)
+	Equal(t, retry.BreakerHalfOpen, breaker.State())
+
+	// the probe's caller is canceled while it's in flight, so its result isn't recorded.
+	ctx, cancel := context.WithCancel(context.Background())
+	err = retry.WithBackoff(ctx, func(ctx context.Context) error {
+		cancel()
+		return ctx.Err()
+	}, retry.WithBreaker(breaker))
+	NotNil(t, err)
+	Equal(t, retry.BreakerHalfOpen, breaker.State())
+
+	// the slot was released, so the next probe goes through and closes the breaker.
+	err = retry.WithBackoff(context.Background(), func(ctx context.Context) error {
+		return nil
+	}, retry.WithBreaker(breaker))
+	Nil(t, err)
+	Equal(t, retry.BreakerClosed, breaker.State())
+}
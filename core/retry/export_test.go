@@ -53,3 +53,10 @@ func Configurator(tb testing.TB, configurator RetryWithBackoffConfig, apply ...W
 	}
 	return configurator
 }
+
+// SetBreakerClock overrides the clock used by the breaker.
+func SetBreakerClock(b *Breaker, now func() time.Time) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.now = now
+}
@@ -0,0 +1,41 @@
+package retry
+
+import "errors"
+
+// permanentError wraps an error that should never be retried.
+type permanentError struct {
+	err error
+}
+
+func (p *permanentError) Error() string {
+	return p.err.Error()
+}
+
+func (p *permanentError) Unwrap() error {
+	return p.err
+}
+
+// Permanent marks an error as permanent. WithBackoff stops retrying as soon as a permanent error
+// is returned and hands back the wrapped error. This is useful for reverts or 4xx responses
+// where retrying cannot succeed.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent returns true if the error (or any error it wraps) was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permErr *permanentError
+	return errors.As(err, &permErr)
+}
+
+// unwrapPermanent returns the underlying error if err is a top level permanent error.
+func unwrapPermanent(err error) error {
+	var permErr *permanentError
+	if errors.As(err, &permErr) && permErr == err {
+		return permErr.err
+	}
+	return err
+}
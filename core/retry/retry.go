@@ -25,6 +25,11 @@ type retryWithBackoffConfig struct {
 	// maxAllAttempts sets the maximum time for all attempts.
 	// if this is negative it is ignored
 	maxAllAttemptsTime time.Duration
+	// retryIf decides whether an error should be retried.
+	// if this is nil every error is retried
+	retryIf func(error) bool
+	// breaker is an optional circuit breaker shared between callers.
+	breaker *Breaker
 }
 
 // returns true if the number of attempts exceeds the maximum number of attempts.
@@ -89,6 +94,30 @@ func WithMaxTotalTime(maxTotalTime time.Duration) WithBackoffConfigurator {
 	}
 }
 
+// WithRetryIf sets a predicate that decides whether an error should be retried.
+// If the predicate returns false, WithBackoff stops and returns the error as is.
+func WithRetryIf(retryIf func(error) bool) WithBackoffConfigurator {
+	return func(c *retryWithBackoffConfig) {
+		c.retryIf = retryIf
+	}
+}
+
+// WithBreaker attaches a circuit breaker to the retry loop. While the breaker is open
+// WithBackoff fails fast with ErrBreakerOpen instead of calling the function.
+func WithBreaker(breaker *Breaker) WithBackoffConfigurator {
+	return func(c *retryWithBackoffConfig) {
+		c.breaker = breaker
+	}
+}
+
+// shouldRetry returns false if the error is permanent or rejected by the retryIf predicate.
+func (r *retryWithBackoffConfig) shouldRetry(err error) bool {
+	if IsPermanent(err) {
+		return false
+	}
+	return r.retryIf == nil || r.retryIf(err)
+}
+
 func defaultConfig() retryWithBackoffConfig {
 	return retryWithBackoffConfig{
 		factor:             2,
@@ -124,24 +153,19 @@ func WithBackoff(ctx context.Context, doFunc RetryableFunc, configurators ...Wit
 		case <-ctx.Done():
 			return fmt.Errorf("%w while retrying", ctx.Err())
 		case <-time.After(timeout):
-			var funcCtx context.Context
-			var cancel context.CancelFunc
-
-			if config.maxAttemptTime > 0 {
-				funcCtx, cancel = context.WithTimeout(ctx, config.maxAttemptTime)
-			} else {
-				funcCtx, cancel = context.WithCancel(ctx)
+			allowed, err := config.attempt(ctx, doFunc)
+			if !allowed {
+				return errorUtil.Wrapf(ErrBreakerOpen, "breaker %s after %d attempts", config.breaker.Name(), attempts)
 			}
-
-			err := doFunc(funcCtx)
-			if err != nil {
-				timeout = b.Duration()
-				attempts++
-				cancel()
-			} else {
-				cancel()
+			if err == nil {
 				return nil
 			}
+			if !config.shouldRetry(err) {
+				return unwrapPermanent(err)
+			}
+
+			timeout = b.Duration()
+			attempts++
 		}
 	}
 
@@ -161,5 +185,46 @@ funcCtx, cancel = context.WithCancel(ctx)
 // ErrMaxTime is returned when the maximum time for all retry attempts is reached.
 var ErrMaxTime = errors.New("max time reached")
 
+// attempt calls doFunc once and records the result on the breaker, if one is attached. allowed is false if the
+// breaker rejected the call. The probe slot the call may have claimed is released on every exit path, including a
+// canceled caller, so the breaker can't get stuck half-open.
+func (r *retryWithBackoffConfig) attempt(ctx context.Context, doFunc RetryableFunc) (allowed bool, err error) {
+	if r.breaker != nil {
+		release, ok := r.breaker.allow()
+		if !ok {
+			return false, nil
+		}
+		defer release()
+	}
+
+	var funcCtx context.Context
+	var cancel context.CancelFunc
+
+	if r.maxAttemptTime > 0 {
+		funcCtx, cancel = context.WithTimeout(ctx, r.maxAttemptTime)
+	} else {
+		funcCtx, cancel = context.WithCancel(ctx)
+	}
+
+	err = doFunc(funcCtx)
+	cancel()
+
+	switch {
+	case err == nil:
+		r.breaker.recordSuccess()
+	case !r.shouldRetry(err):
+		// the upstream answered, so this does not count against the breaker.
+		r.breaker.recordSuccess()
+	case ctx.Err() == nil:
+		r.breaker.recordFailure()
+	default:
+		// a canceled caller says nothing about the health of the upstream.
+	}
+	return true, err
+}
+
+// ErrBreakerOpen is returned when the attached circuit breaker is open.
+var ErrBreakerOpen = errors.New("circuit breaker open")
+
 // ErrUnknown is returned when an unknown error occurs.
 var ErrUnknown = errors.New("unknown error")
@@ -119,4 +119,40 @@ func TestRetryWithBackoff(t *testing.T) {
 			t.Errorf("Expected to run for at least %s second, but ran for %s", testDuration.String(), time.Since(startTime))
 		}
 	})
+
+	t.Run("Permanent", func(t *testing.T) {
+		var attempts int
+		testErr := errors.New("reverted")
+		err := retry.WithBackoff(context.Background(), func(ctx context.Context) error {
+			attempts++
+			return retry.Permanent(testErr)
+		}, retry.WithMaxAttempts(3))
+		Equal(t, testErr, err)
+		Equal(t, 1, attempts)
+	})
+
+	t.Run("WithRetryIf", func(t *testing.T) {
+		var attempts int
+		retryableErr := errors.New("timeout")
+		fatalErr := errors.New("bad request")
+		err := retry.WithBackoff(context.Background(), func(ctx context.Context) error {
+			attempts++
+			if attempts < 2 {
+				return retryableErr
+			}
+			return fatalErr
+		}, retry.WithMaxAttempts(5), retry.WithMin(time.Millisecond), retry.WithRetryIf(func(err error) bool {
+			return errors.Is(err, retryableErr)
+		}))
+		ErrorIs(t, err, fatalErr)
+		Equal(t, 2, attempts)
+	})
+}
+
+func TestIsPermanent(t *testing.T) {
+	Nil(t, retry.Permanent(nil))
+	False(t, retry.IsPermanent(errors.New("test")))
+
+	wrapped := fmt.Errorf("wrapped: %w", retry.Permanent(errors.New("test")))
+	True(t, retry.IsPermanent(wrapped))
 }
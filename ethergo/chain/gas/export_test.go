@@ -6,3 +6,10 @@
 func Min(a, b *big.Int) *big.Int {
 	return min(a, b)
 }
+
+const (
+	// OPGasPriceOracleABI exports opGasPriceOracleABI for testing.
+	OPGasPriceOracleABI = opGasPriceOracleABI
+	// ArbNodeInterfaceABI exports arbNodeInterfaceABI for testing.
+	ArbNodeInterfaceABI = arbNodeInterfaceABI
+)
@@ -0,0 +1,223 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RollupType is the type of rollup a chain is. It determines how the L1 data fee of a transaction is estimated.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=RollupType -linecomment
+type RollupType uint8
+
+const (
+	// NotRollup is a chain without an L1 data fee.
+	NotRollup RollupType = iota // none
+	// OPStack is an OP stack chain. The L1 data fee is charged on top of execution, and is estimated with the
+	// GasPriceOracle predeploy.
+	OPStack // op_stack
+	// Arbitrum is an Arbitrum chain. The L1 data fee is charged as extra L2 gas, and is estimated with the
+	// NodeInterface precompile.
+	Arbitrum // arbitrum
+)
+
+// ParseRollupType parses a rollup type from its string representation. An empty string is NotRollup.
+func ParseRollupType(rollupType string) (RollupType, error) {
+	if rollupType == "" {
+		return NotRollup, nil
+	}
+
+	for _, candidate := range []RollupType{NotRollup, OPStack, Arbitrum} {
+		if strings.EqualFold(candidate.String(), rollupType) {
+			return candidate, nil
+		}
+	}
+	return NotRollup, fmt.Errorf("unknown rollup type: %s", rollupType)
+}
+
+var (
+	// OPGasPriceOracleAddress is the address of the GasPriceOracle predeploy on OP stack chains.
+	OPGasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+	// ArbNodeInterfaceAddress is the address of the NodeInterface precompile on Arbitrum chains.
+	ArbNodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+)
+
+const (
+	opGasPriceOracleABI = `[{"inputs":[{"internalType":"bytes","name":"_data","type":"bytes"}],"name":"getL1Fee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	arbNodeInterfaceABI = `[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"bool","name":"contractCreation","type":"bool"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"gasEstimateL1Component","outputs":[{"internalType":"uint64","name":"gasEstimateForL1","type":"uint64"},{"internalType":"uint256","name":"baseFee","type":"uint256"},{"internalType":"uint256","name":"l1BaseFeeEstimate","type":"uint256"}],"stateMutability":"payable","type":"function"}]`
+)
+
+// Cost is the maximum cost of a transaction in wei, split into its L2 execution and L1 data components.
+type Cost struct {
+	// Execution is the gas limit times the max price per gas.
+	Execution *big.Int
+	// L1Data is the L1 data fee. On Arbitrum, this is L1Gas at the max price per gas.
+	L1Data *big.Int
+	// L1Gas is the L2 gas Arbitrum charges for the L1 data component. This is zero on other chains.
+	L1Gas uint64
+}
+
+// Total returns the total cost. On Arbitrum, gas limits from eth_estimateGas already include L1Gas, so
+// gasLimitIncludesL1 should be set to avoid counting it twice.
+func (c Cost) Total(gasLimitIncludesL1 bool) *big.Int {
+	if gasLimitIncludesL1 && c.L1Gas != 0 {
+		return new(big.Int).Set(c.Execution)
+	}
+	return new(big.Int).Add(c.Execution, c.L1Data)
+}
+
+// MaxPricePerGas returns the highest max price per gas at which a tx with this cost's L1 data component and
+// gasLimit stays within maxCost, or nil if the L1 data fee alone exceeds maxCost. On Arbitrum, the L1 component is
+// charged as L1Gas at the same price, so it's added to the gas limit unless gasLimitIncludesL1 is set.
+func (c Cost) MaxPricePerGas(maxCost *big.Int, gasLimit uint64, gasLimitIncludesL1 bool) *big.Int {
+	budget := new(big.Int).Set(maxCost)
+	gasUsed := new(big.Int).SetUint64(gasLimit)
+
+	if c.L1Gas != 0 {
+		if !gasLimitIncludesL1 {
+			gasUsed.Add(gasUsed, new(big.Int).SetUint64(c.L1Gas))
+		}
+	} else {
+		budget.Sub(budget, c.L1Data)
+	}
+
+	if budget.Sign() <= 0 || gasUsed.Sign() == 0 {
+		return nil
+	}
+	return budget.Div(budget, gasUsed)
+}
+
+// EstimateCost estimates the maximum cost of a transaction, including the L1 data fee on rollups.
+func EstimateCost(ctx context.Context, caller ethereum.ContractCaller, rollupType RollupType, tx *types.Transaction) (cost Cost, err error) {
+	// for legacy txes, the fee cap is the gas price.
+	maxPricePerGas := tx.GasFeeCap()
+
+	cost = Cost{
+		Execution: new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), maxPricePerGas),
+		L1Data:    big.NewInt(0),
+	}
+
+	switch rollupType {
+	case NotRollup:
+	case OPStack:
+		cost.L1Data, err = estimateOPL1Fee(ctx, caller, tx)
+		if err != nil {
+			return Cost{}, err
+		}
+	case Arbitrum:
+		cost.L1Gas, err = estimateArbL1Gas(ctx, caller, tx)
+		if err != nil {
+			return Cost{}, err
+		}
+		cost.L1Data = new(big.Int).Mul(new(big.Int).SetUint64(cost.L1Gas), maxPricePerGas)
+	default:
+		return Cost{}, fmt.Errorf("unknown rollup type: %s", rollupType)
+	}
+
+	return cost, nil
+}
+
+// estimateOPL1Fee gets the L1 fee of the tx from the GasPriceOracle.
+func estimateOPL1Fee(ctx context.Context, caller ethereum.ContractCaller, tx *types.Transaction) (*big.Int, error) {
+	txData, err := unsignedTxData(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var fee *big.Int
+	err = callPredeploy(ctx, caller, opGasPriceOracleABI, OPGasPriceOracleAddress, "getL1Fee", func(out []interface{}) {
+		fee = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	}, txData)
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// estimateArbL1Gas gets the L2 gas charged for the L1 component of the tx from the NodeInterface.
+func estimateArbL1Gas(ctx context.Context, caller ethereum.ContractCaller, tx *types.Transaction) (uint64, error) {
+	to := common.Address{}
+	if tx.To() != nil {
+		to = *tx.To()
+	}
+
+	var l1Gas uint64
+	err := callPredeploy(ctx, caller, arbNodeInterfaceABI, ArbNodeInterfaceAddress, "gasEstimateL1Component", func(out []interface{}) {
+		l1Gas = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	}, to, tx.To() == nil, tx.Data())
+	if err != nil {
+		return 0, err
+	}
+	return l1Gas, nil
+}
+
+// callPredeploy calls method on the predeploy at address and passes the unpacked outputs to unpack.
+func callPredeploy(ctx context.Context, caller ethereum.ContractCaller, rawABI string, address common.Address, method string, unpack func(out []interface{}), args ...interface{}) error {
+	contractABI, err := abi.JSON(strings.NewReader(rawABI))
+	if err != nil {
+		return fmt.Errorf("could not parse abi: %w", err)
+	}
+
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("could not pack %s: %w", method, err)
+	}
+
+	output, err := caller.CallContract(ctx, ethereum.CallMsg{To: &address, Data: input}, nil)
+	if err != nil {
+		return fmt.Errorf("could not call %s: %w", method, err)
+	}
+
+	out, err := contractABI.Unpack(method, output)
+	if err != nil {
+		return fmt.Errorf("could not unpack %s: %w", method, err)
+	}
+	if len(out) == 0 {
+		return fmt.Errorf("no output from %s", method)
+	}
+
+	unpack(out)
+	return nil
+}
+
+// unsignedTxData returns the rlp encoding of the tx without its signature, which is what the GasPriceOracle expects.
+// Tx types other than legacy and dynamic fee are encoded with their signature, which slightly overestimates the fee.
+func unsignedTxData(tx *types.Transaction) ([]byte, error) {
+	unsignedTx := tx
+	switch tx.Type() {
+	case types.LegacyTxType:
+		unsignedTx = types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: tx.GasPrice(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	case types.DynamicFeeTxType:
+		unsignedTx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	}
+
+	data, err := unsignedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode tx: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,102 @@
+package gas_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/stretchr/testify/assert"
+	"github.com/synapsecns/sanguine/ethergo/chain/gas"
+)
+
+// predeployCaller returns fixed outputs from the rollup fee predeploys.
+type predeployCaller struct {
+	opL1Fee  *big.Int
+	arbL1Gas uint64
+}
+
+func (p predeployCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	switch *call.To {
+	case gas.OPGasPriceOracleAddress:
+		return packOutput(gas.OPGasPriceOracleABI, "getL1Fee", p.opL1Fee)
+	case gas.ArbNodeInterfaceAddress:
+		return packOutput(gas.ArbNodeInterfaceABI, "gasEstimateL1Component", p.arbL1Gas, big.NewInt(0), big.NewInt(0))
+	default:
+		return nil, fmt.Errorf("unexpected call to %s", call.To)
+	}
+}
+
+func packOutput(rawABI, method string, outputs ...interface{}) ([]byte, error) {
+	contractABI, err := abi.JSON(strings.NewReader(rawABI))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse abi: %w", err)
+	}
+	//nolint: wrapcheck
+	return contractABI.Methods[method].Outputs.Pack(outputs...)
+}
+
+func (s GasSuite) TestEstimateCost() {
+	to := common.HexToAddress("0x1")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Gas:       100_000,
+		GasFeeCap: big.NewInt(10),
+		GasTipCap: big.NewInt(1),
+		To:        &to,
+		Data:      []byte{1, 2, 3},
+	})
+	caller := predeployCaller{opL1Fee: big.NewInt(5_000_000), arbL1Gas: 20_000}
+
+	// execution is the gas limit at the fee cap.
+	cost, err := gas.EstimateCost(s.GetTestContext(), caller, gas.NotRollup, tx)
+	Nil(s.T(), err)
+	Equal(s.T(), big.NewInt(1_000_000), cost.Execution)
+	Equal(s.T(), big.NewInt(1_000_000), cost.Total(false))
+
+	// op stack charges the l1 fee on top of execution.
+	cost, err = gas.EstimateCost(s.GetTestContext(), caller, gas.OPStack, tx)
+	Nil(s.T(), err)
+	Equal(s.T(), big.NewInt(5_000_000), cost.L1Data)
+	Equal(s.T(), big.NewInt(6_000_000), cost.Total(false))
+	Equal(s.T(), big.NewInt(6_000_000), cost.Total(true))
+
+	// arbitrum charges the l1 component as l2 gas, which may already be in the gas limit.
+	cost, err = gas.EstimateCost(s.GetTestContext(), caller, gas.Arbitrum, tx)
+	Nil(s.T(), err)
+	Equal(s.T(), uint64(20_000), cost.L1Gas)
+	Equal(s.T(), big.NewInt(200_000), cost.L1Data)
+	Equal(s.T(), big.NewInt(1_200_000), cost.Total(false))
+	Equal(s.T(), big.NewInt(1_000_000), cost.Total(true))
+}
+
+func (s GasSuite) TestParseRollupType() {
+	for expected, raw := range map[gas.RollupType]string{gas.NotRollup: "", gas.OPStack: "op_stack", gas.Arbitrum: "ARBITRUM"} {
+		rollupType, err := gas.ParseRollupType(raw)
+		Nil(s.T(), err)
+		Equal(s.T(), expected, rollupType)
+	}
+
+	_, err := gas.ParseRollupType("zk")
+	NotNil(s.T(), err)
+}
+
+func (s GasSuite) TestMaxPricePerGas() {
+	maxCost := big.NewInt(6_000_000)
+
+	// without an l1 fee, the whole budget goes to execution.
+	Equal(s.T(), big.NewInt(60), gas.Cost{L1Data: big.NewInt(0)}.MaxPricePerGas(maxCost, 100_000, false))
+
+	// op stack takes the l1 fee out of the budget first.
+	opCost := gas.Cost{L1Data: big.NewInt(5_000_000)}
+	Equal(s.T(), big.NewInt(10), opCost.MaxPricePerGas(maxCost, 100_000, false))
+	Nil(s.T(), opCost.MaxPricePerGas(big.NewInt(5_000_000), 100_000, false))
+
+	// arbitrum prices the l1 gas like execution gas, unless it's already in the gas limit.
+	arbCost := gas.Cost{L1Data: big.NewInt(200_000), L1Gas: 20_000}
+	Equal(s.T(), big.NewInt(50), arbCost.MaxPricePerGas(maxCost, 100_000, false))
+	Equal(s.T(), big.NewInt(60), arbCost.MaxPricePerGas(maxCost, 100_000, true))
+}
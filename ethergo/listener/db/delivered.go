@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm/clause"
+)
+
+// PutDeliveredLog stores a log delivered from the logs subscription. Storing the same log again is a no-op.
+func (s Store) PutDeliveredLog(ctx context.Context, chainID uint64, listenerKey string, log types.Log) error {
+	dbTx := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&DeliveredLog{
+		ChainID:     chainID,
+		ListenerKey: listenerKey,
+		BlockHash:   log.BlockHash.String(),
+		LogIndex:    log.Index,
+		BlockNumber: log.BlockNumber,
+	})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not store delivered log: %w", dbTx.Error)
+	}
+	return nil
+}
+
+// DeliveredLogsFrom gets the logs delivered from fromBlock on.
+func (s Store) DeliveredLogsFrom(ctx context.Context, chainID uint64, listenerKey string, fromBlock uint64) ([]types.Log, error) {
+	var deliveredLogs []DeliveredLog
+	dbTx := s.db.WithContext(ctx).
+		Where(fmt.Sprintf("%s = ? AND %s = ? AND %s >= ?", chainIDFieldName, listenerKeyFieldName, blockNumberFieldName), chainID, listenerKey, fromBlock).
+		Find(&deliveredLogs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not get delivered logs: %w", dbTx.Error)
+	}
+
+	logs := make([]types.Log, len(deliveredLogs))
+	for i, deliveredLog := range deliveredLogs {
+		logs[i] = types.Log{
+			BlockNumber: deliveredLog.BlockNumber,
+			BlockHash:   common.HexToHash(deliveredLog.BlockHash),
+			Index:       deliveredLog.LogIndex,
+		}
+	}
+	return logs, nil
+}
+
+// PruneDeliveredBefore deletes the delivered logs before beforeBlock.
+func (s Store) PruneDeliveredBefore(ctx context.Context, chainID uint64, listenerKey string, beforeBlock uint64) error {
+	dbTx := s.db.WithContext(ctx).
+		Where(fmt.Sprintf("%s = ? AND %s = ? AND %s < ?", chainIDFieldName, listenerKeyFieldName, blockNumberFieldName), chainID, listenerKey, beforeBlock).
+		Delete(&DeliveredLog{})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not prune delivered logs: %w", dbTx.Error)
+	}
+	return nil
+}
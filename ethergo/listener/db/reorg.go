@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PutProcessedBlocks stores the hashes of processed blocks and the logs delivered from them in one transaction.
+func (s Store) PutProcessedBlocks(ctx context.Context, chainID uint64, listenerKey string, hashes map[uint64]common.Hash, logs []types.Log) error {
+	blocks := make([]ProcessedBlock, 0, len(hashes))
+	for blockNumber, blockHash := range hashes {
+		blocks = append(blocks, ProcessedBlock{
+			ChainID:     chainID,
+			ListenerKey: listenerKey,
+			BlockNumber: blockNumber,
+			BlockHash:   blockHash.String(),
+		})
+	}
+
+	processedLogs := make([]ProcessedLog, len(logs))
+	for i, log := range logs {
+		// topics are required when the log is decoded.
+		if log.Topics == nil {
+			log.Topics = []common.Hash{}
+		}
+		rawLog, err := json.Marshal(log)
+		if err != nil {
+			return fmt.Errorf("could not encode log: %w", err)
+		}
+
+		processedLogs[i] = ProcessedLog{
+			ChainID:     chainID,
+			ListenerKey: listenerKey,
+			BlockHash:   log.BlockHash.String(),
+			LogIndex:    log.Index,
+			BlockNumber: log.BlockNumber,
+			RawLog:      rawLog,
+		}
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(blocks) > 0 {
+			dbTx := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: chainIDFieldName}, {Name: listenerKeyFieldName}, {Name: blockNumberFieldName}},
+				DoUpdates: clause.AssignmentColumns([]string{blockHashFieldName}),
+			}).Create(&blocks)
+			if dbTx.Error != nil {
+				return fmt.Errorf("could not store blocks: %w", dbTx.Error)
+			}
+		}
+
+		if len(processedLogs) > 0 {
+			dbTx := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&processedLogs)
+			if dbTx.Error != nil {
+				return fmt.Errorf("could not store logs: %w", dbTx.Error)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not store processed blocks: %w", err)
+	}
+	return nil
+}
+
+// ProcessedBlocks gets the hashes of the processed blocks that are still stored.
+func (s Store) ProcessedBlocks(ctx context.Context, chainID uint64, listenerKey string) (map[uint64]common.Hash, error) {
+	var blocks []ProcessedBlock
+	dbTx := s.db.WithContext(ctx).
+		Where(fmt.Sprintf("%s = ? AND %s = ?", chainIDFieldName, listenerKeyFieldName), chainID, listenerKey).
+		Find(&blocks)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not get processed blocks: %w", dbTx.Error)
+	}
+
+	hashes := make(map[uint64]common.Hash, len(blocks))
+	for _, block := range blocks {
+		hashes[block.BlockNumber] = common.HexToHash(block.BlockHash)
+	}
+	return hashes, nil
+}
+
+// ProcessedLogsFrom gets the delivered logs from fromBlock on, in the order they were delivered.
+func (s Store) ProcessedLogsFrom(ctx context.Context, chainID uint64, listenerKey string, fromBlock uint64) ([]types.Log, error) {
+	var processedLogs []ProcessedLog
+	dbTx := s.db.WithContext(ctx).
+		Where(fmt.Sprintf("%s = ? AND %s = ? AND %s >= ?", chainIDFieldName, listenerKeyFieldName, blockNumberFieldName), chainID, listenerKey, fromBlock).
+		Order(fmt.Sprintf("%s asc, %s asc", blockNumberFieldName, logIndexFieldName)).
+		Find(&processedLogs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not get processed logs: %w", dbTx.Error)
+	}
+
+	logs := make([]types.Log, len(processedLogs))
+	for i, processedLog := range processedLogs {
+		err := json.Unmarshal(processedLog.RawLog, &logs[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not decode log: %w", err)
+		}
+	}
+	return logs, nil
+}
+
+// DeleteProcessedFrom deletes the processed blocks and delivered logs from fromBlock on.
+func (s Store) DeleteProcessedFrom(ctx context.Context, chainID uint64, listenerKey string, fromBlock uint64) error {
+	return s.deleteProcessed(ctx, chainID, listenerKey, fmt.Sprintf("%s >= ?", blockNumberFieldName), fromBlock)
+}
+
+// PruneProcessedBefore deletes the processed blocks and delivered logs before beforeBlock.
+func (s Store) PruneProcessedBefore(ctx context.Context, chainID uint64, listenerKey string, beforeBlock uint64) error {
+	return s.deleteProcessed(ctx, chainID, listenerKey, fmt.Sprintf("%s < ?", blockNumberFieldName), beforeBlock)
+}
+
+// deleteProcessed deletes the processed blocks and delivered logs of a listener matching the block number condition.
+func (s Store) deleteProcessed(ctx context.Context, chainID uint64, listenerKey string, blockCondition string, blockNumber uint64) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{&ProcessedBlock{}, &ProcessedLog{}} {
+			dbTx := tx.Where(fmt.Sprintf("%s = ? AND %s = ?", chainIDFieldName, listenerKeyFieldName), chainID, listenerKey).
+				Where(blockCondition, blockNumber).
+				Delete(model)
+			if dbTx.Error != nil {
+				return fmt.Errorf("could not delete %T: %w", model, dbTx.Error)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete processed blocks: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@
 
 import (
 	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"gorm.io/gorm"
 	"time"
 )
@@ -15,6 +17,52 @@ type ChainListenerDB interface {
 	LatestBlockForChain(ctx context.Context, chainID uint64) (uint64, error)
 }
 
+// CursorDB is the interface for the latest block of each chain listener. Unlike ChainListenerDB, which has one latest
+// block per chain, listeners on the same chain each have their own, identified by their key.
+type CursorDB interface {
+	// PutListenerBlock upserts the latest block of a listener on a given chain id to be new height.
+	PutListenerBlock(ctx context.Context, chainID uint64, listenerKey string, height uint64) error
+	// ListenerBlock gets the latest block of a listener on a given chain id.
+	// will return ErrNoLatestBlockForChainID if no block exists for the listener.
+	ListenerBlock(ctx context.Context, chainID uint64, listenerKey string) (uint64, error)
+}
+
+// Service is the interface for the chain listener database with every feature of the listener.
+type Service interface {
+	ChainListenerDB
+	CursorDB
+	ReorgDB
+	DeliveredLogDB
+}
+
+// ReorgDB is the interface for the blocks and logs processed by a reorg aware chain listener.
+// Each listener on a chain is identified by its key.
+type ReorgDB interface {
+	// PutProcessedBlocks stores the hashes of processed blocks and the logs delivered from them.
+	PutProcessedBlocks(ctx context.Context, chainID uint64, listenerKey string, hashes map[uint64]common.Hash, logs []types.Log) error
+	// ProcessedBlocks gets the hashes of the processed blocks that are still stored.
+	ProcessedBlocks(ctx context.Context, chainID uint64, listenerKey string) (map[uint64]common.Hash, error)
+	// ProcessedLogsFrom gets the delivered logs from fromBlock on, in the order they were delivered.
+	ProcessedLogsFrom(ctx context.Context, chainID uint64, listenerKey string, fromBlock uint64) ([]types.Log, error)
+	// DeleteProcessedFrom deletes the processed blocks and delivered logs from fromBlock on.
+	DeleteProcessedFrom(ctx context.Context, chainID uint64, listenerKey string, fromBlock uint64) error
+	// PruneProcessedBefore deletes the processed blocks and delivered logs before beforeBlock.
+	PruneProcessedBefore(ctx context.Context, chainID uint64, listenerKey string, beforeBlock uint64) error
+}
+
+// DeliveredLogDB is the interface for the logs a chain listener delivered from its logs subscription before a poll
+// processed their blocks. They're kept so a poll doesn't deliver them again after a restart.
+// Each listener on a chain is identified by its key.
+type DeliveredLogDB interface {
+	// PutDeliveredLog stores a log delivered from the logs subscription.
+	PutDeliveredLog(ctx context.Context, chainID uint64, listenerKey string, log types.Log) error
+	// DeliveredLogsFrom gets the logs delivered from fromBlock on. Only the block number, block hash and index of
+	// each log are stored.
+	DeliveredLogsFrom(ctx context.Context, chainID uint64, listenerKey string, fromBlock uint64) ([]types.Log, error)
+	// PruneDeliveredBefore deletes the delivered logs before beforeBlock.
+	PruneDeliveredBefore(ctx context.Context, chainID uint64, listenerKey string, beforeBlock uint64) error
+}
+
 // LastIndexed is used to make sure we haven't missed any events while offline.
 // since we event source - rather than use a state machine this is needed to make sure we haven't missed any events
 // by allowing us to go back and source any events we may have missed.
@@ -33,9 +81,73 @@ type LastIndexed struct {
 	BlockNumber int `gorm:"block_number"`
 }
 
+// ListenerCursor is the latest block of a listener on a chain.
+type ListenerCursor struct {
+	// CreatedAt is the creation time
+	CreatedAt time.Time
+	// UpdatedAt is the update time
+	UpdatedAt time.Time
+	// ChainID is the chain id of the chain the listener is watching.
+	ChainID uint64 `gorm:"column:chain_id;primaryKey;autoIncrement:false"`
+	// ListenerKey is the key of the listener.
+	ListenerKey string `gorm:"column:listener_key;primaryKey"`
+	// BlockNumber is the latest block of the listener.
+	BlockNumber uint64 `gorm:"column:block_number"`
+}
+
+// ProcessedBlock is the hash of a block processed by a reorg aware listener. The hash is checked against the
+// canonical chain on each poll to detect reorgs.
+type ProcessedBlock struct {
+	// CreatedAt is the creation time
+	CreatedAt time.Time
+	// ChainID is the chain id of the block.
+	ChainID uint64 `gorm:"column:chain_id;primaryKey;autoIncrement:false"`
+	// ListenerKey is the key of the listener that processed the block.
+	ListenerKey string `gorm:"column:listener_key;primaryKey"`
+	// BlockNumber is the number of the block.
+	BlockNumber uint64 `gorm:"column:block_number;primaryKey;autoIncrement:false"`
+	// BlockHash is the hash of the block when it was processed.
+	BlockHash string `gorm:"column:block_hash"`
+}
+
+// ProcessedLog is a log delivered by a reorg aware listener. It's kept while its block can still be reorged,
+// so it can be passed to HandleRemovedLog if the block is orphaned.
+type ProcessedLog struct {
+	// CreatedAt is the creation time
+	CreatedAt time.Time
+	// ChainID is the chain id of the log.
+	ChainID uint64 `gorm:"column:chain_id;primaryKey;autoIncrement:false"`
+	// ListenerKey is the key of the listener that delivered the log.
+	ListenerKey string `gorm:"column:listener_key;primaryKey"`
+	// BlockHash is the hash of the block the log is in.
+	BlockHash string `gorm:"column:block_hash;primaryKey"`
+	// LogIndex is the index of the log in the block.
+	LogIndex uint `gorm:"column:log_index;primaryKey;autoIncrement:false"`
+	// BlockNumber is the number of the block the log is in.
+	BlockNumber uint64 `gorm:"column:block_number;index"`
+	// RawLog is the json encoded log.
+	RawLog []byte `gorm:"column:raw_log"`
+}
+
+// DeliveredLog is a log a listener delivered from its logs subscription. It's kept until a poll processes its block.
+type DeliveredLog struct {
+	// CreatedAt is the creation time
+	CreatedAt time.Time
+	// ChainID is the chain id of the log.
+	ChainID uint64 `gorm:"column:chain_id;primaryKey;autoIncrement:false"`
+	// ListenerKey is the key of the listener that delivered the log.
+	ListenerKey string `gorm:"column:listener_key;primaryKey"`
+	// BlockHash is the hash of the block the log is in.
+	BlockHash string `gorm:"column:block_hash;primaryKey"`
+	// LogIndex is the index of the log in the block.
+	LogIndex uint `gorm:"column:log_index;primaryKey;autoIncrement:false"`
+	// BlockNumber is the number of the block the log is in.
+	BlockNumber uint64 `gorm:"column:block_number;index"`
+}
+
 // GetAllModels gets all models to migrate
 // see: https://medium.com/@SaifAbid/slice-interfaces-8c78f8b6345d for an explanation of why we can't do this at initialization time
 func GetAllModels() (allModels []interface{}) {
-	allModels = []interface{}{&LastIndexed{}}
+	allModels = []interface{}{&LastIndexed{}, &ListenerCursor{}, &ProcessedBlock{}, &ProcessedLog{}, &DeliveredLog{}}
 	return allModels
 }
@@ -54,10 +54,48 @@ func (s Store) LatestBlockForChain(ctx context.Context, chainID uint64) (uint64,
 	return uint64(blockWatchModel.BlockNumber), nil
 }
 
+// PutListenerBlock upserts the latest block of a listener into the database.
+func (s Store) PutListenerBlock(ctx context.Context, chainID uint64, listenerKey string, height uint64) error {
+	tx := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: chainIDFieldName}, {Name: listenerKeyFieldName}},
+		DoUpdates: clause.AssignmentColumns([]string{blockNumberFieldName, "updated_at"}),
+	}).Create(&ListenerCursor{
+		ChainID:     chainID,
+		ListenerKey: listenerKey,
+		BlockNumber: height,
+	})
+
+	if tx.Error != nil {
+		return fmt.Errorf("could not update listener block: %w", tx.Error)
+	}
+	return nil
+}
+
+// ListenerBlock gets the latest block of a listener.
+func (s Store) ListenerBlock(ctx context.Context, chainID uint64, listenerKey string) (uint64, error) {
+	var cursor ListenerCursor
+	err := s.db.WithContext(ctx).
+		Where(fmt.Sprintf("%s = ? AND %s = ?", chainIDFieldName, listenerKeyFieldName), chainID, listenerKey).
+		First(&cursor).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, ErrNoLatestBlockForChainID
+		}
+		return 0, fmt.Errorf("could not fetch listener block: %w", err)
+	}
+
+	return cursor.BlockNumber, nil
+}
+
+var _ Service = Store{}
+
 func init() {
 	namer := dbcommon.NewNamer(GetAllModels())
 	chainIDFieldName = namer.GetConsistentName("ChainID")
 	blockNumberFieldName = namer.GetConsistentName("BlockNumber")
+	listenerKeyFieldName = namer.GetConsistentName("ListenerKey")
+	blockHashFieldName = namer.GetConsistentName("BlockHash")
+	logIndexFieldName = namer.GetConsistentName("LogIndex")
 }
 
 var (
@@ -65,6 +103,12 @@ func init() {
 	chainIDFieldName string
 	// blockNumberFieldName is the name of the block number field.
 	blockNumberFieldName string
+	// listenerKeyFieldName is the name of the listener key field.
+	listenerKeyFieldName string
+	// blockHashFieldName is the name of the block hash field.
+	blockHashFieldName string
+	// logIndexFieldName is the name of the log index field.
+	logIndexFieldName string
 )
 
 // ErrNoLatestBlockForChainID is returned when no block exists for the chain.
@@ -0,0 +1,69 @@
+package listener_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	db2 "github.com/synapsecns/sanguine/ethergo/listener/db"
+)
+
+func (l *ListenerTestSuite) TestStores() {
+	for _, store := range l.stores {
+		_, err := store.LatestBlockForChain(l.GetTestContext(), chainID)
+		l.ErrorIs(err, db2.ErrNoLatestBlockForChainID)
+
+		l.Require().NoError(store.PutLatestBlock(l.GetTestContext(), chainID, 10))
+		l.Require().NoError(store.PutLatestBlock(l.GetTestContext(), chainID, 11))
+		latest, err := store.LatestBlockForChain(l.GetTestContext(), chainID)
+		l.Require().NoError(err)
+		l.Equal(uint64(11), latest)
+
+		_, err = store.ListenerBlock(l.GetTestContext(), chainID, "a")
+		l.ErrorIs(err, db2.ErrNoLatestBlockForChainID)
+
+		l.Require().NoError(store.PutListenerBlock(l.GetTestContext(), chainID, "a", 20))
+		l.Require().NoError(store.PutListenerBlock(l.GetTestContext(), chainID, "a", 21))
+		l.Require().NoError(store.PutListenerBlock(l.GetTestContext(), chainID, "b", 30))
+		cursor, err := store.ListenerBlock(l.GetTestContext(), chainID, "a")
+		l.Require().NoError(err)
+		l.Equal(uint64(21), cursor)
+
+		hashes := map[uint64]common.Hash{1: common.HexToHash("0x1"), 2: common.HexToHash("0x2")}
+		logs := []types.Log{
+			{BlockNumber: 1, BlockHash: hashes[1], Index: 0},
+			{BlockNumber: 2, BlockHash: hashes[2], Index: 0},
+		}
+		l.Require().NoError(store.PutProcessedBlocks(l.GetTestContext(), chainID, "a", hashes, logs))
+		// storing the same logs again is a no-op.
+		l.Require().NoError(store.PutProcessedBlocks(l.GetTestContext(), chainID, "a", hashes, logs))
+
+		processed, err := store.ProcessedBlocks(l.GetTestContext(), chainID, "a")
+		l.Require().NoError(err)
+		l.Equal(hashes, processed)
+
+		processedLogs, err := store.ProcessedLogsFrom(l.GetTestContext(), chainID, "a", 2)
+		l.Require().NoError(err)
+		l.Require().Len(processedLogs, 1)
+		l.Equal(hashes[2], processedLogs[0].BlockHash)
+
+		l.Require().NoError(store.PruneProcessedBefore(l.GetTestContext(), chainID, "a", 2))
+		l.Require().NoError(store.DeleteProcessedFrom(l.GetTestContext(), chainID, "a", 2))
+		processed, err = store.ProcessedBlocks(l.GetTestContext(), chainID, "a")
+		l.Require().NoError(err)
+		l.Empty(processed)
+
+		for _, log := range logs {
+			l.Require().NoError(store.PutDeliveredLog(l.GetTestContext(), chainID, "a", log))
+		}
+		// storing the same log again is a no-op.
+		l.Require().NoError(store.PutDeliveredLog(l.GetTestContext(), chainID, "a", logs[1]))
+
+		deliveredLogs, err := store.DeliveredLogsFrom(l.GetTestContext(), chainID, "a", 2)
+		l.Require().NoError(err)
+		l.Equal([]types.Log{logs[1]}, deliveredLogs)
+
+		l.Require().NoError(store.PruneDeliveredBefore(l.GetTestContext(), chainID, "a", 3))
+		deliveredLogs, err = store.DeliveredLogsFrom(l.GetTestContext(), chainID, "a", 0)
+		l.Require().NoError(err)
+		l.Empty(deliveredLogs)
+	}
+}
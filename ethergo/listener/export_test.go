@@ -12,6 +12,7 @@
 type TestChainListener interface {
 	ContractListener
 	GetMetadata(parentCtx context.Context) (startBlock, chainID uint64, err error)
+	Poll(ctx context.Context, handler HandleLog) error
 }
 
 // GetMetadata wraps chain listener for testing.
@@ -19,6 +20,17 @@ func (c chainListener) GetMetadata(ctx context.Context) (startBlock, chainID uin
 	return c.getMetadata(ctx)
 }
 
+// Poll polls once for testing, getting the metadata first if the listener hasn't started.
+func (c *chainListener) Poll(ctx context.Context, handler HandleLog) (err error) {
+	if c.chainID == 0 {
+		c.startBlock, c.chainID, err = c.getMetadata(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return c.doPoll(ctx, handler)
+}
+
 type TestChainListenerArgs struct {
 	Address      common.Address
 	InitialBlock uint64
@@ -30,9 +42,14 @@ type TestChainListenerArgs struct {
 func NewTestChainListener(args TestChainListenerArgs) TestChainListener {
 	return &chainListener{
 		client:       args.Client,
-		address:      args.Address,
+		addresses:    []common.Address{args.Address},
 		initialBlock: args.InitialBlock,
 		store:        args.Store,
 		handler:      args.Handler,
 	}
 }
+
+// IsRangeTooLarge exports isRangeTooLarge for testing.
+func IsRangeTooLarge(err error) bool {
+	return isRangeTooLarge(err)
+}
@@ -5,6 +5,8 @@
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 	"time"
 
 	listenerDB "github.com/synapsecns/sanguine/ethergo/listener/db"
@@ -27,21 +29,59 @@ type ContractListener interface {
 	// LatestBlock gets the last recorded latest block from the rpc.
 	// this is NOT last indexed. It is provided as a helper for checking confirmation count
 	LatestBlock() uint64
-	// Address gets the address of the contract this listener is listening to
+	// Address gets the address of the contract this listener is listening to.
+	// If the listener is listening to more than one contract, this is the first one.
 	Address() common.Address
+	// Addresses gets the addresses of every contract this listener is listening to.
+	Addresses() []common.Address
+	// Key gets the key the listener's latest block is stored under when its store implements db.CursorDB.
+	Key() string
 }
 
 // HandleLog is the handler for a log event
 // in the event this errors, the range will be reparsed.
 type HandleLog func(ctx context.Context, log types.Log) error
 
+// HandleRemovedLog is the handler for a log that was delivered to HandleLog but is no longer in the canonical chain
+// because of a reorg. log.Removed is set. Removed logs are handled newest first, before the logs of the new canonical
+// chain are delivered. In the event this errors, the reorg will be handled again.
+type HandleRemovedLog func(ctx context.Context, log types.Log) error
+
 type chainListener struct {
 	client       client.EVM
-	address      common.Address
+	addresses    []common.Address
 	initialBlock uint64
 	store        listenerDB.ChainListenerDB
 	handler      metrics.Handler
 	backoff      *backoff.Backoff
+	// topics filters the logs of the contracts, in the same format as ethereum.FilterQuery.
+	topics [][]common.Hash
+	// name is the key the listener's latest block is stored under. If it's not set, the addresses are used.
+	name string
+	// cursorStore is set if the store keeps a latest block for each listener.
+	cursorStore listenerDB.CursorDB
+	// minPollInterval is the time between polls once the listener has caught up. If it's zero, the backoff is used.
+	minPollInterval time.Duration
+	// getLogsRange is the number of blocks requested from the rpc at once. minGetLogsRange is the least it is
+	// shrunk to if the rpc reports the range is too large.
+	getLogsRange, minGetLogsRange uint64
+	// logsRange is the current get logs range, and logsRangeSuccesses the number of calls in a row it has succeeded.
+	logsRange          uint64
+	logsRangeSuccesses int
+	// confirmations is the number of blocks that must be on top of a block before its logs are delivered.
+	confirmations uint64
+	// reorgStore and removedHandler are set if the listener is reorg aware.
+	reorgStore     listenerDB.ReorgDB
+	removedHandler HandleRemovedLog
+	// subscriber is set if the listener subscribes to new heads and logs.
+	subscriber SubscriptionClient
+	// delivered are the logs delivered from the logs subscription that haven't been processed by a poll yet,
+	// mapped to their block number.
+	delivered map[deliveredLog]uint64
+	// deliveredStore is set if the store keeps the logs delivered from the logs subscription across restarts.
+	deliveredStore listenerDB.DeliveredLogDB
+	// caughtUp is true if the last poll succeeded and processed every confirmed block.
+	caughtUp bool
 	// IMPORTANT! These fields cannot be used until they has been set. They are NOT
 	// set in the constructor
 	startBlock, chainID, latestBlock uint64
@@ -56,22 +96,54 @@ type chainListener struct {
 )
 
 // NewChainListener creates a new chain listener.
-func NewChainListener(omnirpcClient client.EVM, store listenerDB.ChainListenerDB, address common.Address, initialBlock uint64, handler metrics.Handler) (ContractListener, error) {
-	return &chainListener{
-		handler:      handler,
-		address:      address,
-		initialBlock: initialBlock,
-		store:        store,
-		client:       omnirpcClient,
-		backoff:      newBackoffConfig(),
-	}, nil
+func NewChainListener(omnirpcClient client.EVM, store listenerDB.ChainListenerDB, address common.Address, initialBlock uint64, handler metrics.Handler, opts ...Option) (ContractListener, error) {
+	c := &chainListener{
+		handler:         handler,
+		addresses:       []common.Address{address},
+		initialBlock:    initialBlock,
+		store:           store,
+		client:          omnirpcClient,
+		backoff:         newBackoffConfig(),
+		getLogsRange:    defaultGetLogsRange,
+		minGetLogsRange: 1,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.minGetLogsRange > c.getLogsRange {
+		c.minGetLogsRange = c.getLogsRange
+	}
+	c.logsRange = c.getLogsRange
+
+	cursorStore, ok := store.(listenerDB.CursorDB)
+	if ok {
+		c.cursorStore = cursorStore
+	}
+
+	deliveredStore, ok := store.(listenerDB.DeliveredLogDB)
+	if ok {
+		c.deliveredStore = deliveredStore
+	}
+
+	if c.removedHandler != nil {
+		reorgStore, ok := store.(listenerDB.ReorgDB)
+		if !ok {
+			return nil, fmt.Errorf("store %T does not support reorg tracking", store)
+		}
+		c.reorgStore = reorgStore
+	}
+	return c, nil
 }
 
 // defaultPollInterval.
 const (
-	// TODO: replace w/ config param if needed.
 	defaultPollInterval = 4
-	maxGetLogsRange     = 2000
+	// defaultGetLogsRange is the default number of blocks requested from the rpc at once.
+	defaultGetLogsRange = 2000
+	// growLogsRangeAfter is the number of calls in a row a shrunk get logs range has to succeed before it's grown.
+	growLogsRangeAfter = 10
+	// reorgWindow is the number of blocks below the last processed block that are checked for reorgs.
+	reorgWindow = 256
 )
 
 func (c *chainListener) Listen(ctx context.Context, handler HandleLog) (err error) {
@@ -82,7 +154,23 @@ func (c *chainListener) Listen(ctx context.Context, handler HandleLog) (err erro
 
 	c.pollInterval = time.Duration(0)
 
+	err = c.loadDelivered(ctx)
+	if err != nil {
+		return err
+	}
+
+	var resubscribeAt time.Time
 	for {
+		if c.subscriber != nil && !time.Now().Before(resubscribeAt) {
+			err = c.listenSubscribed(ctx, handler)
+			if ctx.Err() != nil {
+				return fmt.Errorf("context canceled: %w", ctx.Err())
+			}
+			// poll until the next attempt to subscribe, starting from the stored latest block.
+			logger.Warnf("subscription on chain %d failed, falling back to polling: %v", c.chainID, err)
+			resubscribeAt = time.Now().Add(resubscribeInterval)
+		}
+
 		select {
 		case <-ctx.Done():
 			return fmt.Errorf("context canceled: %w", ctx.Err())
@@ -96,7 +184,15 @@ func (c *chainListener) Listen(ctx context.Context, handler HandleLog) (err erro
 }
 
 func (c *chainListener) Address() common.Address {
-	return c.address
+	return c.addresses[0]
+}
+
+func (c *chainListener) Addresses() []common.Address {
+	return c.addresses
+}
+
+func (c *chainListener) Key() string {
+	return c.listenerKey()
 }
 
 func (c *chainListener) LatestBlock() uint64 {
@@ -109,11 +205,13 @@ func (c *chainListener) doPoll(parentCtx context.Context, handler HandleLog) (er
 
 	// Note: in the case of an error, you don't have to handle the poll interval by calling b.duration.
 	var endBlock uint64
+	var caughtUp bool
 	defer func() {
 		span.SetAttributes(
 			attribute.Int64("start_block", int64(c.startBlock)),
 			attribute.Int64("end_block", int64(endBlock)),
 			attribute.Int64("latest_block", int64(c.latestBlock)),
+			attribute.Int64("get_logs_range", int64(c.logsRange)),
 		)
 		metrics.EndSpanWithErr(span, err)
 		if err != nil {
@@ -122,6 +220,10 @@ func (c *chainListener) doPoll(parentCtx context.Context, handler HandleLog) (er
 			c.backoff.Reset()
 		}
 		c.pollInterval = c.backoff.Duration()
+		c.caughtUp = err == nil && caughtUp
+		if c.caughtUp && c.minPollInterval > 0 {
+			c.pollInterval = c.minPollInterval
+		}
 	}()
 
 	c.latestBlock, err = c.client.BlockNumber(ctx)
@@ -129,44 +231,270 @@ func (c *chainListener) doPoll(parentCtx context.Context, handler HandleLog) (er
 		return fmt.Errorf("could not get block number: %w", err)
 	}
 
-	// Check if latest block is the same as start block (for chains with slow block times)
-	if c.latestBlock == c.startBlock {
+	if c.reorgStore != nil {
+		err = c.handleReorg(ctx)
+		if err != nil {
+			return fmt.Errorf("could not handle reorg: %w", err)
+		}
+	}
+
+	if c.latestBlock < c.confirmations {
+		return nil
+	}
+	confirmedBlock := c.latestBlock - c.confirmations
+
+	// Check if confirmed block is the same as start block (for chains with slow block times)
+	if (confirmedBlock == c.startBlock && !c.exclusiveCursor()) || confirmedBlock < c.startBlock {
+		caughtUp = true
 		return nil
 	}
 
 	// Handle if the listener is more than one get logs range behind the head
-	// Note: this does not cover the edge case of a reorg that includes a new tx
-	endBlock = c.latestBlock
-	lastUnconfirmedBlock := c.latestBlock
-	if c.startBlock+maxGetLogsRange < c.latestBlock {
-		endBlock = c.startBlock + maxGetLogsRange
+	// Note: unless the listener is reorg aware, this does not cover the edge case of a reorg that includes a new tx
+	endBlock = confirmedBlock
+	lastUnconfirmedBlock := confirmedBlock
+	caughtUp = true
+	if c.startBlock+c.logsRange < confirmedBlock {
+		endBlock = c.startBlock + c.logsRange
 		// This will be used as the bottom of the range in the next iteration
 		lastUnconfirmedBlock = endBlock
-		c.pollInterval = 0
+		caughtUp = false
+	}
+
+	// the end block is checked for reorgs on the next poll, so its hash is fetched before its logs.
+	var endHeader *types.Header
+	if c.reorgStore != nil {
+		endHeader, err = c.client.HeaderByNumber(ctx, new(big.Int).SetUint64(endBlock))
+		if err != nil {
+			return fmt.Errorf("could not get end block header: %w", err)
+		}
 	}
 
 	filterQuery := c.buildFilterQuery(c.startBlock, endBlock)
 	logs, err := c.client.FilterLogs(ctx, filterQuery)
 	if err != nil {
+		c.logsRangeSuccesses = 0
+		if isRangeTooLarge(err) && c.logsRange > c.minGetLogsRange {
+			c.logsRange = max(c.logsRange/2, c.minGetLogsRange)
+			return fmt.Errorf("get logs range too large, shrinking to %d blocks: %w", c.logsRange, err)
+		}
 		return fmt.Errorf("could not filter logs: %w", err)
 	}
+	// the range is grown back once it has succeeded a few times, in case the rpc only failed on a busy range.
+	c.logsRangeSuccesses++
+	if c.logsRangeSuccesses >= growLogsRangeAfter && c.logsRange < c.getLogsRange {
+		c.logsRange = min(c.logsRange*2, c.getLogsRange)
+		c.logsRangeSuccesses = 0
+	}
+
+	if endHeader != nil {
+		for _, newLog := range logs {
+			if newLog.BlockNumber == endBlock && newLog.BlockHash != endHeader.Hash() {
+				return fmt.Errorf("block %d was reorged while it was being processed", endBlock)
+			}
+		}
+	}
 
 	for _, newLog := range logs {
+		// logs from the subscription have already been delivered.
+		if _, ok := c.delivered[newDeliveredLog(newLog)]; ok {
+			continue
+		}
+
 		err = handler(ctx, newLog)
 		if err != nil {
 			return fmt.Errorf("handle log failed, will reparse: %w", err)
 		}
 	}
 
-	err = c.store.PutLatestBlock(ctx, c.chainID, endBlock)
+	if c.reorgStore != nil {
+		err = c.putProcessedBlocks(ctx, endHeader, logs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.exclusiveCursor() {
+		// the end block has been processed, so the next range starts after it.
+		lastUnconfirmedBlock = endBlock + 1
+	}
+
+	err = c.putLatestBlock(ctx, endBlock)
 	if err != nil {
 		return fmt.Errorf("could not put latest block: %w", err)
 	}
 
 	c.startBlock = lastUnconfirmedBlock
+	c.pruneDelivered(ctx)
+	return nil
+}
+
+// exclusiveCursor is true if the stored latest block is the last block the listener processed, rather than the block
+// it starts the next range from. Reorg aware and subscribed listeners can't deliver a log twice, so they need it.
+func (c *chainListener) exclusiveCursor() bool {
+	return c.reorgStore != nil || c.subscriber != nil
+}
+
+// putProcessedBlocks stores the hash of the end block and the delivered logs, and prunes the blocks that are out of
+// the reorg window.
+func (c *chainListener) putProcessedBlocks(ctx context.Context, endHeader *types.Header, logs []types.Log) error {
+	endBlock := endHeader.Number.Uint64()
+	hashes := map[uint64]common.Hash{endBlock: endHeader.Hash()}
+	for _, newLog := range logs {
+		hashes[newLog.BlockNumber] = newLog.BlockHash
+	}
+
+	err := c.reorgStore.PutProcessedBlocks(ctx, c.chainID, c.listenerKey(), hashes, logs)
+	if err != nil {
+		return fmt.Errorf("could not put processed blocks: %w", err)
+	}
+
+	if endBlock > reorgWindow {
+		err = c.reorgStore.PruneProcessedBefore(ctx, c.chainID, c.listenerKey(), endBlock-reorgWindow)
+		if err != nil {
+			return fmt.Errorf("could not prune processed blocks: %w", err)
+		}
+	}
 	return nil
 }
 
+// handleReorg checks the processed blocks against the canonical chain. If any were reorged, the logs delivered from
+// them are passed to the removed log handler, and the listener is rewound to the last processed block that's still
+// canonical.
+func (c *chainListener) handleReorg(parentCtx context.Context) (err error) {
+	ctx, span := c.handler.Tracer().Start(parentCtx, "handleReorg", trace.WithAttributes(attribute.Int(metrics.ChainID, int(c.chainID))))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	hashes, err := c.reorgStore.ProcessedBlocks(ctx, c.chainID, c.listenerKey())
+	if err != nil {
+		return fmt.Errorf("could not get processed blocks: %w", err)
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	blockNumbers := make([]uint64, 0, len(hashes))
+	for blockNumber := range hashes {
+		blockNumbers = append(blockNumbers, blockNumber)
+	}
+	sort.Slice(blockNumbers, func(i, j int) bool {
+		return blockNumbers[i] > blockNumbers[j]
+	})
+
+	// blocks are compared newest first. Blocks a poll processed were stored in chain order, so once one of them is
+	// canonical, so is every block before it. Blocks from the start block on were only delivered from the logs
+	// subscription, so each of them is checked.
+	rewindTo := blockNumbers[len(blockNumbers)-1]
+	reorged := make(map[uint64]bool)
+	for i, blockNumber := range blockNumbers {
+		isCanonical, err := c.isCanonical(ctx, blockNumber, hashes[blockNumber])
+		if err != nil {
+			return err
+		}
+		if isCanonical {
+			if blockNumber < c.startBlock {
+				rewindTo = blockNumber + 1
+				break
+			}
+			continue
+		}
+		reorged[blockNumber] = true
+		if i == len(blockNumbers)-1 {
+			logger.Errorf("reorg on chain %d is deeper than the %d processed blocks stored, rewinding to block %d", c.chainID, len(blockNumbers), rewindTo)
+		}
+	}
+	if len(reorged) == 0 {
+		return nil
+	}
+
+	processedLogs, err := c.reorgStore.ProcessedLogsFrom(ctx, c.chainID, c.listenerKey(), rewindTo)
+	if err != nil {
+		return fmt.Errorf("could not get removed logs: %w", err)
+	}
+	// logs delivered from the subscription in blocks that are still canonical aren't removed. They're still marked as
+	// delivered, so the poll stores them again without delivering them.
+	var removedLogs []types.Log
+	for _, processedLog := range processedLogs {
+		if reorged[processedLog.BlockNumber] {
+			removedLogs = append(removedLogs, processedLog)
+		}
+	}
+
+	span.AddEvent("reorg", trace.WithAttributes(
+		attribute.Int64("rewind_to", int64(rewindTo)),
+		attribute.Int64("processed_block", int64(blockNumbers[0])),
+		attribute.Int("removed_logs", len(removedLogs)),
+	))
+
+	for i := len(removedLogs) - 1; i >= 0; i-- {
+		removedLogs[i].Removed = true
+		err = c.removedHandler(ctx, removedLogs[i])
+		if err != nil {
+			return fmt.Errorf("handle removed log failed, will retry: %w", err)
+		}
+		delete(c.delivered, newDeliveredLog(removedLogs[i]))
+	}
+
+	err = c.reorgStore.DeleteProcessedFrom(ctx, c.chainID, c.listenerKey(), rewindTo)
+	if err != nil {
+		return fmt.Errorf("could not delete reorged blocks: %w", err)
+	}
+
+	// a reorg of blocks only delivered from the subscription doesn't need a rewind, the poll hasn't got to them yet.
+	if rewindTo >= c.startBlock {
+		return nil
+	}
+
+	if rewindTo > 0 {
+		err = c.putLatestBlock(ctx, rewindTo-1)
+		if err != nil {
+			return fmt.Errorf("could not put latest block: %w", err)
+		}
+	}
+
+	c.startBlock = rewindTo
+	return nil
+}
+
+// isCanonical checks if the block with the given number and hash is in the canonical chain.
+func (c *chainListener) isCanonical(ctx context.Context, blockNumber uint64, blockHash common.Hash) (bool, error) {
+	header, err := c.client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	// the new canonical chain may be shorter.
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not get header for block %d: %w", blockNumber, err)
+	}
+	return header.Hash() == blockHash, nil
+}
+
+// listenerKey is the key the listener's latest block and processed blocks are stored under.
+func (c chainListener) listenerKey() string {
+	if c.name != "" {
+		return c.name
+	}
+
+	addresses := make([]string, len(c.addresses))
+	for i, address := range c.addresses {
+		addresses[i] = address.String()
+	}
+	sort.Strings(addresses)
+	return strings.Join(addresses, ",")
+}
+
+// putLatestBlock stores the latest block of the listener.
+func (c *chainListener) putLatestBlock(ctx context.Context, height uint64) error {
+	if c.cursorStore != nil {
+		//nolint: wrapcheck
+		return c.cursorStore.PutListenerBlock(ctx, c.chainID, c.listenerKey(), height)
+	}
+	//nolint: wrapcheck
+	return c.store.PutLatestBlock(ctx, c.chainID, height)
+}
+
 func (c chainListener) getMetadata(parentCtx context.Context) (startBlock, chainID uint64, err error) {
 	var lastIndexed uint64
 	ctx, span := c.handler.Tracer().Start(parentCtx, "getMetadata")
@@ -198,6 +526,9 @@ func (c chainListener) getMetadata(parentCtx context.Context) (startBlock, chain
 
 	if lastIndexed > c.startBlock {
 		startBlock = lastIndexed
+		if c.exclusiveCursor() {
+			startBlock++
+		}
 	} else {
 		startBlock = c.initialBlock
 	}
@@ -208,6 +539,17 @@ func (c chainListener) getMetadata(parentCtx context.Context) (startBlock, chain
 // TODO: consider some kind of backoff here in case rpcs are down at boot.
 // this becomes more of an issue as we add more chains.
 func (c chainListener) getLastIndexed(ctx context.Context, chainID uint64) (lastIndexed uint64, err error) {
+	if c.cursorStore != nil {
+		lastIndexed, err = c.cursorStore.ListenerBlock(ctx, chainID, c.listenerKey())
+		if err == nil {
+			return lastIndexed, nil
+		}
+		if !errors.Is(err, ErrNoLatestBlockForChainID) {
+			return 0, fmt.Errorf("could not get the latest block for listener: %w", err)
+		}
+		// listeners that ran before the latest block was stored per listener carry on from the chain's latest block.
+	}
+
 	lastIndexed, err = c.store.LatestBlockForChain(ctx, chainID)
 	// Workaround: TODO remove
 	if errors.Is(err, ErrNoLatestBlockForChainID) || err != nil && err.Error() == ErrNoLatestBlockForChainID.Error() {
@@ -233,6 +575,7 @@ func (c chainListener) buildFilterQuery(fromBlock, toBlock uint64) ethereum.Filt
 	return ethereum.FilterQuery{
 		FromBlock: new(big.Int).SetUint64(fromBlock),
 		ToBlock:   new(big.Int).SetUint64(toBlock),
-		Addresses: []common.Address{c.address},
+		Addresses: c.addresses,
+		Topics:    c.topics,
 	}
 }
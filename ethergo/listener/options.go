@@ -0,0 +1,165 @@
+package listener
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+)
+
+// Option is an option for the chain listener.
+type Option func(*chainListener)
+
+// WithConfirmations only delivers the logs in a block once confirmations blocks have been mined on top of it.
+func WithConfirmations(confirmations uint64) Option {
+	return func(c *chainListener) {
+		c.confirmations = confirmations
+	}
+}
+
+// WithRemovedLogHandler makes the listener reorg aware. The hash of each processed block is stored, and checked
+// against the canonical chain on each poll. If a processed block was reorged, the logs delivered from it and
+// every block after it are passed to handler, and the listener rewinds to the last block that's still canonical.
+// The store passed to NewChainListener must implement listenerDB.ReorgDB.
+func WithRemovedLogHandler(handler HandleRemovedLog) Option {
+	return func(c *chainListener) {
+		c.removedHandler = handler
+	}
+}
+
+// WithAddresses listens to the logs of more contracts on the same chain, alongside the address passed to
+// NewChainListener. Logs from every contract are delivered to the same handler, in the order they were emitted.
+func WithAddresses(addresses ...common.Address) Option {
+	return func(c *chainListener) {
+		for _, address := range addresses {
+			if !containsAddress(c.addresses, address) {
+				c.addresses = append(c.addresses, address)
+			}
+		}
+	}
+}
+
+// WithTopics only delivers logs matching the topics, in the same format as ethereum.FilterQuery.Topics:
+// each position is a list of topics that match, and an empty position matches any topic.
+func WithTopics(topics ...[]common.Hash) Option {
+	return func(c *chainListener) {
+		c.topics = topics
+	}
+}
+
+// WithName sets the key the listener's latest block is stored under. By default the addresses of the contracts are
+// used, so listeners with more than one address should set a name to keep their latest block if addresses are added.
+func WithName(name string) Option {
+	return func(c *chainListener) {
+		c.name = name
+	}
+}
+
+// WithPollInterval sets the time between polls once the listener has caught up to the chain.
+// While the listener is behind, or its polls are failing, it polls again as soon as the backoff allows.
+func WithPollInterval(pollInterval time.Duration) Option {
+	return func(c *chainListener) {
+		c.minPollInterval = pollInterval
+	}
+}
+
+// WithGetLogsRange sets the number of blocks requested from the rpc at once. If the rpc reports a range is too large,
+// the range is halved on each attempt down to minRange, and grown back once requests at the smaller range succeed.
+func WithGetLogsRange(maxRange, minRange uint64) Option {
+	return func(c *chainListener) {
+		if maxRange > 0 {
+			c.getLogsRange = maxRange
+		}
+		if minRange > 0 {
+			c.minGetLogsRange = minRange
+		}
+	}
+}
+
+// WithSubscriptions subscribes to new heads and logs with subscriber, rather than only polling. Each new head triggers
+// a poll, and logs are delivered as soon as they're received unless the listener waits for confirmations. If the
+// listener is reorg aware, logs delivered from the subscription are undone like polled ones if their block is
+// reorged. If a subscription fails, the listener falls back to polling and subscribes again later.
+// If the store implements db.DeliveredLogDB, logs delivered from the subscription aren't delivered again by a poll
+// after a restart.
+func WithSubscriptions(subscriber SubscriptionClient) Option {
+	return func(c *chainListener) {
+		c.subscriber = subscriber
+	}
+}
+
+// Config is the yaml config of a chain listener. Zero values keep the defaults.
+type Config struct {
+	// PollIntervalMs is the time between polls once the listener has caught up to the chain, in milliseconds.
+	PollIntervalMs int `yaml:"poll_interval_ms"`
+	// GetLogsRange is the number of blocks requested from the rpc at once.
+	GetLogsRange uint64 `yaml:"get_logs_range"`
+	// MinGetLogsRange is the least the get logs range is shrunk to when the rpc reports a range is too large.
+	MinGetLogsRange uint64 `yaml:"min_get_logs_range"`
+	// Confirmations is the number of blocks mined on top of a block before its logs are delivered.
+	Confirmations uint64 `yaml:"confirmations"`
+	// WebsocketURL is the ws:// or wss:// url of an rpc to subscribe to new heads and logs with. If it's not set, the
+	// listener only polls.
+	WebsocketURL string `yaml:"websocket_url"`
+}
+
+// Options gets the listener options for the config, dialing the websocket url if it's set.
+func (c Config) Options(ctx context.Context, handler metrics.Handler) ([]Option, error) {
+	opts := []Option{WithGetLogsRange(c.GetLogsRange, c.MinGetLogsRange), WithConfirmations(c.Confirmations)}
+	if c.PollIntervalMs > 0 {
+		opts = append(opts, WithPollInterval(time.Duration(c.PollIntervalMs)*time.Millisecond))
+	}
+	if c.WebsocketURL != "" {
+		subscriber, err := client.DialBackend(ctx, c.WebsocketURL, handler)
+		if err != nil {
+			return nil, fmt.Errorf("could not dial websocket: %w", err)
+		}
+		opts = append(opts, WithSubscriptions(subscriber))
+	}
+	return opts, nil
+}
+
+// rangeTooLargeErrors are the errors rpcs return when a get logs range has too many results or blocks. These are
+// matched against the lowercased error, so they're kept specific enough not to match rate limit or other errors that
+// shrinking the range won't fix.
+var rangeTooLargeErrors = []string{
+	// geth, erigon and infura.
+	"query returned more than",
+	"query exceeds max results",
+	// alchemy.
+	"log response size exceeded",
+	// nodereal.
+	"response size should not greater than",
+	// cronos, evmos and other cosmos evm chains.
+	"exceed maximum block range",
+	// ankr.
+	"block range is too wide",
+	// publicnode.
+	"range is too large",
+	// quicknode.
+	"are limited to a 10,000 blocks range",
+}
+
+// isRangeTooLarge checks if a get logs error is because the range was too large.
+func isRangeTooLarge(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, rangeErr := range rangeTooLargeErrors {
+		if strings.Contains(msg, rangeErr) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
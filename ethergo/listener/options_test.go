@@ -0,0 +1,103 @@
+package listener_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+	listenerDB "github.com/synapsecns/sanguine/ethergo/listener/db"
+)
+
+func (l *ListenerTestSuite) TestMultiAddressListener() {
+	addressA := common.BigToAddress(big.NewInt(1))
+	addressB := common.BigToAddress(big.NewInt(2))
+	addressC := common.BigToAddress(big.NewInt(3))
+	topicX := common.BigToHash(big.NewInt(1))
+	topicY := common.BigToHash(big.NewInt(2))
+	topicZ := common.BigToHash(big.NewInt(3))
+
+	// the rpc fails on ranges of more than 3 blocks.
+	chain := newFakeChain(addressA, 21)
+	chain.maxRange = 3
+
+	expected := []types.Log{
+		chain.putLog(types.Log{Address: addressA, Topics: []common.Hash{topicX}, BlockNumber: 2}),
+		chain.putLog(types.Log{Address: addressB, Topics: []common.Hash{topicY}, BlockNumber: 5}),
+		chain.putLog(types.Log{Address: addressB, Topics: []common.Hash{topicX}, BlockNumber: 15}),
+	}
+	// logs from other contracts or with other topics are filtered out.
+	chain.putLog(types.Log{Address: addressC, Topics: []common.Hash{topicX}, BlockNumber: 6})
+	chain.putLog(types.Log{Address: addressA, Topics: []common.Hash{topicZ}, BlockNumber: 18})
+
+	cl, err := listener.NewChainListener(chain, l.store, addressA, 1, l.metrics,
+		listener.WithAddresses(addressB, addressA),
+		listener.WithTopics([]common.Hash{topicX, topicY}),
+		listener.WithGetLogsRange(8, 2),
+		listener.WithName("multi"))
+	l.Require().NoError(err)
+	l.Require().Equal([]common.Address{addressA, addressB}, cl.Addresses())
+
+	// logs at the end of a range are delivered again at the start of the next one, so they're counted once.
+	delivered := make(map[common.Hash]map[uint]types.Log)
+	for i := 0; i < 20; i++ {
+		_ = cl.(listener.TestChainListener).Poll(l.GetTestContext(), func(ctx context.Context, log types.Log) error {
+			if delivered[log.BlockHash] == nil {
+				delivered[log.BlockHash] = make(map[uint]types.Log)
+			}
+			delivered[log.BlockHash][log.Index] = log
+			return nil
+		})
+	}
+
+	var deliveredLogs []types.Log
+	for _, log := range expected {
+		deliveredLogs = append(deliveredLogs, delivered[log.BlockHash][log.Index])
+	}
+	l.Require().Equal(expected, deliveredLogs)
+	l.Require().Len(delivered, len(expected))
+
+	// the latest block is stored under the listener's name, not the chain.
+	latestBlock, err := l.store.ListenerBlock(l.GetTestContext(), chainID, "multi")
+	l.Require().NoError(err)
+	l.Require().Equal(uint64(20), latestBlock)
+
+	_, err = l.store.LatestBlockForChain(l.GetTestContext(), chainID)
+	l.Require().ErrorIs(err, listenerDB.ErrNoLatestBlockForChainID)
+
+	// listeners without a latest block of their own start from the chain's.
+	l.Require().NoError(l.store.PutLatestBlock(l.GetTestContext(), chainID, 12))
+	other, err := listener.NewChainListener(chain, l.store, addressC, 1, l.metrics)
+	l.Require().NoError(err)
+	startBlock, _, err := other.(listener.TestChainListener).GetMetadata(l.GetTestContext())
+	l.Require().NoError(err)
+	l.Require().Equal(uint64(12), startBlock)
+}
+
+func (l *ListenerTestSuite) TestIsRangeTooLarge() {
+	rangeErrs := []string{
+		"query returned more than 10000 results",
+		"Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range and no limit on the response size",
+		"exceed maximum block range: 50000",
+		"block range is too wide",
+		"eth_getLogs range is too large, max is 1k blocks",
+		"eth_getLogs and eth_newFilter are limited to a 10,000 blocks range",
+	}
+	for _, rangeErr := range rangeErrs {
+		l.True(listener.IsRangeTooLarge(errors.New(rangeErr)), rangeErr)
+	}
+
+	// rate limits aren't fixed by shrinking the range.
+	otherErrs := []string{
+		"rate limit exceeded",
+		"daily request count limit exceeded",
+		"compute units per second capacity limit exceeded",
+		"header not found for block range start",
+		"rpc unavailable",
+	}
+	for _, otherErr := range otherErrs {
+		l.False(listener.IsRangeTooLarge(errors.New(otherErr)), otherErr)
+	}
+}
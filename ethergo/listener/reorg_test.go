@@ -0,0 +1,185 @@
+package listener_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+)
+
+// fakeChain is a chain that can be reorged. Calling any method other than the ones the listener uses panics.
+type fakeChain struct {
+	client.EVM
+	mux     sync.Mutex
+	address common.Address
+	headers []*types.Header
+	logs    map[common.Hash][]types.Log
+	// maxRange is the largest get logs range the chain serves, or zero if there is no limit.
+	maxRange uint64
+	// failLogs makes FilterLogs fail, so logs can only be received from subscriptions.
+	failLogs bool
+	// headSubs and logSubs are the open subscriptions, which are closed with an error by dropSubscriptions.
+	headSubs []chan<- *types.Header
+	logSubs  []logSub
+	dropped  chan struct{}
+}
+
+func newFakeChain(address common.Address, length int) *fakeChain {
+	f := &fakeChain{address: address, logs: make(map[common.Hash][]types.Log), dropped: make(chan struct{})}
+	f.reorg(0, length, 0)
+	return f
+}
+
+// reorg replaces every block from fromBlock on with a new fork of length blocks.
+func (f *fakeChain) reorg(fromBlock, length int, fork byte) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.headers = f.headers[:fromBlock]
+	for i := fromBlock; i < length; i++ {
+		f.headers = append(f.headers, &types.Header{
+			Number:     big.NewInt(int64(i)),
+			Difficulty: big.NewInt(0),
+			Extra:      []byte{fork},
+		})
+	}
+}
+
+// addLog adds a log to the block with the given number on the current fork.
+func (f *fakeChain) addLog(blockNumber uint64, data string) types.Log {
+	return f.putLog(types.Log{
+		Address:     f.address,
+		Topics:      []common.Hash{},
+		Data:        []byte(data),
+		BlockNumber: blockNumber,
+	})
+}
+
+// putLog adds the log to its block on the current fork.
+func (f *fakeChain) putLog(log types.Log) types.Log {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	log.BlockHash = f.headers[log.BlockNumber].Hash()
+	log.Index = uint(len(f.logs[log.BlockHash]))
+	f.logs[log.BlockHash] = append(f.logs[log.BlockHash], log)
+	return log
+}
+
+func (f *fakeChain) ChainID(_ context.Context) (*big.Int, error) {
+	return big.NewInt(chainID), nil
+}
+
+func (f *fakeChain) BlockNumber(_ context.Context) (uint64, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return uint64(len(f.headers) - 1), nil
+}
+
+func (f *fakeChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if number.Uint64() >= uint64(len(f.headers)) {
+		return nil, ethereum.NotFound
+	}
+	return f.headers[number.Uint64()], nil
+}
+
+func (f *fakeChain) FilterLogs(_ context.Context, query ethereum.FilterQuery) (logs []types.Log, _ error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if f.failLogs {
+		return nil, errors.New("rpc unavailable")
+	}
+	if f.maxRange > 0 && query.ToBlock.Uint64()-query.FromBlock.Uint64() > f.maxRange {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+
+	for i := query.FromBlock.Uint64(); i <= query.ToBlock.Uint64() && i < uint64(len(f.headers)); i++ {
+		for _, log := range f.logs[f.headers[i].Hash()] {
+			if matchesQuery(log, query) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// matchesQuery checks if the log matches the addresses and topics of the query.
+func matchesQuery(log types.Log, query ethereum.FilterQuery) bool {
+	if len(query.Addresses) > 0 && !slices.Contains(query.Addresses, log.Address) {
+		return false
+	}
+	for i, topics := range query.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) || !slices.Contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *ListenerTestSuite) TestReorg() {
+	address := common.BigToAddress(big.NewInt(1))
+	chain := newFakeChain(address, 11)
+	logA := chain.addLog(3, "a")
+	logB := chain.addLog(8, "b")
+	logC := chain.addLog(9, "c")
+
+	var removed []types.Log
+	cl, err := listener.NewChainListener(chain, l.store, address, 1, l.metrics,
+		listener.WithConfirmations(2),
+		listener.WithRemovedLogHandler(func(ctx context.Context, log types.Log) error {
+			removed = append(removed, log)
+			return nil
+		}))
+	l.Require().NoError(err)
+
+	var delivered []types.Log
+	poll := func() {
+		l.Require().NoError(cl.(listener.TestChainListener).Poll(l.GetTestContext(), func(ctx context.Context, log types.Log) error {
+			delivered = append(delivered, log)
+			return nil
+		}))
+	}
+
+	// block 9 only has one confirmation.
+	poll()
+	l.Require().Equal([]types.Log{logA, logB}, delivered)
+
+	// blocks 7 on are replaced by a longer fork, where logB is in a different block.
+	chain.reorg(7, 12, 1)
+	logB2 := chain.addLog(8, "b")
+	logC2 := chain.addLog(9, "c")
+	l.Require().NotEqual(logB.BlockHash, logB2.BlockHash)
+
+	poll()
+	removedB := logB
+	removedB.Removed = true
+	l.Require().Equal([]types.Log{removedB}, removed)
+	l.Require().Equal([]types.Log{logA, logB, logB2, logC2}, delivered)
+	l.Require().NotEqual(logC, logC2)
+
+	// nothing is delivered twice.
+	poll()
+	l.Require().Len(delivered, 4)
+
+	// a listener started from the cursor carries on after the last processed block.
+	restarted, err := listener.NewChainListener(chain, l.store, address, 1, l.metrics,
+		listener.WithRemovedLogHandler(func(ctx context.Context, log types.Log) error {
+			return nil
+		}))
+	l.Require().NoError(err)
+	startBlock, _, err := restarted.(listener.TestChainListener).GetMetadata(l.GetTestContext())
+	l.Require().NoError(err)
+	l.Require().Equal(uint64(10), startBlock)
+}
@@ -0,0 +1,202 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SubscriptionClient is a client that can subscribe to new heads and logs, usually over a WebSocket.
+// client.EVM implements it when it's dialed with a ws:// or wss:// url.
+type SubscriptionClient interface {
+	// SubscribeNewHead subscribes to the headers of new blocks.
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	// SubscribeFilterLogs subscribes to the logs matching the query.
+	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+}
+
+const (
+	// subscriptionBuffer is the number of heads and logs buffered from the subscriptions.
+	subscriptionBuffer = 64
+	// staleHeadTimeout is how long the listener waits for a new head before it considers the subscription dead.
+	staleHeadTimeout = 2 * time.Minute
+	// resubscribeInterval is how long the listener polls after a subscription fails, before it subscribes again.
+	resubscribeInterval = 30 * time.Second
+)
+
+// errStaleSubscription is returned when the new heads subscription hasn't received a head in staleHeadTimeout.
+var errStaleSubscription = errors.New("no new heads received")
+
+// deliveredLog identifies a log delivered from the logs subscription.
+type deliveredLog struct {
+	blockHash common.Hash
+	index     uint
+}
+
+func newDeliveredLog(log types.Log) deliveredLog {
+	return deliveredLog{blockHash: log.BlockHash, index: log.Index}
+}
+
+// listenSubscribed listens until the subscriptions fail or the context is cancelled. Each new head triggers a poll,
+// which fills the gap from the stored latest block, so no log is missed while the subscription is down. Unless the
+// listener waits for confirmations, logs are also delivered from the logs subscription as soon as they're received,
+// and skipped when the poll gets to them. Reorg aware listeners store the block of each subscribed log, so the next
+// poll undoes it if the block is reorged.
+func (c *chainListener) listenSubscribed(ctx context.Context, handler HandleLog) error {
+	heads := make(chan *types.Header, subscriptionBuffer)
+	headSub, err := c.subscriber.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return fmt.Errorf("could not subscribe to new heads: %w", err)
+	}
+	defer headSub.Unsubscribe()
+
+	var logs chan types.Log
+	var logErrs <-chan error
+	if c.confirmations == 0 {
+		logs = make(chan types.Log, subscriptionBuffer)
+		logSub, err := c.subscriber.SubscribeFilterLogs(ctx, c.subscriptionQuery(), logs)
+		if err != nil {
+			return fmt.Errorf("could not subscribe to logs: %w", err)
+		}
+		defer logSub.Unsubscribe()
+		logErrs = logSub.Err()
+	}
+
+	staleTimer := time.NewTimer(staleHeadTimeout)
+	defer staleTimer.Stop()
+
+	// the first poll fills the gap from the stored latest block to the head.
+	pollNow := time.After(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context canceled: %w", ctx.Err())
+		case err := <-headSub.Err():
+			return fmt.Errorf("new heads subscription failed: %w", err)
+		case err := <-logErrs:
+			return fmt.Errorf("logs subscription failed: %w", err)
+		case <-staleTimer.C:
+			return errStaleSubscription
+		case newLog := <-logs:
+			c.deliverSubscribedLog(ctx, handler, newLog)
+		case <-heads:
+			staleTimer.Reset(staleHeadTimeout)
+			pollNow = time.After(0)
+		case <-pollNow:
+			pollNow = nil
+			err = c.doPoll(ctx, handler)
+			if err != nil {
+				logger.Warn(err)
+			}
+			// keep polling until the listener has caught up, rather than waiting for the next head.
+			if !c.caughtUp {
+				pollNow = time.After(c.pollInterval)
+			}
+		}
+	}
+}
+
+// deliverSubscribedLog delivers a log from the logs subscription, unless a poll has already processed its block.
+// If the handler fails, the log is delivered again by the next poll.
+func (c *chainListener) deliverSubscribedLog(ctx context.Context, handler HandleLog, newLog types.Log) {
+	// reorg aware listeners undo removed logs from the reorg store on the next poll, which the new head triggers.
+	if newLog.Removed || newLog.BlockNumber < c.startBlock {
+		return
+	}
+	if _, ok := c.delivered[newDeliveredLog(newLog)]; ok {
+		return
+	}
+
+	if c.reorgStore != nil {
+		// a log from another fork of a block is left to the poll, which handles the reorg first.
+		if c.deliveredFromFork(newLog) {
+			return
+		}
+		// the block is stored before the log is delivered, so the log can always be undone.
+		hashes := map[uint64]common.Hash{newLog.BlockNumber: newLog.BlockHash}
+		err := c.reorgStore.PutProcessedBlocks(ctx, c.chainID, c.listenerKey(), hashes, []types.Log{newLog})
+		if err != nil {
+			logger.Warnf("could not store subscribed log, leaving it to the poll: %v", err)
+			return
+		}
+	}
+
+	err := handler(ctx, newLog)
+	if err != nil {
+		logger.Warnf("handle subscribed log failed, will reparse: %v", err)
+		return
+	}
+
+	c.addDelivered(newLog)
+	if c.deliveredStore != nil {
+		err = c.deliveredStore.PutDeliveredLog(ctx, c.chainID, c.listenerKey(), newLog)
+		if err != nil {
+			logger.Warnf("could not store subscribed log, it will be delivered again if the listener restarts: %v", err)
+		}
+	}
+}
+
+// deliveredFromFork checks if a log was delivered from a different block with the same number as the log's.
+func (c *chainListener) deliveredFromFork(newLog types.Log) bool {
+	for delivered, blockNumber := range c.delivered {
+		if blockNumber == newLog.BlockNumber && delivered.blockHash != newLog.BlockHash {
+			return true
+		}
+	}
+	return false
+}
+
+// loadDelivered loads the logs delivered from the logs subscription before a restart, so a poll doesn't deliver them
+// again.
+func (c *chainListener) loadDelivered(ctx context.Context) error {
+	if c.subscriber == nil || c.deliveredStore == nil {
+		return nil
+	}
+
+	logs, err := c.deliveredStore.DeliveredLogsFrom(ctx, c.chainID, c.listenerKey(), c.startBlock)
+	if err != nil {
+		return fmt.Errorf("could not get delivered logs: %w", err)
+	}
+	for _, log := range logs {
+		c.addDelivered(log)
+	}
+	return nil
+}
+
+func (c *chainListener) addDelivered(log types.Log) {
+	if c.delivered == nil {
+		c.delivered = make(map[deliveredLog]uint64)
+	}
+	c.delivered[newDeliveredLog(log)] = log.BlockNumber
+}
+
+// pruneDelivered forgets the logs delivered from the subscription in blocks a poll has processed.
+func (c *chainListener) pruneDelivered(ctx context.Context) {
+	pruned := false
+	for delivered, blockNumber := range c.delivered {
+		if blockNumber < c.startBlock {
+			delete(c.delivered, delivered)
+			pruned = true
+		}
+	}
+
+	if pruned && c.deliveredStore != nil {
+		err := c.deliveredStore.PruneDeliveredBefore(ctx, c.chainID, c.listenerKey(), c.startBlock)
+		if err != nil {
+			logger.Warnf("could not prune delivered logs: %v", err)
+		}
+	}
+}
+
+// subscriptionQuery is the logs subscription query, which matches the same logs as each poll.
+func (c *chainListener) subscriptionQuery() ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: c.addresses,
+		Topics:    c.topics,
+	}
+}
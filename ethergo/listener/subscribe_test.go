@@ -0,0 +1,308 @@
+package listener_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+)
+
+// logSub is a logs subscription to the fake chain.
+type logSub struct {
+	query ethereum.FilterQuery
+	ch    chan<- types.Log
+}
+
+func (f *fakeChain) SubscribeNewHead(_ context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.headSubs = append(f.headSubs, ch)
+	return f.newSubscription(), nil
+}
+
+func (f *fakeChain) SubscribeFilterLogs(_ context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.logSubs = append(f.logSubs, logSub{query: query, ch: ch})
+	return f.newSubscription(), nil
+}
+
+// newSubscription creates a subscription that fails when the subscriptions are dropped.
+func (f *fakeChain) newSubscription() ethereum.Subscription {
+	dropped := f.dropped
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		select {
+		case <-quit:
+			return nil
+		case <-dropped:
+			return errors.New("websocket closed")
+		}
+	})
+}
+
+// dropSubscriptions fails every open subscription, as if the websocket was closed.
+func (f *fakeChain) dropSubscriptions() {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	close(f.dropped)
+	f.dropped = make(chan struct{})
+	f.headSubs = nil
+	f.logSubs = nil
+}
+
+// mine adds a block with a log from the chain's address, and sends both to the open subscriptions.
+func (f *fakeChain) mine(data string) types.Log {
+	f.mux.Lock()
+	header := &types.Header{
+		Number:     big.NewInt(int64(len(f.headers))),
+		Difficulty: big.NewInt(0),
+	}
+	f.headers = append(f.headers, header)
+	f.mux.Unlock()
+
+	newLog := f.addLog(header.Number.Uint64(), data)
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	for _, sub := range f.logSubs {
+		if matchesQuery(newLog, sub.query) {
+			sub.ch <- newLog
+		}
+	}
+	for _, ch := range f.headSubs {
+		ch <- header
+	}
+	return newLog
+}
+
+func (f *fakeChain) setFailLogs(failLogs bool) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.failLogs = failLogs
+}
+
+func (l *ListenerTestSuite) TestSubscriptions() {
+	address := common.BigToAddress(big.NewInt(1))
+	chain := newFakeChain(address, 5)
+	logA := chain.addLog(2, "a")
+
+	cl, err := listener.NewChainListener(chain, l.store, address, 1, l.metrics,
+		listener.WithSubscriptions(chain),
+		listener.WithPollInterval(10*time.Millisecond))
+	l.Require().NoError(err)
+
+	var mux sync.Mutex
+	delivered := make(map[common.Hash][]types.Log)
+	deliveredLogs := func() (logs []types.Log) {
+		mux.Lock()
+		defer mux.Unlock()
+		for _, blockLogs := range delivered {
+			logs = append(logs, blockLogs...)
+		}
+		return logs
+	}
+	waitFor := func(log types.Log) {
+		l.Require().Eventually(func() bool {
+			mux.Lock()
+			defer mux.Unlock()
+			return len(delivered[log.BlockHash]) > 0
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancel(l.GetTestContext())
+	defer cancel()
+	go func() {
+		_ = cl.Listen(ctx, func(ctx context.Context, log types.Log) error {
+			mux.Lock()
+			defer mux.Unlock()
+			delivered[log.BlockHash] = append(delivered[log.BlockHash], log)
+			return nil
+		})
+	}()
+
+	// the first poll fills the gap up to the head.
+	waitFor(logA)
+
+	// while get logs fails, logs are delivered from the subscription.
+	chain.setFailLogs(true)
+	logB := chain.mine("b")
+	waitFor(logB)
+
+	// the poll gets to the block once get logs recovers, and doesn't deliver the log again.
+	chain.setFailLogs(false)
+	l.Require().Eventually(func() bool {
+		latestBlock, err := l.store.ListenerBlock(l.GetTestContext(), chainID, address.String())
+		return err == nil && latestBlock >= logB.BlockNumber
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// once the websocket is closed, the listener falls back to polling.
+	chain.dropSubscriptions()
+	logC := chain.mine("c")
+	waitFor(logC)
+
+	cancel()
+	l.Require().ElementsMatch([]types.Log{logA, logB, logC}, deliveredLogs())
+}
+
+func (l *ListenerTestSuite) TestSubscribedLogsAfterRestart() {
+	address := common.BigToAddress(big.NewInt(1))
+	chain := newFakeChain(address, 5)
+	logA := chain.addLog(2, "a")
+
+	var mux sync.Mutex
+	delivered := make(map[common.Hash][]types.Log)
+	handler := func(ctx context.Context, log types.Log) error {
+		mux.Lock()
+		defer mux.Unlock()
+		delivered[log.BlockHash] = append(delivered[log.BlockHash], log)
+		return nil
+	}
+	deliveredCount := func(log types.Log) int {
+		mux.Lock()
+		defer mux.Unlock()
+		return len(delivered[log.BlockHash])
+	}
+	latestBlock := func() uint64 {
+		latest, _ := l.store.ListenerBlock(l.GetTestContext(), chainID, address.String())
+		return latest
+	}
+	listen := func() (stop func()) {
+		cl, err := listener.NewChainListener(chain, l.store, address, 1, l.metrics,
+			listener.WithSubscriptions(chain),
+			listener.WithPollInterval(10*time.Millisecond))
+		l.Require().NoError(err)
+
+		ctx, cancel := context.WithCancel(l.GetTestContext())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = cl.Listen(ctx, handler)
+		}()
+		return func() {
+			cancel()
+			<-done
+		}
+	}
+
+	stop := listen()
+	l.Require().Eventually(func() bool {
+		return deliveredCount(logA) == 1 && latestBlock() >= logA.BlockNumber
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the log is delivered from the subscription, and the listener stops before a poll gets to its block.
+	chain.setFailLogs(true)
+	logB := chain.mine("b")
+	l.Require().Eventually(func() bool {
+		deliveredLogs, err := l.store.DeliveredLogsFrom(l.GetTestContext(), chainID, address.String(), logB.BlockNumber)
+		return err == nil && len(deliveredLogs) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	stop()
+	l.Require().Less(latestBlock(), logB.BlockNumber)
+
+	// once restarted, the poll gets to the block and doesn't deliver the log again.
+	chain.setFailLogs(false)
+	stop = listen()
+	defer stop()
+	l.Require().Eventually(func() bool {
+		return latestBlock() >= logB.BlockNumber
+	}, 10*time.Second, 10*time.Millisecond)
+	l.Equal(1, deliveredCount(logB))
+
+	// the delivered log is forgotten once the poll has processed its block.
+	logC := chain.mine("c")
+	l.Require().Eventually(func() bool {
+		return deliveredCount(logC) == 1 && latestBlock() >= logC.BlockNumber
+	}, 10*time.Second, 10*time.Millisecond)
+	deliveredLogs, err := l.store.DeliveredLogsFrom(l.GetTestContext(), chainID, address.String(), 0)
+	l.Require().NoError(err)
+	l.Empty(deliveredLogs)
+}
+
+// TestSubscriptionsReorgAware uses the same options as the relayer, whose listener handles removed logs.
+func (l *ListenerTestSuite) TestSubscriptionsReorgAware() {
+	address := common.BigToAddress(big.NewInt(1))
+	chain := newFakeChain(address, 5)
+	logA := chain.addLog(2, "a")
+
+	var mux sync.Mutex
+	var delivered, removed []types.Log
+	cl, err := listener.NewChainListener(chain, l.store, address, 1, l.metrics,
+		listener.WithRemovedLogHandler(func(ctx context.Context, log types.Log) error {
+			mux.Lock()
+			defer mux.Unlock()
+			removed = append(removed, log)
+			return nil
+		}),
+		listener.WithSubscriptions(chain),
+		listener.WithPollInterval(10*time.Millisecond))
+	l.Require().NoError(err)
+
+	isDelivered := func(log types.Log) bool {
+		mux.Lock()
+		defer mux.Unlock()
+		for _, deliveredLog := range delivered {
+			if deliveredLog.BlockHash == log.BlockHash && deliveredLog.Index == log.Index {
+				return true
+			}
+		}
+		return false
+	}
+	waitFor := func(log types.Log) {
+		l.Require().Eventually(func() bool {
+			return isDelivered(log)
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancel(l.GetTestContext())
+	defer cancel()
+	go func() {
+		_ = cl.Listen(ctx, func(ctx context.Context, log types.Log) error {
+			mux.Lock()
+			defer mux.Unlock()
+			delivered = append(delivered, log)
+			return nil
+		})
+	}()
+	waitFor(logA)
+
+	// while get logs fails, logs are only delivered from the subscription.
+	chain.setFailLogs(true)
+	logB := chain.mine("b")
+	logC := chain.mine("c")
+	waitFor(logB)
+	waitFor(logC)
+
+	// the block of logC is reorged before the poll gets to it, the next head undoes it.
+	chain.reorg(int(logC.BlockNumber), int(logC.BlockNumber)+1, 1)
+	logC2 := chain.addLog(logC.BlockNumber, "c")
+	logD := chain.mine("d")
+	waitFor(logD)
+	removedC := logC
+	removedC.Removed = true
+	l.Require().Eventually(func() bool {
+		mux.Lock()
+		defer mux.Unlock()
+		return len(removed) == 1 && removed[0].BlockHash == removedC.BlockHash
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// once get logs recovers, the poll delivers the log from the new fork, and nothing else twice.
+	chain.setFailLogs(false)
+	waitFor(logC2)
+	l.Require().Eventually(func() bool {
+		latestBlock, err := l.store.ListenerBlock(l.GetTestContext(), chainID, address.String())
+		return err == nil && latestBlock >= logD.BlockNumber
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	mux.Lock()
+	defer mux.Unlock()
+	l.Require().ElementsMatch([]types.Log{logA, logB, logC, logD, logC2}, delivered)
+	l.Require().Equal([]types.Log{removedC}, removed)
+}
@@ -10,6 +10,7 @@
 	"github.com/synapsecns/sanguine/ethergo/example/counter"
 	"github.com/synapsecns/sanguine/ethergo/listener"
 	db2 "github.com/synapsecns/sanguine/ethergo/listener/db"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 	"math/big"
@@ -33,7 +34,9 @@ type ListenerTestSuite struct {
 	*testsuite.TestSuite
 	manager *example.DeployManager
 	backend backends.SimulatedTestBackend
-	store   db2.ChainListenerDB
+	store   db2.Service
+	// stores are the stores to run db tests on: the sqlite store, and postgres if enabled.
+	stores  []db2.Service
 	metrics metrics.Handler
 	counter *counter.CounterRef
 }
@@ -60,6 +63,13 @@ func (l *ListenerTestSuite) SetupTest() {
 	l.store, err = NewSqliteStore(l.GetTestContext(), filet.TmpDir(l.T(), ""), l.metrics)
 	l.Require().NoError(err)
 
+	l.stores = []db2.Service{l.store}
+	if os.Getenv(common_base.EnablePostgresTestVar) == "true" {
+		postgresStore, err := NewPostgresStore(l.GetTestContext(), common_base.GetTestPostgresConnString(), l.metrics)
+		l.Require().NoError(err)
+		l.stores = append(l.stores, postgresStore)
+	}
+
 	_, l.counter = l.manager.GetCounter(l.GetTestContext(), l.backend)
 }
 
@@ -107,6 +117,39 @@ func (l *ListenerTestSuite) TestListen() {
 
 }
 
+// NewPostgresStore creates a new postgres data store.
+func NewPostgresStore(parentCtx context.Context, dbURL string, handler metrics.Handler) (_ *db2.Store, err error) {
+	logger := log.Logger("postgres-store")
+
+	ctx, span := handler.Tracer().Start(parentCtx, "start-postgres")
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	namingStrategy := schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("test%d_%d_", gofakeit.Int64(), time.Now().Unix()),
+	}
+
+	gdb, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{
+		Logger:                 common_base.GetGormLogger(logger),
+		FullSaveAssociations:   true,
+		NamingStrategy:         namingStrategy,
+		NowFunc:                time.Now,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create postgres connection: %w", err)
+	}
+
+	handler.AddGormCallbacks(gdb)
+
+	err = gdb.WithContext(ctx).AutoMigrate(db2.GetAllModels()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate on postgres: %w", err)
+	}
+	return db2.NewChainListenerStore(gdb, handler), nil
+}
+
 // NewSqliteStore creates a new sqlite data store.
 func NewSqliteStore(parentCtx context.Context, dbPath string, handler metrics.Handler) (_ *db2.Store, err error) {
 	logger := log.Logger("sqlite-store")
@@ -6,12 +6,14 @@
 	"errors"
 	"fmt"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jftuga/ellipsis"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/awssigner"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/gcpsigner"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner"
 	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
 	"google.golang.org/api/option"
 	"gopkg.in/yaml.v2"
@@ -20,34 +22,119 @@
 	"strings"
 )
 
-// SignerConfig contains a signer config. Currently this config
-// only supports local based signers due to a lack of isomorphic types
-// when we parse yaml.
+// SignerConfig contains a signer config. File signers read a private key from File. AWS, GCP and Remote signers
+// are configured by their sub-config, or by a yaml file containing it at File if the sub-config isn't set.
 type SignerConfig struct {
 	// Type is the driver used for the signer
-	Type string
+	Type string `yaml:"type"`
 	// File is the file used for the key.
-	File string
+	File string `yaml:"file"`
+	// AWS is the config for an AWS signer.
+	AWS *AWSConfig `yaml:"aws,omitempty"`
+	// GCP is the config for a GCP signer.
+	GCP *GCPConfig `yaml:"gcp,omitempty"`
+	// Remote is the config for a remote signer.
+	Remote *RemoteConfig `yaml:"remote,omitempty"`
 }
 
-// IsValid determines if the config is valid.
-func (s SignerConfig) IsValid(_ context.Context) (ok bool, err error) {
-	if !strings.EqualFold(s.Type, FileType.String()) {
-		return false, fmt.Errorf("%w: %s. must be one of: %s", ErrUnsupportedSignerType, s.Type, allSignerTypesList())
+// IsValid determines if the config is valid. Signers other than file signers are created to make sure their key
+// can be reached, and AWS and GCP signers must set the address their key is expected to derive.
+func (s SignerConfig) IsValid(ctx context.Context) (ok bool, err error) {
+	signerType, err := s.signerType()
+	if err != nil {
+		return false, err
+	}
+
+	switch signerType {
+	case FileType:
+		_, err = wallet.FromKeyFile(s.File)
+		if err != nil {
+			return false, fmt.Errorf("file %s invalid: %w", s.File, err)
+		}
+		return true, nil
+	case AWSType:
+		awsConfig, err := s.getAWSConfig()
+		if err != nil {
+			return false, err
+		}
+		if awsConfig.Address == "" {
+			return false, fmt.Errorf("%w: %s", ErrMissingAddress, signerType)
+		}
+	case GCPType:
+		gcpConfig, err := s.getGCPConfig()
+		if err != nil {
+			return false, err
+		}
+		if gcpConfig.Address == "" {
+			return false, fmt.Errorf("%w: %s", ErrMissingAddress, signerType)
+		}
 	}
 
-	// TODO: we'll need to switch validity here based on type once we have more then one supported configuration type
-	// alternatively, we could try to use an awsconfig type file, but this makes the virtual box setup more tedious. A third option is a json blob
-	_, err = wallet.FromKeyFile(s.File)
+	_, err = SignerFromConfig(ctx, s)
 	if err != nil {
-		return false, fmt.Errorf("file %s invalid: %w", s.File, err)
+		return false, fmt.Errorf("%s signer invalid: %w", signerType, err)
 	}
 
 	return true, nil
 }
 
-// ErrUnsupportedSignerType indicates the signer type being used is unsupported.
-var ErrUnsupportedSignerType = errors.New("unsupported signer type")
+// signerType gets the signer type, ignoring case.
+func (s SignerConfig) signerType() (SignerType, error) {
+	for _, signerType := range []SignerType{FileType, AWSType, GCPType, RemoteType} {
+		if strings.EqualFold(s.Type, signerType.String()) {
+			return signerType, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s. must be one of: %s", ErrUnsupportedSignerType, s.Type, allSignerTypesList())
+}
+
+// getAWSConfig gets the AWS config, decoding it from the file if it isn't set.
+func (s SignerConfig) getAWSConfig() (AWSConfig, error) {
+	if s.AWS != nil {
+		return *s.AWS, nil
+	}
+
+	awsConfig, err := DecodeAWSConfig(s.File)
+	if err != nil {
+		return AWSConfig{}, fmt.Errorf("could not decode aws config: %w", err)
+	}
+	return awsConfig, nil
+}
+
+// getGCPConfig gets the GCP config, decoding it from the file if it isn't set.
+func (s SignerConfig) getGCPConfig() (GCPConfig, error) {
+	if s.GCP != nil {
+		return *s.GCP, nil
+	}
+
+	gcpConfig, err := DecodeGCPConfig(s.File)
+	if err != nil {
+		return GCPConfig{}, fmt.Errorf("could not decode gcp config: %w", err)
+	}
+	return gcpConfig, nil
+}
+
+// getRemoteConfig gets the remote config, decoding it from the file if it isn't set.
+func (s SignerConfig) getRemoteConfig() (RemoteConfig, error) {
+	if s.Remote != nil {
+		return *s.Remote, nil
+	}
+
+	remoteConfig, err := DecodeRemoteConfig(s.File)
+	if err != nil {
+		return RemoteConfig{}, fmt.Errorf("could not decode remote config: %w", err)
+	}
+	return remoteConfig, nil
+}
+
+var (
+	// ErrUnsupportedSignerType indicates the signer type being used is unsupported.
+	ErrUnsupportedSignerType = errors.New("unsupported signer type")
+	// ErrMissingAddress indicates a kms signer config doesn't set the address its key is expected to derive.
+	ErrMissingAddress = errors.New("address must be set to validate the signer's key")
+	// ErrAddressMismatch indicates a signer's key derives a different address than the config expects.
+	ErrAddressMismatch = errors.New("signer key does not derive the expected address")
+)
 
 // SignerType is the signer type
 //
@@ -61,6 +148,8 @@ func (s SignerConfig) IsValid(_ context.Context) (ok bool, err error) {
 	AWSType // AWS
 	// GCPType is a gcp cloud based signer.
 	GCPType // GCP
+	// RemoteType is a signer backed by a remote signing service, such as web3signer or clef.
+	RemoteType // Remote
 )
 
 // AllSignerTypes is a list of all contract types. Since we use stringer and this is a testing library, instead
@@ -90,8 +179,13 @@ func allSignerTypesList() string {
 // TODO: this needs to be moved to some kind of common package.
 // in the old code configs were split into responsible packages. Maybe something like that works here?
 func SignerFromConfig(ctx context.Context, config SignerConfig) (signer.Signer, error) {
-	switch config.Type {
-	case FileType.String():
+	signerType, err := config.signerType()
+	if err != nil {
+		return nil, fmt.Errorf("could not create signer: %w", err)
+	}
+
+	switch signerType {
+	case FileType:
 		wall, err := wallet.FromKeyFile(core.ExpandOrReturnPath(config.File))
 		if err != nil {
 			return nil, fmt.Errorf("could not add signer: %w", err)
@@ -100,29 +194,87 @@ func SignerFromConfig(ctx context.Context, config SignerConfig) (signer.Signer,
 		res := localsigner.NewSigner(wall.PrivateKey())
 
 		return res, nil
-	case AWSType.String():
-		awsConfig, err := DecodeAWSConfig(config.File)
+	case AWSType:
+		awsConfig, err := config.getAWSConfig()
 		if err != nil {
-			return nil, fmt.Errorf("could not decode aws config: %w", err)
+			return nil, err
 		}
-		res, err := awssigner.NewKmsSigner(ctx, awsConfig.Region, awsConfig.AccessKey, awsConfig.AccessSecret, awsConfig.KeyID)
+
+		return makeAWSSigner(ctx, awsConfig)
+	case GCPType:
+		gcpConfig, err := config.getGCPConfig()
 		if err != nil {
-			return nil, fmt.Errorf("could not decode aws config: %w", err)
+			return nil, err
 		}
-		return res, nil
-	case GCPType.String():
-		gcpConfig, err := DecodeGCPConfig(config.File)
+
+		return makeGCPSigner(ctx, gcpConfig)
+	case RemoteType:
+		remoteConfig, err := config.getRemoteConfig()
 		if err != nil {
-			return nil, fmt.Errorf("could not decode gcp config: %w", err)
+			return nil, err
 		}
 
-		return makeGCPSigner(ctx, gcpConfig)
+		return makeRemoteSigner(ctx, remoteConfig)
 	default:
 		return nil, fmt.Errorf("could not create signer: %w", ErrUnsupportedSignerType)
 	}
 }
 
+func makeAWSSigner(ctx context.Context, awsConfig AWSConfig) (signer.Signer, error) {
+	if awsConfig.KeyID == "" {
+		return nil, errors.New("key id must be set")
+	}
+
+	var options []awssigner.Option
+	switch awsConfig.GetCredentialsSource() {
+	case AWSStaticCredentials:
+		if awsConfig.AccessKey == "" || awsConfig.AccessSecret == "" {
+			return nil, errors.New("access key and access secret must be set for static credentials")
+		}
+		options = append(options, awssigner.WithStaticCredentials(awsConfig.AccessKey, awsConfig.AccessSecret))
+	case AWSDefaultCredentials:
+	default:
+		return nil, fmt.Errorf("unknown credentials source %s, must be one of: %s,%s", awsConfig.CredentialsSource, AWSStaticCredentials, AWSDefaultCredentials)
+	}
+
+	if awsConfig.Profile != "" {
+		options = append(options, awssigner.WithProfile(awsConfig.Profile))
+	}
+
+	if awsConfig.Endpoint != "" {
+		options = append(options, awssigner.WithEndpoint(awsConfig.Endpoint))
+	}
+
+	res, err := awssigner.NewSigner(ctx, awsConfig.Region, awsConfig.KeyID, options...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create kms signer: %w", err)
+	}
+
+	return checkAddress(res, awsConfig.Address)
+}
+
+// checkAddress checks the signer's key derives the expected address, if one is set.
+func checkAddress(res signer.Signer, expectedAddress string) (signer.Signer, error) {
+	if expectedAddress == "" {
+		return res, nil
+	}
+
+	if !common.IsHexAddress(expectedAddress) {
+		return nil, fmt.Errorf("invalid address: %s", expectedAddress)
+	}
+
+	if res.Address() != common.HexToAddress(expectedAddress) {
+		return nil, fmt.Errorf("%w: key derives %s, expected %s", ErrAddressMismatch, res.Address(), expectedAddress)
+	}
+
+	return res, nil
+}
+
 func makeGCPSigner(ctx context.Context, gcpConfig GCPConfig) (signer.Signer, error) {
+	if gcpConfig.KeyName == "" {
+		return nil, errors.New("key name must be set")
+	}
+
 	var options []option.ClientOption
 	if gcpConfig.CredentialFile != "" {
 		options = append(options, option.WithCredentialsFile(gcpConfig.CredentialFile))
@@ -142,6 +294,37 @@ func makeGCPSigner(ctx context.Context, gcpConfig GCPConfig) (signer.Signer, err
 		return nil, fmt.Errorf("could not create managed key: %w", err)
 	}
 
+	return checkAddress(res, gcpConfig.Address)
+}
+
+func makeRemoteSigner(ctx context.Context, remoteConfig RemoteConfig) (signer.Signer, error) {
+	flavor, err := remotesigner.ParseFlavor(remoteConfig.Flavor)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse flavor: %w", err)
+	}
+
+	if !common.IsHexAddress(remoteConfig.Address) {
+		return nil, fmt.Errorf("invalid address: %s", remoteConfig.Address)
+	}
+
+	options := []remotesigner.Option{remotesigner.WithFlavor(flavor)}
+	if remoteConfig.ClientCertFile != "" || remoteConfig.ClientKeyFile != "" || remoteConfig.CAFile != "" {
+		httpClient, err := remotesigner.NewTLSClient(
+			core.ExpandOrReturnPath(remoteConfig.ClientCertFile),
+			core.ExpandOrReturnPath(remoteConfig.ClientKeyFile),
+			core.ExpandOrReturnPath(remoteConfig.CAFile),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not create tls client: %w", err)
+		}
+		options = append(options, remotesigner.WithHTTPClient(httpClient))
+	}
+
+	res, err := remotesigner.NewSigner(ctx, remoteConfig.URL, common.HexToAddress(remoteConfig.Address), options...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create remote signer: %w", err)
+	}
+
 	return res, nil
 }
 
@@ -155,6 +338,8 @@ type GCPConfig struct {
 	CredentialFile string `yaml:"credential_file"`
 	// Endpoint is the endpoint to use. This is useful for testing.
 	Endpoint string `yaml:"endpoint"`
+	// Address is the address the key is expected to derive. It's required to validate the config.
+	Address string `yaml:"address"`
 }
 
 // Encode encodes the config to yaml.
@@ -190,6 +375,34 @@ type AWSConfig struct {
 	AccessSecret string `yaml:"access_secret"`
 	// KeyID is the key id for the signer.
 	KeyID string `yaml:"key_id"`
+	// Endpoint is a custom kms endpoint, e.g. a vpc endpoint. This is also useful for testing.
+	Endpoint string `yaml:"endpoint"`
+	// CredentialsSource is where the credentials come from, static or default. Defaults to static if an access key
+	// is set, and default otherwise.
+	CredentialsSource string `yaml:"credentials_source"`
+	// Profile is the profile in the shared aws config files to load config and credentials from.
+	Profile string `yaml:"profile"`
+	// Address is the address the key is expected to derive. It's required to validate the config.
+	Address string `yaml:"address"`
+}
+
+const (
+	// AWSStaticCredentials signs requests with the access key and access secret in the config.
+	AWSStaticCredentials = "static"
+	// AWSDefaultCredentials uses the default aws credential chain: environment variables, shared config files,
+	// web identity tokens (e.g. eks service accounts) or the instance role.
+	AWSDefaultCredentials = "default"
+)
+
+// GetCredentialsSource gets the credentials source, defaulting to static if an access key is set.
+func (a AWSConfig) GetCredentialsSource() string {
+	if a.CredentialsSource != "" {
+		return strings.ToLower(a.CredentialsSource)
+	}
+	if a.AccessKey != "" {
+		return AWSStaticCredentials
+	}
+	return AWSDefaultCredentials
 }
 
 // Encode encodes the config to yaml.
@@ -213,3 +426,42 @@ func DecodeAWSConfig(filePath string) (cfg AWSConfig, err error) {
 	}
 	return cfg, nil
 }
+
+// RemoteConfig is the config for a remote signer.
+type RemoteConfig struct {
+	// URL is the url of the signing service.
+	URL string `yaml:"url"`
+	// Address is the address of the key to sign with.
+	Address string `yaml:"address"`
+	// Flavor is the api of the signing service, web3signer or clef. Defaults to web3signer.
+	Flavor string `yaml:"flavor"`
+	// ClientCertFile is the path to the client certificate used to authenticate to the signing service.
+	ClientCertFile string `yaml:"client_cert_file"`
+	// ClientKeyFile is the path to the client certificate's private key.
+	ClientKeyFile string `yaml:"client_key_file"`
+	// CAFile is the path to the certificate authority the signing service's certificate is verified against.
+	// If it's not set, the system roots are used.
+	CAFile string `yaml:"ca_file"`
+}
+
+// Encode encodes the config to yaml.
+func (r RemoteConfig) Encode() ([]byte, error) {
+	output, err := yaml.Marshal(&r)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshall config %s: %w", ellipsis.Shorten(spew.Sdump(r), 20), err)
+	}
+	return output, nil
+}
+
+// DecodeRemoteConfig decodes the config from a file.
+func DecodeRemoteConfig(filePath string) (cfg RemoteConfig, err error) {
+	input, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return RemoteConfig{}, fmt.Errorf("failed to read file: %w", err)
+	}
+	err = yaml.Unmarshal(input, &cfg)
+	if err != nil {
+		return RemoteConfig{}, fmt.Errorf("could not unmarshall config %s: %w", ellipsis.Shorten(string(input), 30), err)
+	}
+	return cfg, nil
+}
@@ -3,10 +3,18 @@
 import (
 	"fmt"
 	"github.com/Flaque/filet"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
 	. "github.com/stretchr/testify/assert"
 	"github.com/synapsecns/sanguine/ethergo/signer/config"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/awssigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/awssigner/kmsmock"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner/remotemock"
 	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
+	"gopkg.in/yaml.v2"
+	"math/big"
 	"os"
 	"path/filepath"
 )
@@ -91,3 +99,105 @@ func (c ConfigSuite) TestGCPConfigMarshallUnmarshall() {
 
 	Equal(c.T(), gcpConfig, decodedConfig)
 }
+
+func (c ConfigSuite) TestRemoteConfigMarshallUnmarshall() {
+	remoteConfig := config.RemoteConfig{
+		URL:            gofakeit.URL(),
+		Address:        common.BigToAddress(big.NewInt(gofakeit.Int64())).String(),
+		Flavor:         "clef",
+		ClientCertFile: gofakeit.Name(),
+		ClientKeyFile:  gofakeit.Name(),
+		CAFile:         gofakeit.Name(),
+	}
+
+	encodedConfig, err := remoteConfig.Encode()
+	Nil(c.T(), err)
+
+	file := filet.TmpFile(c.T(), "", string(encodedConfig))
+
+	decodedConfig, err := config.DecodeRemoteConfig(file.Name())
+	Nil(c.T(), err)
+
+	Equal(c.T(), remoteConfig, decodedConfig)
+}
+
+func (c ConfigSuite) TestRemoteSignerFromConfig() {
+	mockSigner := remotemock.NewMockTLSSigner(c.GetTestContext(), c.T())
+	certs := mockSigner.Certs()
+
+	encodedConfig, err := config.RemoteConfig{
+		URL:            mockSigner.URL(),
+		Address:        mockSigner.Address().String(),
+		ClientCertFile: certs.ClientCertFile,
+		ClientKeyFile:  certs.ClientKeyFile,
+		CAFile:         certs.CAFile,
+	}.Encode()
+	Nil(c.T(), err)
+
+	remoteSigner, err := config.SignerFromConfig(c.GetTestContext(), config.SignerConfig{
+		Type: config.RemoteType.String(),
+		File: filet.TmpFile(c.T(), "", string(encodedConfig)).Name(),
+	})
+	Nil(c.T(), err)
+	Equal(c.T(), mockSigner.Address(), remoteSigner.Address())
+}
+
+func (c ConfigSuite) TestAWSSignerFromConfig() {
+	kmsMocker := kmsmock.NewMockKMS(c.GetTestContext(), c.T())
+	testKey, err := kmsMocker.Client().CreateKey(c.GetTestContext(), &kms.CreateKeyInput{
+		CustomerMasterKeySpec: types.CustomerMasterKeySpecEccSecgP256k1,
+		KeyUsage:              types.KeyUsageTypeSignVerify,
+	})
+	Nil(c.T(), err)
+
+	kmsSigner, err := awssigner.NewSigner(c.GetTestContext(), kmsMocker.Region(), *testKey.KeyMetadata.KeyId,
+		awssigner.WithEndpoint(kmsMocker.URL()), awssigner.WithStaticCredentials(gofakeit.Word(), gofakeit.Word()))
+	Nil(c.T(), err)
+
+	// the sub-config is set inline in the yaml.
+	var testConfig config.SignerConfig
+	err = yaml.Unmarshal([]byte(fmt.Sprintf(`
+type: aws
+aws:
+  region: %s
+  key_id: %s
+  endpoint: %s
+  access_key: %s
+  access_secret: %s
+  address: %s
+`, kmsMocker.Region(), *testKey.KeyMetadata.KeyId, kmsMocker.URL(), gofakeit.Word(), gofakeit.Word(), kmsSigner.Address())), &testConfig)
+	Nil(c.T(), err)
+	NotNil(c.T(), testConfig.AWS)
+	Equal(c.T(), config.AWSStaticCredentials, testConfig.AWS.GetCredentialsSource())
+
+	ok, err := testConfig.IsValid(c.GetTestContext())
+	True(c.T(), ok)
+	Nil(c.T(), err)
+
+	res, err := config.SignerFromConfig(c.GetTestContext(), testConfig)
+	Nil(c.T(), err)
+	Equal(c.T(), kmsSigner.Address(), res.Address())
+
+	// the key must derive the address.
+	testConfig.AWS.Address = common.BigToAddress(big.NewInt(1)).String()
+	ok, err = testConfig.IsValid(c.GetTestContext())
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrAddressMismatch)
+
+	testConfig.AWS.Address = ""
+	ok, err = testConfig.IsValid(c.GetTestContext())
+	False(c.T(), ok)
+	ErrorIs(c.T(), err, config.ErrMissingAddress)
+}
+
+func (c ConfigSuite) TestAWSCredentialsSource() {
+	Equal(c.T(), config.AWSDefaultCredentials, config.AWSConfig{}.GetCredentialsSource())
+	Equal(c.T(), config.AWSStaticCredentials, config.AWSConfig{AccessKey: gofakeit.Word()}.GetCredentialsSource())
+	Equal(c.T(), config.AWSDefaultCredentials, config.AWSConfig{AccessKey: gofakeit.Word(), CredentialsSource: "Default"}.GetCredentialsSource())
+
+	_, err := config.SignerFromConfig(c.GetTestContext(), config.SignerConfig{
+		Type: config.AWSType.String(),
+		AWS:  &config.AWSConfig{KeyID: gofakeit.UUID(), CredentialsSource: config.AWSStaticCredentials},
+	})
+	Error(c.T(), err)
+}
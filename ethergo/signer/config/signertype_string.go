@@ -11,11 +11,12 @@ func _() {
 	_ = x[FileType-1]
 	_ = x[AWSType-2]
 	_ = x[GCPType-3]
+	_ = x[RemoteType-4]
 }
 
-const _SignerType_name = "FileAWSGCP"
+const _SignerType_name = "FileAWSGCPRemote"
 
-var _SignerType_index = [...]uint8{0, 4, 7, 10}
+var _SignerType_index = [...]uint8{0, 4, 7, 10, 16}
 
 func (i SignerType) String() string {
 	i -= 1
@@ -86,6 +86,16 @@ func (k *MockKMSService) Client() *kms.Client {
 	})
 }
 
+// URL gets the url of the mocked kms server.
+func (k *MockKMSService) URL() string {
+	return k.url
+}
+
+// Region gets the region of the mocked kms server.
+func (k *MockKMSService) Region() string {
+	return k.awsRegion
+}
+
 // startServer starts the server and terminates it when the context is done.
 func (k *MockKMSService) startServer(ctx context.Context) {
 	// start the serveMux and terminate it when the db config is done
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
@@ -14,20 +15,71 @@
 
 // NewKmsSigner creates a kms handler.
 func NewKmsSigner(ctx context.Context, awsRegion, awsAccessKey, awsSecretAccessKey, keyID string) (_ signer.Signer, err error) {
-	aws, err := awsConfig.LoadDefaultConfig(ctx, func(options *awsConfig.LoadOptions) error {
-		options.Credentials = newCredentialProvider(awsAccessKey, awsSecretAccessKey)
-		options.Region = awsRegion
+	return NewSigner(ctx, awsRegion, keyID, WithStaticCredentials(awsAccessKey, awsSecretAccessKey))
+}
+
+// signerOptions are the options for the kms client.
+type signerOptions struct {
+	// credentials are used instead of the default credential chain if set
+	credentials aws.CredentialsProvider
+	// profile is the shared config profile
+	profile string
+	// endpoint is a custom kms endpoint
+	endpoint string
+}
+
+// Option is an option for the kms client of a kms signer.
+type Option func(*signerOptions)
+
+// WithStaticCredentials signs requests with an access key, rather than the default credential chain.
+func WithStaticCredentials(awsAccessKey, awsSecretAccessKey string) Option {
+	return func(o *signerOptions) {
+		o.credentials = newCredentialProvider(awsAccessKey, awsSecretAccessKey)
+	}
+}
+
+// WithProfile loads config and credentials from a profile in the shared aws config files.
+func WithProfile(profile string) Option {
+	return func(o *signerOptions) {
+		o.profile = profile
+	}
+}
+
+// WithEndpoint sends kms requests to a custom endpoint, e.g. a vpc endpoint or a local kms.
+func WithEndpoint(endpoint string) Option {
+	return func(o *signerOptions) {
+		o.endpoint = endpoint
+	}
+}
+
+// NewSigner creates a kms handler for the key. Unless WithStaticCredentials is passed, credentials come from the
+// default credential chain: environment variables, shared config files, web identity tokens or the instance role.
+func NewSigner(ctx context.Context, awsRegion, keyID string, opts ...Option) (_ signer.Signer, err error) {
+	var options signerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	cfg, err := awsConfig.LoadDefaultConfig(ctx, func(loadOptions *awsConfig.LoadOptions) error {
+		if options.credentials != nil {
+			loadOptions.Credentials = options.credentials
+		}
+		loadOptions.SharedConfigProfile = options.profile
+		loadOptions.Region = awsRegion
 		return nil
 	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load aws config: %w", err)
+	}
 
 	kmsHandler := Signer{
 		awsRegion: awsRegion,
 		keyID:     keyID,
-		client:    kms.NewFromConfig(aws),
-	}
-
-	if err != nil {
-		return nil, fmt.Errorf("could not get address: %w", err)
+		client: kms.NewFromConfig(cfg, func(kmsOptions *kms.Options) {
+			if options.endpoint != "" {
+				kmsOptions.EndpointResolver = kms.EndpointResolverFromURL(options.endpoint)
+			}
+		}),
 	}
 
 	kmsHandler.pubKeyData, err = kmsHandler.getPublicKey(ctx)
@@ -14,6 +14,7 @@
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/pkg/errors"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer"
 	"math/big"
@@ -106,6 +107,12 @@ func (signingHandler *Signer) SignMessage(ctx context.Context, message []byte, h
 	return signer.DecodeSignature(sigBytes), nil
 }
 
+// SignTypedData signs the EIP-712 digest of the typed data.
+func (signingHandler *Signer) SignTypedData(ctx context.Context, typedData apitypes.TypedData) (signer.Signature, error) {
+	//nolint: wrapcheck
+	return signer.SignTypedDataDigest(ctx, signingHandler, typedData)
+}
+
 func (signingHandler *Signer) getEthereumSignature(expectedPublicKeyBytes []byte, txHash []byte, r []byte, s []byte) ([]byte, error) {
 	rsSignature := append(adjustSignatureLength(r), adjustSignatureLength(s)...)
 	signature := append(rsSignature, []byte{0}...)
@@ -25,6 +25,7 @@
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 // managedKey uses the Key Management Service (KMS) for blockchain operation.
@@ -124,6 +125,12 @@ func (mk *managedKey) SignMessage(ctx context.Context, message []byte, hash bool
 	return signer.DecodeSignature(etcSig), nil
 }
 
+// SignTypedData signs the EIP-712 digest of the typed data.
+func (mk *managedKey) SignTypedData(ctx context.Context, typedData apitypes.TypedData) (signer.Signature, error) {
+	//nolint: wrapcheck
+	return signer.SignTypedDataDigest(ctx, mk, typedData)
+}
+
 // nolint: cyclop
 func (mk *managedKey) getSignatureFromKMS(ctx context.Context, messageBytes []byte) ([]byte, error) {
 	// resolve a signature
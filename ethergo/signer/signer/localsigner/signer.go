@@ -11,6 +11,7 @@
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer"
 )
 
@@ -50,6 +51,12 @@ func (s *Signer) SignMessage(_ context.Context, message []byte, hash bool) (sign
 	return decodeSignature(sig), nil
 }
 
+// SignTypedData signs the EIP-712 digest of the typed data.
+func (s *Signer) SignTypedData(ctx context.Context, typedData apitypes.TypedData) (signer.Signature, error) {
+	//nolint: wrapcheck
+	return signer.SignTypedDataDigest(ctx, s, typedData)
+}
+
 // Address gets the address of the signer.
 func (s *Signer) Address() common.Address {
 	return crypto.PubkeyToAddress(s.privateKey.PublicKey)
@@ -8,6 +8,8 @@
 	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
 	common "github.com/ethereum/go-ethereum/common"
 
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+
 	context "context"
 
 	crypto "github.com/libp2p/go-libp2p/core/crypto"
@@ -100,6 +102,29 @@ func (_m *Signer) SignMessage(ctx context.Context, message []byte, hash bool) (s
 	return r0, r1
 }
 
+// SignTypedData provides a mock function with given fields: ctx, typedData
+func (_m *Signer) SignTypedData(ctx context.Context, typedData apitypes.TypedData) (signer.Signature, error) {
+	ret := _m.Called(ctx, typedData)
+
+	var r0 signer.Signature
+	if rf, ok := ret.Get(0).(func(context.Context, apitypes.TypedData) signer.Signature); ok {
+		r0 = rf(ctx, typedData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(signer.Signature)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, apitypes.TypedData) error); ok {
+		r1 = rf(ctx, typedData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewSigner interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,3 @@
+// Package remotesigner contains a signer that signs with a key held by a separate signing service, either Web3Signer
+// or Clef, over http.
+package remotesigner
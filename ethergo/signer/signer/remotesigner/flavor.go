@@ -0,0 +1,32 @@
+package remotesigner
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flavor is the api of the signing service.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=Flavor -linecomment
+type Flavor int
+
+const (
+	// Web3Signer is a Web3Signer service running in eth1 mode. Transactions are signed with eth_signTransaction, and
+	// messages with the /api/v1/eth1/sign endpoint.
+	Web3Signer Flavor = iota + 1 // web3signer
+	// Clef is a Clef service. Transactions are signed with account_signTransaction. Clef doesn't sign raw messages.
+	Clef // clef
+)
+
+// ParseFlavor parses a flavor from its name. An empty name is Web3Signer.
+func ParseFlavor(name string) (Flavor, error) {
+	if name == "" {
+		return Web3Signer, nil
+	}
+	for _, flavor := range []Flavor{Web3Signer, Clef} {
+		if strings.EqualFold(name, flavor.String()) {
+			return flavor, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown remote signer flavor %s, must be one of: %s,%s", name, Web3Signer, Clef)
+}
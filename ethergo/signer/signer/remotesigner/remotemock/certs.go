@@ -0,0 +1,118 @@
+package remotemock
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/stretchr/testify/require"
+)
+
+// Certs are the pem files of a certificate authority, and the server and client certificates it issued.
+type Certs struct {
+	// CAFile is the certificate authority's certificate.
+	CAFile string
+	// ServerCertFile is the server's certificate.
+	ServerCertFile string
+	// ServerKeyFile is the server's private key.
+	ServerKeyFile string
+	// ClientCertFile is the client's certificate.
+	ClientCertFile string
+	// ClientKeyFile is the client's private key.
+	ClientKeyFile string
+	// pool contains the certificate authority.
+	pool *x509.CertPool
+}
+
+// newCerts creates a certificate authority, and issues a server certificate for localhost and a client certificate.
+func newCerts(tb testing.TB) *Certs {
+	tb.Helper()
+
+	dir := filet.TmpDir(tb, "")
+	certs := &Certs{
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		ServerCertFile: filepath.Join(dir, "server.pem"),
+		ServerKeyFile:  filepath.Join(dir, "server-key.pem"),
+		ClientCertFile: filepath.Join(dir, "client.pem"),
+		ClientKeyFile:  filepath.Join(dir, "client-key.pem"),
+		pool:           x509.NewCertPool(),
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "remotemock ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caKey, caCert := issueCert(tb, caTemplate, nil, nil, certs.CAFile, "")
+	certs.pool.AddCert(caCert)
+
+	issueCert(tb, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}, caCert, caKey, certs.ServerCertFile, certs.ServerKeyFile)
+
+	issueCert(tb, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "remotemock client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, caKey, certs.ClientCertFile, certs.ClientKeyFile)
+
+	return certs
+}
+
+// issueCert creates a certificate from the template, signed by the parent or self signed if parent is nil, and
+// writes it and its key to pem files. The key isn't written if keyFile is empty.
+func issueCert(tb testing.TB, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, certFile, keyFile string) (*ecdsa.PrivateKey, *x509.Certificate) {
+	tb.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(tb, err)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(tb, err)
+	writePem(tb, certFile, "CERTIFICATE", der)
+
+	if keyFile != "" {
+		keyDer, err := x509.MarshalECPrivateKey(key)
+		require.NoError(tb, err)
+		writePem(tb, keyFile, "EC PRIVATE KEY", keyDer)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(tb, err)
+	return key, cert
+}
+
+func writePem(tb testing.TB, file, blockType string, der []byte) {
+	tb.Helper()
+
+	err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+	require.NoError(tb, err)
+}
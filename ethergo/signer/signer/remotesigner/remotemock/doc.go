@@ -0,0 +1,2 @@
+// Package remotemock sets up a mock signing service that is used for testing the remote signer.
+package remotemock
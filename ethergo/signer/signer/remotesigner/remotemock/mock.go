@@ -0,0 +1,249 @@
+package remotemock
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSigner is a mocked signing service. It holds a single key, and serves the parts of web3signer's eth1 api
+// and clef's account api the remote signer uses.
+type MockSigner struct {
+	// key is the key the mock signs with
+	key *ecdsa.PrivateKey
+	// server is the http server
+	server *httptest.Server
+	// certs are the certificates the tls server and its clients use, if the server uses tls
+	certs *Certs
+}
+
+// NewMockSigner creates a mocked signing service over http. The server is closed when the context is done.
+func NewMockSigner(ctx context.Context, tb testing.TB) *MockSigner {
+	tb.Helper()
+
+	mockSigner := newMockSigner(tb)
+	mockSigner.server = httptest.NewServer(mockSigner.handler(tb))
+	mockSigner.closeOnDone(ctx, tb)
+	return mockSigner
+}
+
+// NewMockTLSSigner creates a mocked signing service over tls, which requires clients to authenticate with the
+// client certificate. The server is closed when the context is done.
+func NewMockTLSSigner(ctx context.Context, tb testing.TB) *MockSigner {
+	tb.Helper()
+
+	mockSigner := newMockSigner(tb)
+	mockSigner.certs = newCerts(tb)
+
+	serverCert, err := tls.LoadX509KeyPair(mockSigner.certs.ServerCertFile, mockSigner.certs.ServerKeyFile)
+	require.NoError(tb, err)
+
+	mockSigner.server = httptest.NewUnstartedServer(mockSigner.handler(tb))
+	mockSigner.server.TLS = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    mockSigner.certs.pool,
+	}
+	mockSigner.server.StartTLS()
+	mockSigner.closeOnDone(ctx, tb)
+	return mockSigner
+}
+
+func newMockSigner(tb testing.TB) *MockSigner {
+	tb.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(tb, err)
+	return &MockSigner{key: key}
+}
+
+func (m *MockSigner) closeOnDone(ctx context.Context, tb testing.TB) {
+	tb.Helper()
+
+	tb.Cleanup(m.server.Close)
+	go func() {
+		<-ctx.Done()
+		m.server.Close()
+	}()
+}
+
+// URL gets the url of the signing service.
+func (m *MockSigner) URL() string {
+	return m.server.URL
+}
+
+// Address gets the address of the key held by the signing service.
+func (m *MockSigner) Address() common.Address {
+	return crypto.PubkeyToAddress(m.key.PublicKey)
+}
+
+// Certs gets the certificates used by the tls server and its clients. It's nil if the server doesn't use tls.
+func (m *MockSigner) Certs() *Certs {
+	return m.certs
+}
+
+// handler serves json-rpc at the root, and web3signer's rest api under /api/v1/eth1.
+func (m *MockSigner) handler(tb testing.TB) http.Handler {
+	tb.Helper()
+
+	rpcServer := rpc.NewServer()
+	require.NoError(tb, rpcServer.RegisterName("eth", &ethAPI{m}))
+	require.NoError(tb, rpcServer.RegisterName("account", &accountAPI{m}))
+
+	mux := http.NewServeMux()
+	mux.Handle("/", rpcServer)
+	mux.HandleFunc("/api/v1/eth1/publicKeys", m.handlePublicKeys)
+	mux.HandleFunc("/api/v1/eth1/sign/", m.handleSign)
+	return mux
+}
+
+// publicKey is the public key without the uncompressed point prefix, as web3signer returns it.
+func (m *MockSigner) publicKey() string {
+	return hexutil.Encode(crypto.FromECDSAPub(&m.key.PublicKey)[1:])
+}
+
+func (m *MockSigner) handlePublicKeys(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode([]string{m.publicKey()})
+}
+
+// handleSign signs the keccak256 hash of the data, with v as 27 or 28 like web3signer.
+func (m *MockSigner) handleSign(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if request.URL.Path != "/api/v1/eth1/sign/"+m.publicKey() {
+		http.Error(writer, "signer not found", http.StatusNotFound)
+		return
+	}
+
+	var body struct {
+		Data hexutil.Bytes `json:"data"`
+	}
+	err := json.NewDecoder(request.Body).Decode(&body)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sig, err := crypto.Sign(crypto.Keccak256(body.Data), m.key)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+
+	writer.Header().Set("Content-Type", "text/plain")
+	_, _ = writer.Write([]byte(hexutil.Encode(sig)))
+}
+
+// signTransaction signs the transaction described by args.
+func (m *MockSigner) signTransaction(args apitypes.SendTxArgs) (*types.Transaction, error) {
+	if args.From.Address() != m.Address() {
+		return nil, fmt.Errorf("unknown account %s", args.From.Address())
+	}
+	if args.ChainID == nil {
+		return nil, errors.New("chain id is required")
+	}
+
+	signedTx, err := types.SignTx(args.ToTransaction(), types.LatestSignerForChainID(args.ChainID.ToInt()), m.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign transaction: %w", err)
+	}
+	return signedTx, nil
+}
+
+// signTypedData signs the EIP-712 digest of the typed data, with v as 27 or 28 like web3signer and clef.
+func (m *MockSigner) signTypedData(address common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if address.Address() != m.Address() {
+		return nil, fmt.Errorf("unknown account %s", address.Address())
+	}
+
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash typed data: %w", err)
+	}
+
+	sig, err := crypto.Sign(digest, m.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign typed data: %w", err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// ethAPI is the eth namespace served by web3signer.
+type ethAPI struct {
+	mockSigner *MockSigner
+}
+
+// Accounts lists the accounts the signing service holds keys for.
+func (e *ethAPI) Accounts() []common.Address {
+	return []common.Address{e.mockSigner.Address()}
+}
+
+// SignTransaction signs a transaction and returns it rlp encoded.
+func (e *ethAPI) SignTransaction(args apitypes.SendTxArgs) (hexutil.Bytes, error) {
+	signedTx, err := e.mockSigner.signTransaction(args)
+	if err != nil {
+		return nil, err
+	}
+	//nolint: wrapcheck
+	return signedTx.MarshalBinary()
+}
+
+// SignTypedData signs EIP-712 typed data.
+func (e *ethAPI) SignTypedData(address common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	return e.mockSigner.signTypedData(address, typedData)
+}
+
+// accountAPI is the account namespace served by clef.
+type accountAPI struct {
+	mockSigner *MockSigner
+}
+
+// List lists the accounts the signing service holds keys for.
+func (a *accountAPI) List() []common.Address {
+	return []common.Address{a.mockSigner.Address()}
+}
+
+// SignTransactionResult is the result of account_signTransaction.
+type SignTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// SignTransaction signs a transaction and returns it both rlp encoded and as json.
+func (a *accountAPI) SignTransaction(args apitypes.SendTxArgs, _ *string) (*SignTransactionResult, error) {
+	signedTx, err := a.mockSigner.signTransaction(args)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode transaction: %w", err)
+	}
+	return &SignTransactionResult{Raw: raw, Tx: signedTx}, nil
+}
+
+// SignTypedData signs EIP-712 typed data.
+func (a *accountAPI) SignTypedData(address common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	return a.mockSigner.signTypedData(address, typedData)
+}
@@ -0,0 +1,270 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	libp2p "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+)
+
+var (
+	// ErrUnknownAddress is returned when the signing service doesn't hold the key for the address.
+	ErrUnknownAddress = errors.New("signing service has no key for address")
+	// ErrUnhashedMessage is returned when asked to sign a message without hashing it. Signing services only sign
+	// data they hash themselves, so they can't be used to sign an arbitrary digest.
+	ErrUnhashedMessage = errors.New("remote signers can only sign messages they hash")
+	// ErrMessageSigningUnsupported is returned when asked to sign a message with a flavor that only signs transactions.
+	ErrMessageSigningUnsupported = errors.New("signing service does not sign raw messages")
+)
+
+// Signer signs with a key held by a remote signing service.
+type Signer struct {
+	// address is the address of the key
+	address common.Address
+	// flavor is the api of the signing service
+	flavor Flavor
+	// url is the url of the signing service
+	url string
+	// httpClient is used for every request to the signing service
+	httpClient *http.Client
+	// rpcClient is the json-rpc client for the signing service
+	rpcClient *rpc.Client
+	// publicKey identifies the key to web3signer's message signing endpoint
+	publicKey string
+}
+
+// Option is an option for the remote signer.
+type Option func(*Signer)
+
+// WithFlavor sets the api of the signing service. The default is Web3Signer.
+func WithFlavor(flavor Flavor) Option {
+	return func(s *Signer) {
+		s.flavor = flavor
+	}
+}
+
+// WithHTTPClient sets the http client used to reach the signing service, e.g. one created with NewTLSClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Signer) {
+		s.httpClient = client
+	}
+}
+
+// NewSigner creates a signer for address, which fails fast if the signing service at url doesn't hold its key.
+func NewSigner(ctx context.Context, url string, address common.Address, opts ...Option) (_ signer.Signer, err error) {
+	remoteSigner := &Signer{
+		address:    address,
+		flavor:     Web3Signer,
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(remoteSigner)
+	}
+
+	remoteSigner.rpcClient, err = rpc.DialOptions(ctx, remoteSigner.url, rpc.WithHTTPClient(remoteSigner.httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("could not dial signing service: %w", err)
+	}
+
+	err = remoteSigner.checkAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if remoteSigner.flavor == Web3Signer {
+		remoteSigner.publicKey, err = remoteSigner.getPublicKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return remoteSigner, nil
+}
+
+// checkAccount checks the signing service lists the signer's address.
+func (s *Signer) checkAccount(ctx context.Context) error {
+	method := "eth_accounts"
+	if s.flavor == Clef {
+		method = "account_list"
+	}
+
+	var accounts []common.Address
+	err := s.rpcClient.CallContext(ctx, &accounts, method)
+	if err != nil {
+		return fmt.Errorf("could not list accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		if account == s.address {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownAddress, s.address)
+}
+
+// getPublicKey gets the public key web3signer identifies the signer's key by.
+func (s *Signer) getPublicKey(ctx context.Context) (string, error) {
+	var publicKeys []string
+	err := s.doRequest(ctx, http.MethodGet, "/api/v1/eth1/publicKeys", nil, func(body []byte) error {
+		//nolint: wrapcheck
+		return json.Unmarshal(body, &publicKeys)
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not get public keys: %w", err)
+	}
+
+	for _, publicKey := range publicKeys {
+		rawKey, err := hexutil.Decode(publicKey)
+		if err != nil {
+			continue
+		}
+		// web3signer omits the uncompressed point prefix.
+		if len(rawKey) == 64 {
+			rawKey = append([]byte{4}, rawKey...)
+		}
+
+		pubKey, err := crypto.UnmarshalPubkey(rawKey)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*pubKey) == s.address {
+			return publicKey, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownAddress, s.address)
+}
+
+// SignMessage signs the keccak256 hash of the message. The signing service hashes the message itself,
+// so hash must be true.
+func (s *Signer) SignMessage(ctx context.Context, message []byte, hash bool) (signer.Signature, error) {
+	if !hash {
+		return nil, ErrUnhashedMessage
+	}
+	if s.flavor != Web3Signer {
+		return nil, fmt.Errorf("%w: %s", ErrMessageSigningUnsupported, s.flavor)
+	}
+
+	request, err := json.Marshal(map[string]string{"data": hexutil.Encode(message)})
+	if err != nil {
+		return nil, fmt.Errorf("could not encode request: %w", err)
+	}
+
+	var sig []byte
+	err = s.doRequest(ctx, http.MethodPost, "/api/v1/eth1/sign/"+s.publicKey, request, func(body []byte) (err error) {
+		sig, err = hexutil.Decode(strings.TrimSpace(string(body)))
+		//nolint: wrapcheck
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not sign message: %w", err)
+	}
+
+	return s.checkSignature(crypto.Keccak256(message), sig)
+}
+
+// SignTypedData signs the EIP-712 digest of the typed data with eth_signTypedData on web3signer, or
+// account_signTypedData on clef.
+func (s *Signer) SignTypedData(ctx context.Context, typedData apitypes.TypedData) (signer.Signature, error) {
+	digest, err := signer.TypedDataHash(typedData)
+	if err != nil {
+		//nolint: wrapcheck
+		return nil, err
+	}
+
+	method := "eth_signTypedData"
+	if s.flavor == Clef {
+		method = "account_signTypedData"
+	}
+
+	var sig hexutil.Bytes
+	err = s.rpcClient.CallContext(ctx, &sig, method, common.NewMixedcaseAddress(s.address), typedData)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign typed data: %w", err)
+	}
+
+	return s.checkSignature(digest, sig)
+}
+
+// checkSignature checks the signature of digest is from the signer's key, and normalizes v to 0 or 1.
+func (s *Signer) checkSignature(digest, sig []byte) (signer.Signature, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover signer: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pubKey); recovered != s.address {
+		return nil, fmt.Errorf("signature is from %s, expected %s", recovered, s.address)
+	}
+
+	return signer.DecodeSignature(sig), nil
+}
+
+// doRequest makes a request to the signing service's rest api, and parses the body of a successful response.
+func (s *Signer) doRequest(ctx context.Context, method, path string, body []byte, parse func(body []byte) error) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.url+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not reach signing service: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("signing service returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	err = parse(respBody)
+	if err != nil {
+		return fmt.Errorf("could not parse response: %w", err)
+	}
+	return nil
+}
+
+// Address gets the address of the signer.
+func (s *Signer) Address() common.Address {
+	return s.address
+}
+
+// PrivKey is not supported by remote signers, since the key never leaves the signing service, and it can't sign
+// the sha256 digests libp2p uses. It always returns nil.
+func (s *Signer) PrivKey() libp2p.PrivKey {
+	return nil
+}
+
+var _ signer.Signer = &Signer{}
@@ -0,0 +1,107 @@
+package remotesigner_test
+
+import (
+	"math/big"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner/remotemock"
+)
+
+func (r *RemoteSignerSuite) TestSignMessage() {
+	remoteSigner, err := remotesigner.NewSigner(r.GetTestContext(), r.mockSigner.URL(), r.mockSigner.Address())
+	r.Require().NoError(err)
+
+	message := []byte(gofakeit.Sentence(10))
+	sig, err := remoteSigner.SignMessage(r.GetTestContext(), message, true)
+	r.Require().NoError(err)
+
+	// v is normalized to 0 or 1, like the other signers.
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(message), signer.Encode(sig))
+	r.Require().NoError(err)
+	r.Equal(r.mockSigner.Address(), crypto.PubkeyToAddress(*pubKey))
+
+	_, err = remoteSigner.SignMessage(r.GetTestContext(), crypto.Keccak256(message), false)
+	r.ErrorIs(err, remotesigner.ErrUnhashedMessage)
+}
+
+func (r *RemoteSignerSuite) TestClef() {
+	remoteSigner, err := remotesigner.NewSigner(r.GetTestContext(), r.mockSigner.URL(), r.mockSigner.Address(),
+		remotesigner.WithFlavor(remotesigner.Clef))
+	r.Require().NoError(err)
+
+	chainID := big.NewInt(int64(gofakeit.Uint16()))
+	transactor, err := remoteSigner.GetTransactor(r.GetTestContext(), chainID)
+	r.Require().NoError(err)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     gofakeit.Uint64(),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(3),
+		Data:      []byte{1, 2, 3},
+	})
+
+	signedTx, err := transactor.Signer(transactor.From, tx)
+	r.Require().NoError(err)
+	latestSigner := types.LatestSignerForChainID(chainID)
+	r.Equal(latestSigner.Hash(tx), latestSigner.Hash(signedTx))
+
+	sender, err := types.Sender(latestSigner, signedTx)
+	r.Require().NoError(err)
+	r.Equal(r.mockSigner.Address(), sender)
+
+	// other addresses can't sign.
+	_, err = transactor.Signer(common.BigToAddress(big.NewInt(2)), tx)
+	r.Error(err)
+
+	_, err = remoteSigner.SignMessage(r.GetTestContext(), []byte(gofakeit.Sentence(10)), true)
+	r.ErrorIs(err, remotesigner.ErrMessageSigningUnsupported)
+
+	// typed data is signed with account_signTypedData.
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}},
+			"Message":      {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Message",
+		Domain:      apitypes.TypedDataDomain{Name: "remotesigner"},
+		Message:     apitypes.TypedDataMessage{"contents": gofakeit.Sentence(10)},
+	}
+	sig, err := remoteSigner.SignTypedData(r.GetTestContext(), typedData)
+	r.Require().NoError(err)
+	r.NoError(signer.VerifyTypedData(typedData, sig, r.mockSigner.Address()))
+}
+
+func (r *RemoteSignerSuite) TestUnknownAddress() {
+	_, err := remotesigner.NewSigner(r.GetTestContext(), r.mockSigner.URL(), common.BigToAddress(big.NewInt(1)))
+	r.ErrorIs(err, remotesigner.ErrUnknownAddress)
+}
+
+func (r *RemoteSignerSuite) TestClientCertificate() {
+	tlsSigner := remotemock.NewMockTLSSigner(r.GetTestContext(), r.T())
+	certs := tlsSigner.Certs()
+
+	// the service only trusts the client certificate.
+	serverOnly, err := remotesigner.NewTLSClient("", "", certs.CAFile)
+	r.Require().NoError(err)
+	_, err = remotesigner.NewSigner(r.GetTestContext(), tlsSigner.URL(), tlsSigner.Address(), remotesigner.WithHTTPClient(serverOnly))
+	r.Error(err)
+
+	httpClient, err := remotesigner.NewTLSClient(certs.ClientCertFile, certs.ClientKeyFile, certs.CAFile)
+	r.Require().NoError(err)
+	remoteSigner, err := remotesigner.NewSigner(r.GetTestContext(), tlsSigner.URL(), tlsSigner.Address(), remotesigner.WithHTTPClient(httpClient))
+	r.Require().NoError(err)
+
+	_, err = remoteSigner.SignMessage(r.GetTestContext(), []byte(gofakeit.Sentence(10)), true)
+	r.NoError(err)
+}
@@ -0,0 +1,114 @@
+package remotesigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ErrTransactionMismatch is returned when the signing service signs a different transaction than it was sent.
+var ErrTransactionMismatch = errors.New("signed transaction does not match the transaction sent to the signing service")
+
+// GetTransactor creates a transactor that signs with the signing service.
+func (s *Signer) GetTransactor(ctx context.Context, chainID *big.Int) (*bind.TransactOpts, error) {
+	latestSigner := types.LatestSignerForChainID(chainID)
+
+	return &bind.TransactOpts{
+		From: s.address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.address {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			return s.signTransaction(ctx, latestSigner, chainID, tx)
+		},
+	}, nil
+}
+
+// clefSignTransactionResult is the result of account_signTransaction.
+type clefSignTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// signTransaction signs the transaction with the signing service, and checks the service signed the transaction
+// it was sent with the signer's key.
+func (s *Signer) signTransaction(ctx context.Context, latestSigner types.Signer, chainID *big.Int, tx *types.Transaction) (*types.Transaction, error) {
+	args := toSendTxArgs(s.address, chainID, tx)
+
+	var rawTx hexutil.Bytes
+	switch s.flavor {
+	case Clef:
+		var res clefSignTransactionResult
+		err := s.rpcClient.CallContext(ctx, &res, "account_signTransaction", args)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign transaction: %w", err)
+		}
+		rawTx = res.Raw
+	default:
+		err := s.rpcClient.CallContext(ctx, &rawTx, "eth_signTransaction", args)
+		if err != nil {
+			return nil, fmt.Errorf("could not sign transaction: %w", err)
+		}
+	}
+
+	signedTx := new(types.Transaction)
+	err := signedTx.UnmarshalBinary(rawTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode signed transaction: %w", err)
+	}
+
+	if latestSigner.Hash(signedTx) != latestSigner.Hash(tx) {
+		return nil, ErrTransactionMismatch
+	}
+
+	sender, err := types.Sender(latestSigner, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover sender: %w", err)
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("transaction is signed by %s, expected %s", sender, s.address)
+	}
+
+	return signedTx, nil
+}
+
+// toSendTxArgs converts the transaction to the arguments web3signer and clef sign.
+func toSendTxArgs(from common.Address, chainID *big.Int, tx *types.Transaction) apitypes.SendTxArgs {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(from),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		accessList := tx.AccessList()
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+	case types.AccessListTxType:
+		accessList := tx.AccessList()
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		args.AccessList = &accessList
+	default:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	return args
+}
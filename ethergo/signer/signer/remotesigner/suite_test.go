@@ -0,0 +1,32 @@
+package remotesigner_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner/remotemock"
+)
+
+// RemoteSignerSuite is the remote signer test suite.
+type RemoteSignerSuite struct {
+	*testsuite.TestSuite
+	mockSigner *remotemock.MockSigner
+}
+
+// NewRemoteSignerSuite creates a remote signer test suite.
+func NewRemoteSignerSuite(tb testing.TB) *RemoteSignerSuite {
+	tb.Helper()
+	return &RemoteSignerSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+	}
+}
+
+func (r *RemoteSignerSuite) SetupTest() {
+	r.TestSuite.SetupTest()
+	r.mockSigner = remotemock.NewMockSigner(r.GetTestContext(), r.T())
+}
+
+func TestRemoteSignerSuite(t *testing.T) {
+	suite.Run(t, NewRemoteSignerSuite(t))
+}
@@ -9,6 +9,7 @@
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	libp2p "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/synapsecns/sanguine/core"
 )
@@ -19,6 +20,8 @@
 type Signer interface {
 	// SignMessage signs a message
 	SignMessage(ctx context.Context, message []byte, hash bool) (Signature, error)
+	// SignTypedData signs the EIP-712 digest of the typed data.
+	SignTypedData(ctx context.Context, typedData apitypes.TypedData) (Signature, error)
 	// GetTransactor gets the transactor for a tx manager.
 	// TODO: this doesn't support pre-london txes yet
 	GetTransactor(ctx context.Context, chainID *big.Int) (*bind.TransactOpts, error)
@@ -15,6 +15,8 @@
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/gcpsigner"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/gcpsigner/gcpmock"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/remotesigner/remotemock"
 	"github.com/synapsecns/sanguine/ethergo/signer/wallet"
 	"testing"
 )
@@ -42,6 +44,8 @@ func TestSignerSuite(t *testing.T) {
 
 func (s *SignerSuite) SetupTest() {
 	s.TestSuite.SetupTest()
+	s.testSigners = nil
+
 	// add aws signer
 	s.addSigner(config.AWSType, NewSignerFromMockKMS(s.GetTestContext(), s.T()))
 
@@ -54,6 +58,9 @@ func (s *SignerSuite) SetupTest() {
 
 	s.addSigner(config.GCPType, gcpSigner)
 
+	// add remote signer
+	s.addSigner(config.RemoteType, NewSignerFromMockRemote(s.GetTestContext(), s.T()))
+
 	// add local signer
 	newWallet, err := wallet.FromRandom()
 	s.NoError(err, "should create wallet")
@@ -88,6 +95,21 @@ func NewSignerFromMockKMS(ctx context.Context, tb testing.TB) awssigner.UnsafeSi
 	return awsSigner
 }
 
+// NewSignerFromMockRemote creates a new remote signer from a mock signing service over tls.
+func NewSignerFromMockRemote(ctx context.Context, tb testing.TB) signer.Signer {
+	tb.Helper()
+
+	mockSigner := remotemock.NewMockTLSSigner(ctx, tb)
+	certs := mockSigner.Certs()
+
+	httpClient, err := remotesigner.NewTLSClient(certs.ClientCertFile, certs.ClientKeyFile, certs.CAFile)
+	require.Nil(tb, err)
+
+	remoteSigner, err := remotesigner.NewSigner(ctx, mockSigner.URL(), mockSigner.Address(), remotesigner.WithHTTPClient(httpClient))
+	require.Nil(tb, err)
+	return remoteSigner
+}
+
 func (s *SignerSuite) addSigner(signerType config.SignerType, signer signer.Signer) {
 	s.testSigners = append(s.testSigners, TestSigner{
 		Signer:     signer,
@@ -0,0 +1,80 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ErrInvalidSignature is returned when typed data wasn't signed by the expected address.
+var ErrInvalidSignature = errors.New("signature is not from the expected address")
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Div(secp256k1N, big.NewInt(2))
+)
+
+// TypedDataHash gets the EIP-712 digest of the typed data: keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+func TypedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash typed data: %w", err)
+	}
+	return digest, nil
+}
+
+// SignTypedDataDigest signs the EIP-712 digest of the typed data with a signer that signs digests. The s value of
+// the signature is normalized to the lower half of the curve order, since most on-chain verifiers reject the upper half.
+func SignTypedDataDigest(ctx context.Context, digestSigner Signer, typedData apitypes.TypedData) (Signature, error) {
+	digest, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := digestSigner.SignMessage(ctx, digest, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign typed data: %w", err)
+	}
+
+	if sig.S().Cmp(secp256k1HalfN) > 0 {
+		v := new(big.Int).Xor(sig.V(), big.NewInt(1))
+		return NewSignature(v, sig.R(), new(big.Int).Sub(secp256k1N, sig.S())), nil
+	}
+	return sig, nil
+}
+
+// RecoverTypedDataSigner recovers the address that signed the typed data. v may be either 0/1 or 27/28.
+func RecoverTypedDataSigner(typedData apitypes.TypedData, sig Signature) (common.Address, error) {
+	digest, err := TypedDataHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	rawSig := Encode(sig)
+	if rawSig[crypto.RecoveryIDOffset] >= 27 {
+		rawSig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(digest, rawSig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("could not recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// VerifyTypedData checks the typed data was signed by address.
+func VerifyTypedData(typedData apitypes.TypedData, sig Signature, address common.Address) error {
+	recovered, err := RecoverTypedDataSigner(typedData, sig)
+	if err != nil {
+		return err
+	}
+	if recovered != address {
+		return fmt.Errorf("%w: signed by %s, expected %s", ErrInvalidSignature, recovered, address)
+	}
+	return nil
+}
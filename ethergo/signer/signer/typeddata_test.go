@@ -0,0 +1,66 @@
+package signer_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+)
+
+// newTestTypedData creates typed data for a quote.
+func newTestTypedData(amount int64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Quote": {
+				{Name: "relayer", Type: "address"},
+				{Name: "originChainId", Type: "uint256"},
+				{Name: "amount", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Quote",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "FastBridge",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(1),
+			VerifyingContract: common.BigToAddress(big.NewInt(1)).String(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"relayer":       common.BigToAddress(big.NewInt(2)).String(),
+			"originChainId": "10",
+			"amount":        big.NewInt(amount).String(),
+		},
+	}
+}
+
+func (s *SignerSuite) TestSignTypedData() {
+	halfN := new(big.Int).Div(crypto.S256().Params().N, big.NewInt(2))
+
+	s.RunOnAllSigners(func(testSigner signer.Signer) {
+		typedData := newTestTypedData(100)
+
+		sig, err := testSigner.SignTypedData(s.GetTestContext(), typedData)
+		s.Require().NoError(err)
+		s.LessOrEqual(sig.S().Cmp(halfN), 0)
+
+		recovered, err := signer.RecoverTypedDataSigner(typedData, sig)
+		s.Require().NoError(err)
+		s.Equal(testSigner.Address(), recovered)
+		s.NoError(signer.VerifyTypedData(typedData, sig, testSigner.Address()))
+
+		// v may also be 27 or 28, as it is on chain.
+		onChainSig := signer.NewSignature(new(big.Int).Add(sig.V(), big.NewInt(27)), sig.R(), sig.S())
+		s.NoError(signer.VerifyTypedData(typedData, onChainSig, testSigner.Address()))
+
+		// the signature doesn't verify for different data.
+		s.ErrorIs(signer.VerifyTypedData(newTestTypedData(101), sig, testSigner.Address()), signer.ErrInvalidSignature)
+	})
+}
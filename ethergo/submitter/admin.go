@@ -0,0 +1,318 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NonceState is the state of a pending nonce, as reported to operators.
+type NonceState string
+
+const (
+	// NoncePending is a nonce that is being submitted and bumped as usual.
+	NoncePending NonceState = "pending"
+	// NonceStuck is a nonce that has been pending for longer than the stuck threshold.
+	NonceStuck NonceState = "stuck"
+	// NonceFailed is a nonce whose latest attempt could not be submitted.
+	NonceFailed NonceState = "failed"
+)
+
+// maxListedNonces is the maximum number of nonces after the on-chain nonce that are checked by ListNonces.
+const maxListedNonces = 100
+
+var (
+	// ErrNotPending is returned when a nonce has no pending attempts.
+	ErrNotPending = errors.New("nonce is not pending")
+	// ErrPriceTooLow is returned by ForceBump when the price is not above the price of the current attempt.
+	ErrPriceTooLow = errors.New("gas price must be higher than the current attempt")
+	// ErrPriceTooHigh is returned by ForceBump when the price is above the max gas price.
+	ErrPriceTooHigh = errors.New("gas price exceeds max gas price")
+)
+
+// NonceSummary is the summary of a pending nonce.
+type NonceSummary struct {
+	// ChainID is the chain id of the nonce.
+	ChainID *big.Int
+	// Nonce is the nonce.
+	Nonce uint64
+	// State is the state of the nonce.
+	State NonceState
+	// Latest is the most recent pending attempt.
+	Latest db.TX
+	// Attempts is the number of attempts for the nonce, including ones that have been cancelled.
+	Attempts int
+	// Age is the time since the first attempt was stored.
+	Age time.Duration
+}
+
+// NonceSync is the result of re-syncing a nonce from the chain.
+type NonceSync struct {
+	// OnChainNonce is the nonce of the signer on chain. Every nonce before it has been marked replaced or confirmed.
+	OnChainNonce uint64
+	// NextNonce is the nonce the next submitted transaction will use.
+	NextNonce uint64
+}
+
+// ListNonces returns the pending nonces of the signer on the chain, in order. Nonces that have been pending for longer
+// than stuckAfter are reported as stuck. Only the first maxListedNonces nonces after the on-chain nonce are checked.
+func (t *txSubmitterImpl) ListNonces(parentCtx context.Context, chainID *big.Int, stuckAfter time.Duration) (nonces []NonceSummary, err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.ListNonces", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get client: %w", err)
+	}
+
+	onChainNonce, err := chainClient.NonceAt(ctx, t.signer.Address(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce: %w", err)
+	}
+
+	dbNonce, err := t.db.GetNonceForChainID(ctx, t.signer.Address(), chainID)
+	if errors.Is(err, db.ErrNoNonceForChain) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce from db: %w", err)
+	}
+
+	for nonce := onChainNonce; nonce <= dbNonce && nonce < onChainNonce+maxListedNonces; nonce++ {
+		attempts, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.AllStatusTypes()...)
+		if err != nil {
+			return nil, fmt.Errorf("could not get nonce attempts: %w", err)
+		}
+
+		summary, ok := summarizeNonce(chainID, nonce, attempts, stuckAfter)
+		if ok {
+			nonces = append(nonces, summary)
+		}
+	}
+
+	span.SetAttributes(attribute.Int("nonces", len(nonces)))
+	return nonces, nil
+}
+
+// summarizeNonce summarizes the attempts for a nonce. It returns false if none of the attempts are pending.
+func summarizeNonce(chainID *big.Int, nonce uint64, attempts []db.TX, stuckAfter time.Duration) (NonceSummary, bool) {
+	summary := NonceSummary{
+		ChainID:  core.CopyBigInt(chainID),
+		Nonce:    nonce,
+		Attempts: len(attempts),
+	}
+
+	var found bool
+	var firstSeen time.Time
+	for _, attempt := range attempts {
+		if firstSeen.IsZero() || attempt.CreationTime().Before(firstSeen) {
+			firstSeen = attempt.CreationTime()
+		}
+
+		if !isPendingStatus(attempt.Status) {
+			continue
+		}
+		if !found || attempt.CreationTime().After(summary.Latest.CreationTime()) {
+			summary.Latest = attempt
+			found = true
+		}
+	}
+	if !found {
+		return NonceSummary{}, false
+	}
+
+	summary.Age = time.Since(firstSeen)
+	switch {
+	case summary.Latest.Status == db.FailedSubmit:
+		summary.State = NonceFailed
+	case summary.Age > stuckAfter:
+		summary.State = NonceStuck
+	default:
+		summary.State = NoncePending
+	}
+	return summary, true
+}
+
+// isPendingStatus returns true if a tx with the status is still being bumped.
+func isPendingStatus(status db.Status) bool {
+	for _, pendingStatus := range pendingStatuses {
+		if status == pendingStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// NonceHistory returns every attempt for the nonce, oldest first.
+func (t *txSubmitterImpl) NonceHistory(ctx context.Context, chainID *big.Int, nonce uint64) ([]db.TX, error) {
+	attempts, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.AllStatusTypes()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+	if len(attempts) == 0 {
+		return nil, fmt.Errorf("could not get history of nonce %d on chain %s: %w", nonce, chainID, db.ErrNonceNotExist)
+	}
+
+	sort.SliceStable(attempts, func(i, j int) bool {
+		return attempts[i].CreationTime().Before(attempts[j].CreationTime())
+	})
+	return attempts, nil
+}
+
+// ForceBump replaces the pending transaction for the nonce with a copy at gasPrice. For dynamic fee txes, both the fee
+// cap and the tip cap are set to gasPrice. The price can't exceed the max gas price, and the bumped tx can't exceed the
+// max total cost.
+func (t *txSubmitterImpl) ForceBump(parentCtx context.Context, chainID *big.Int, nonce uint64, gasPrice *big.Int) (txHash common.Hash, err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.ForceBump", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.Int64("nonce", int64(nonce)),
+		attribute.Stringer("gasPrice", gasPrice),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	// hold the queue lock so the chain queue can't bump the tx at the same time.
+	locker, err := t.queueMux.LockCtx(ctx, t.laneKey(chainID))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not lock queue: %w", err)
+	}
+	defer locker.Unlock()
+
+	attempts, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, pendingStatuses...)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+	if len(attempts) == 0 {
+		return common.Hash{}, fmt.Errorf("could not bump nonce %d on chain %s: %w", nonce, chainID, ErrNotPending)
+	}
+
+	prevTx := highestPricedAttempt(attempts)
+	if gasPrice.Cmp(prevTx.GasFeeCap()) <= 0 {
+		return common.Hash{}, fmt.Errorf("could not bump nonce %d to %s, current price is %s: %w", nonce, gasPrice, prevTx.GasFeeCap(), ErrPriceTooLow)
+	}
+	if maxPrice := t.config.GetMaxGasPrice(int(chainID.Uint64())); gasPrice.Cmp(maxPrice) > 0 {
+		return common.Hash{}, fmt.Errorf("could not bump nonce %d to %s, max price is %s: %w", nonce, gasPrice, maxPrice, ErrPriceTooHigh)
+	}
+
+	var tx *types.Transaction
+	switch prevTx.Type() {
+	case types.LegacyTxType:
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    prevTx.Nonce(),
+			GasPrice: core.CopyBigInt(gasPrice),
+			Gas:      prevTx.Gas(),
+			To:       prevTx.To(),
+			Value:    prevTx.Value(),
+			Data:     prevTx.Data(),
+		})
+	case types.DynamicFeeTxType:
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   prevTx.ChainId(),
+			Nonce:     prevTx.Nonce(),
+			GasTipCap: core.CopyBigInt(gasPrice),
+			GasFeeCap: core.CopyBigInt(gasPrice),
+			Gas:       prevTx.Gas(),
+			To:        prevTx.To(),
+			Value:     prevTx.Value(),
+			Data:      prevTx.Data(),
+		})
+	default:
+		return common.Hash{}, fmt.Errorf("unknown tx type: %v", prevTx.Type())
+	}
+
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not get client: %w", err)
+	}
+
+	err = t.applyCostCeil(ctx, chainClient, int(chainID.Uint64()), tx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not bump nonce %d: %w", nonce, err)
+	}
+
+	transactor, err := t.signer.GetTransactor(ctx, core.CopyBigInt(chainID))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not get transactor: %w", err)
+	}
+
+	tx, err = transactor.Signer(transactor.From, tx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not sign tx: %w", err)
+	}
+
+	err = t.storeTX(ctx, tx, db.Stored, prevTx.UUID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not store tx: %w", err)
+	}
+
+	span.AddEvent("trigger reprocess")
+	t.triggerProcessQueue(ctx)
+
+	return tx.Hash(), nil
+}
+
+// ResyncNonce re-syncs the nonce of the signer from the chain. Every attempt before the on-chain nonce is marked
+// replaced or confirmed, so the submitter stops bumping txes that can no longer be mined.
+func (t *txSubmitterImpl) ResyncNonce(parentCtx context.Context, chainID *big.Int) (nonceSync NonceSync, err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.ResyncNonce", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	// hold the nonce lock so no tx is signed with a nonce we're about to change.
+	nonceLocker, err := t.nonceMux.LockCtx(ctx, t.laneKey(chainID))
+	if err != nil {
+		return NonceSync{}, fmt.Errorf("could not lock nonce: %w", err)
+	}
+	defer nonceLocker.Unlock()
+
+	queueLocker, err := t.queueMux.LockCtx(ctx, t.laneKey(chainID))
+	if err != nil {
+		return NonceSync{}, fmt.Errorf("could not lock queue: %w", err)
+	}
+	defer queueLocker.Unlock()
+
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return NonceSync{}, fmt.Errorf("could not get client: %w", err)
+	}
+
+	nonceSync.OnChainNonce, err = chainClient.NonceAt(ctx, t.signer.Address(), nil)
+	if err != nil {
+		return NonceSync{}, fmt.Errorf("could not get nonce: %w", err)
+	}
+
+	err = t.db.MarkAllBeforeNonceReplacedOrConfirmed(ctx, t.signer.Address(), chainID, nonceSync.OnChainNonce)
+	if err != nil {
+		return NonceSync{}, fmt.Errorf("could not mark txes: %w", err)
+	}
+
+	nonceSync.NextNonce, err = t.getNonce(ctx, chainID, t.signer.Address())
+	if err != nil {
+		return NonceSync{}, fmt.Errorf("could not get next nonce: %w", err)
+	}
+
+	span.SetAttributes(attribute.Int64("on_chain_nonce", int64(nonceSync.OnChainNonce)), attribute.Int64("next_nonce", int64(nonceSync.NextNonce)))
+	return nonceSync, nil
+}
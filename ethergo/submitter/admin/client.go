@@ -0,0 +1,125 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Client is a client for the admin api.
+type Client struct {
+	baseURL    string
+	signer     *common.Address
+	httpClient *http.Client
+}
+
+// NewClient creates a new client for the admin api mounted at baseURL. If signer is not nil, every request is made
+// for that signer in the submitter's pool.
+func NewClient(baseURL string, signer *common.Address) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		signer:     signer,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// ListNonces lists the pending, stuck and failed nonces of a chain. If stuckAfter is zero, DefaultStuckAfter is used.
+func (c *Client) ListNonces(ctx context.Context, chainID uint64, stuckAfter time.Duration) (res []NonceSummary, err error) {
+	query := url.Values{}
+	if stuckAfter != 0 {
+		query.Set(stuckAfterParam, stuckAfter.String())
+	}
+	err = c.do(ctx, http.MethodGet, noncesPath(chainID), query, &res)
+	return res, err
+}
+
+// NonceHistory returns every attempt for a nonce, oldest first.
+func (c *Client) NonceHistory(ctx context.Context, chainID, nonce uint64) (res []Attempt, err error) {
+	err = c.do(ctx, http.MethodGet, noncePath(chainID, nonce), url.Values{}, &res)
+	return res, err
+}
+
+// ForceBump replaces the pending tx for a nonce with a copy at gasPrice.
+func (c *Client) ForceBump(ctx context.Context, chainID, nonce uint64, gasPrice *big.Int) (res BumpResponse, err error) {
+	query := url.Values{}
+	query.Set(gasPriceParam, gasPrice.String())
+	err = c.do(ctx, http.MethodPost, noncePath(chainID, nonce)+"/bump", query, &res)
+	return res, err
+}
+
+// Cancel cancels a nonce.
+func (c *Client) Cancel(ctx context.Context, chainID, nonce uint64) error {
+	return c.do(ctx, http.MethodPost, noncePath(chainID, nonce)+"/cancel", url.Values{}, nil)
+}
+
+// Resync re-syncs the nonce of a chain from the chain.
+func (c *Client) Resync(ctx context.Context, chainID uint64) (res ResyncResponse, err error) {
+	err = c.do(ctx, http.MethodPost, fmt.Sprintf("/submitter/chains/%d/resync", chainID), url.Values{}, &res)
+	return res, err
+}
+
+func noncesPath(chainID uint64) string {
+	return fmt.Sprintf("/submitter/chains/%d/nonces", chainID)
+}
+
+func noncePath(chainID, nonce uint64) string {
+	return noncesPath(chainID) + "/" + strconv.FormatUint(nonce, 10)
+}
+
+// do makes a request and decodes the response into res, if it's not nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, res interface{}) error {
+	if c.signer != nil {
+		query.Set(signerParam, c.signer.String())
+	}
+
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not make request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errRes struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(body, &errRes) == nil && errRes.Error != "" {
+			return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, errRes.Error)
+		}
+		return fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+
+	if res == nil {
+		return nil
+	}
+	err = json.Unmarshal(body, res)
+	if err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+var urlFlag = &cli.StringFlag{
+	Name:     "url",
+	Usage:    "url of the api the admin endpoints are mounted on",
+	Required: true,
+}
+
+var signerFlag = &cli.StringFlag{
+	Name:  "signer",
+	Usage: "address of the signer in the submitter's pool, defaults to the submitter's signer",
+}
+
+var chainIDFlag = &cli.Uint64Flag{
+	Name:     "chain-id",
+	Usage:    "chain id",
+	Required: true,
+}
+
+var nonceFlag = &cli.Uint64Flag{
+	Name:     "nonce",
+	Usage:    "nonce",
+	Required: true,
+}
+
+var gasPriceFlag = &cli.StringFlag{
+	Name:     "gas-price",
+	Usage:    "gas price in wei to bump to",
+	Required: true,
+}
+
+var stuckAfterFlag = &cli.DurationFlag{
+	Name:  "stuck-after",
+	Usage: "how long a nonce has to be pending before it's reported as stuck",
+	Value: DefaultStuckAfter,
+}
+
+var noncesCommand = &cli.Command{
+	Name:        "nonces",
+	Description: "list the pending, stuck and failed nonces of a chain",
+	Flags:       []cli.Flag{urlFlag, signerFlag, chainIDFlag, stuckAfterFlag},
+	Action: func(c *cli.Context) error {
+		client, err := clientFromFlags(c)
+		if err != nil {
+			return err
+		}
+
+		res, err := client.ListNonces(c.Context, c.Uint64(chainIDFlag.Name), c.Duration(stuckAfterFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not list nonces: %w", err)
+		}
+		return printJSON(res)
+	},
+}
+
+var historyCommand = &cli.Command{
+	Name:        "history",
+	Description: "show every attempt for a nonce",
+	Flags:       []cli.Flag{urlFlag, signerFlag, chainIDFlag, nonceFlag},
+	Action: func(c *cli.Context) error {
+		client, err := clientFromFlags(c)
+		if err != nil {
+			return err
+		}
+
+		res, err := client.NonceHistory(c.Context, c.Uint64(chainIDFlag.Name), c.Uint64(nonceFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not get nonce history: %w", err)
+		}
+		return printJSON(res)
+	},
+}
+
+var bumpCommand = &cli.Command{
+	Name:        "bump",
+	Description: "replace the pending tx for a nonce with a copy at the given gas price",
+	Flags:       []cli.Flag{urlFlag, signerFlag, chainIDFlag, nonceFlag, gasPriceFlag},
+	Action: func(c *cli.Context) error {
+		client, err := clientFromFlags(c)
+		if err != nil {
+			return err
+		}
+
+		gasPrice, ok := new(big.Int).SetString(c.String(gasPriceFlag.Name), 10)
+		if !ok {
+			return fmt.Errorf("invalid gas price: %s", c.String(gasPriceFlag.Name))
+		}
+
+		res, err := client.ForceBump(c.Context, c.Uint64(chainIDFlag.Name), c.Uint64(nonceFlag.Name), gasPrice)
+		if err != nil {
+			return fmt.Errorf("could not bump nonce: %w", err)
+		}
+		return printJSON(res)
+	},
+}
+
+var cancelCommand = &cli.Command{
+	Name:        "cancel",
+	Description: "cancel a nonce by replacing it with a zero value self-transfer",
+	Flags:       []cli.Flag{urlFlag, signerFlag, chainIDFlag, nonceFlag},
+	Action: func(c *cli.Context) error {
+		client, err := clientFromFlags(c)
+		if err != nil {
+			return err
+		}
+
+		err = client.Cancel(c.Context, c.Uint64(chainIDFlag.Name), c.Uint64(nonceFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not cancel nonce: %w", err)
+		}
+		fmt.Println("cancelled")
+		return nil
+	},
+}
+
+var resyncCommand = &cli.Command{
+	Name:        "resync",
+	Description: "re-sync the nonce of a chain from the chain",
+	Flags:       []cli.Flag{urlFlag, signerFlag, chainIDFlag},
+	Action: func(c *cli.Context) error {
+		client, err := clientFromFlags(c)
+		if err != nil {
+			return err
+		}
+
+		res, err := client.Resync(c.Context, c.Uint64(chainIDFlag.Name))
+		if err != nil {
+			return fmt.Errorf("could not resync nonce: %w", err)
+		}
+		return printJSON(res)
+	},
+}
+
+// Command is the cli for the admin api. It can be added to the commands of any service that mounts the api.
+var Command = &cli.Command{
+	Name:        "submitter",
+	Description: "manage the submitter's queues through the admin api",
+	Subcommands: []*cli.Command{noncesCommand, historyCommand, bumpCommand, cancelCommand, resyncCommand},
+}
+
+func clientFromFlags(c *cli.Context) (*Client, error) {
+	if c.String(signerFlag.Name) == "" {
+		return NewClient(c.String(urlFlag.Name), nil), nil
+	}
+
+	if !common.IsHexAddress(c.String(signerFlag.Name)) {
+		return nil, fmt.Errorf("invalid signer: %s", c.String(signerFlag.Name))
+	}
+	signer := common.HexToAddress(c.String(signerFlag.Name))
+	return NewClient(c.String(urlFlag.Name), &signer), nil
+}
+
+func printJSON(res interface{}) error {
+	out, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode response: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
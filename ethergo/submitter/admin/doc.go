@@ -0,0 +1,3 @@
+// Package admin provides an operator api for the submitter's queues that can be mounted on any gin engine, and a cli
+// that calls it.
+package admin
@@ -0,0 +1,221 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+const (
+	// NoncesEndpoint lists the pending, stuck and failed nonces of a chain.
+	NoncesEndpoint = "/submitter/chains/:chainID/nonces"
+	// NonceEndpoint returns the attempt history of a nonce.
+	NonceEndpoint = NoncesEndpoint + "/:nonce"
+	// BumpEndpoint bumps a nonce to the gas_price query parameter.
+	BumpEndpoint = NonceEndpoint + "/bump"
+	// CancelEndpoint cancels a nonce.
+	CancelEndpoint = NonceEndpoint + "/cancel"
+	// ResyncEndpoint re-syncs the nonce of a chain from the chain.
+	ResyncEndpoint = "/submitter/chains/:chainID/resync"
+)
+
+const (
+	// signerParam selects the signer in the submitter's pool. The default signer is used if it isn't set.
+	signerParam = "signer"
+	// stuckAfterParam is how long a nonce has to be pending before it's reported as stuck.
+	stuckAfterParam = "stuck_after"
+	// gasPriceParam is the gas price in wei to bump to.
+	gasPriceParam = "gas_price"
+)
+
+// DefaultStuckAfter is how long a nonce has to be pending before it's reported as stuck, if stuck_after isn't set.
+const DefaultStuckAfter = 5 * time.Minute
+
+// Handler serves the admin api of a submitter.
+type Handler struct {
+	submitter submitter.TransactionSubmitter
+}
+
+// NewHandler creates a new admin handler for the submitter.
+func NewHandler(txSubmitter submitter.TransactionSubmitter) *Handler {
+	return &Handler{
+		submitter: txSubmitter,
+	}
+}
+
+// Mount mounts the admin endpoints on the router. These endpoints can move funds, so the router should only be
+// reachable by operators.
+func (h *Handler) Mount(router gin.IRoutes) {
+	router.GET(NoncesEndpoint, h.ListNonces)
+	router.GET(NonceEndpoint, h.NonceHistory)
+	router.POST(BumpEndpoint, h.ForceBump)
+	router.POST(CancelEndpoint, h.Cancel)
+	router.POST(ResyncEndpoint, h.Resync)
+}
+
+// ListNonces lists the pending, stuck and failed nonces of a chain.
+func (h *Handler) ListNonces(c *gin.Context) {
+	txSubmitter, chainID, ok := h.parseChain(c)
+	if !ok {
+		return
+	}
+
+	stuckAfter := DefaultStuckAfter
+	if c.Query(stuckAfterParam) != "" {
+		var err error
+		stuckAfter, err = time.ParseDuration(c.Query(stuckAfterParam))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s: %v", stuckAfterParam, err)})
+			return
+		}
+	}
+
+	summaries, err := txSubmitter.ListNonces(c, chainID, stuckAfter)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	res := make([]NonceSummary, len(summaries))
+	for i, summary := range summaries {
+		res[i] = toNonceSummary(summary)
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// NonceHistory returns every attempt for a nonce, oldest first.
+func (h *Handler) NonceHistory(c *gin.Context) {
+	txSubmitter, chainID, nonce, ok := h.parseNonce(c)
+	if !ok {
+		return
+	}
+
+	attempts, err := txSubmitter.NonceHistory(c, chainID, nonce)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	res := make([]Attempt, len(attempts))
+	for i, attempt := range attempts {
+		res[i] = toAttempt(attempt)
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ForceBump replaces the pending tx for a nonce with a copy at the given gas price.
+func (h *Handler) ForceBump(c *gin.Context) {
+	txSubmitter, chainID, nonce, ok := h.parseNonce(c)
+	if !ok {
+		return
+	}
+
+	gasPrice, ok := new(big.Int).SetString(c.Query(gasPriceParam), 10)
+	if !ok || gasPrice.Sign() <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("must specify a positive %s in wei", gasPriceParam)})
+		return
+	}
+
+	txHash, err := txSubmitter.ForceBump(c, chainID, nonce, gasPrice)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, BumpResponse{TxHash: txHash.String()})
+}
+
+// Cancel cancels a nonce.
+func (h *Handler) Cancel(c *gin.Context) {
+	txSubmitter, chainID, nonce, ok := h.parseNonce(c)
+	if !ok {
+		return
+	}
+
+	err := txSubmitter.CancelTransaction(c, chainID, nonce)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Resync re-syncs the nonce of a chain from the chain.
+func (h *Handler) Resync(c *gin.Context) {
+	txSubmitter, chainID, ok := h.parseChain(c)
+	if !ok {
+		return
+	}
+
+	nonceSync, err := txSubmitter.ResyncNonce(c, chainID)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, ResyncResponse{
+		OnChainNonce: nonceSync.OnChainNonce,
+		NextNonce:    nonceSync.NextNonce,
+	})
+}
+
+// parseChain gets the submitter for the signer param and the chain id from the path.
+// If it returns false, an error has already been written.
+func (h *Handler) parseChain(c *gin.Context) (_ submitter.TransactionSubmitter, chainID *big.Int, ok bool) {
+	chainID, ok = new(big.Int).SetString(c.Param("chainID"), 10)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid chain id: %s", c.Param("chainID"))})
+		return nil, nil, false
+	}
+
+	if c.Query(signerParam) == "" {
+		return h.submitter, chainID, true
+	}
+
+	if !common.IsHexAddress(c.Query(signerParam)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid signer: %s", c.Query(signerParam))})
+		return nil, nil, false
+	}
+
+	txSubmitter, err := h.submitter.ForSigner(common.HexToAddress(c.Query(signerParam)))
+	if err != nil {
+		writeError(c, err)
+		return nil, nil, false
+	}
+	return txSubmitter, chainID, true
+}
+
+// parseNonce is parseChain, plus the nonce from the path.
+func (h *Handler) parseNonce(c *gin.Context) (_ submitter.TransactionSubmitter, chainID *big.Int, nonce uint64, ok bool) {
+	txSubmitter, chainID, ok := h.parseChain(c)
+	if !ok {
+		return nil, nil, 0, false
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid nonce: %s", c.Param("nonce"))})
+		return nil, nil, 0, false
+	}
+	return txSubmitter, chainID, nonce, true
+}
+
+// writeError writes err with the status code for the submitter error it wraps.
+func writeError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, submitter.ErrUnknownSigner), errors.Is(err, db.ErrNonceNotExist):
+		status = http.StatusNotFound
+	case errors.Is(err, submitter.ErrNotPending), errors.Is(err, submitter.ErrNotCancellable):
+		status = http.StatusConflict
+	case errors.Is(err, submitter.ErrPriceTooLow), errors.Is(err, submitter.ErrPriceTooHigh), errors.Is(err, submitter.ErrMaxCostExceeded):
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, gin.H{"error": err.Error()})
+}
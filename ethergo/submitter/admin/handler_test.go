@@ -0,0 +1,151 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/admin"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+// fakeSubmitter implements the admin methods of the submitter. Calling any other method panics.
+type fakeSubmitter struct {
+	submitter.TransactionSubmitter
+	signer    common.Address
+	other     *fakeSubmitter
+	nonces    []submitter.NonceSummary
+	history   []db.TX
+	cancelled []uint64
+}
+
+func (f *fakeSubmitter) ListNonces(_ context.Context, _ *big.Int, _ time.Duration) ([]submitter.NonceSummary, error) {
+	return f.nonces, nil
+}
+
+func (f *fakeSubmitter) NonceHistory(_ context.Context, chainID *big.Int, nonce uint64) ([]db.TX, error) {
+	if len(f.history) == 0 {
+		return nil, fmt.Errorf("could not get history of nonce %d on chain %s: %w", nonce, chainID, db.ErrNonceNotExist)
+	}
+	return f.history, nil
+}
+
+func (f *fakeSubmitter) ForceBump(_ context.Context, _ *big.Int, _ uint64, gasPrice *big.Int) (common.Hash, error) {
+	if gasPrice.Cmp(big.NewInt(10)) <= 0 {
+		return common.Hash{}, submitter.ErrPriceTooLow
+	}
+	if gasPrice.Cmp(big.NewInt(1000)) > 0 {
+		return common.Hash{}, submitter.ErrPriceTooHigh
+	}
+	return common.BigToHash(gasPrice), nil
+}
+
+func (f *fakeSubmitter) CancelTransaction(_ context.Context, _ *big.Int, nonce uint64) error {
+	if nonce == 0 {
+		return submitter.ErrNotCancellable
+	}
+	f.cancelled = append(f.cancelled, nonce)
+	return nil
+}
+
+func (f *fakeSubmitter) ResyncNonce(_ context.Context, _ *big.Int) (submitter.NonceSync, error) {
+	return submitter.NonceSync{OnChainNonce: 4, NextNonce: 6}, nil
+}
+
+func (f *fakeSubmitter) ForSigner(from common.Address) (submitter.TransactionSubmitter, error) {
+	if f.other != nil && from == f.other.signer {
+		return f.other, nil
+	}
+	return nil, submitter.ErrUnknownSigner
+}
+
+func newTestClient(t *testing.T, txSubmitter submitter.TransactionSubmitter, signer *common.Address) *admin.Client {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	admin.NewHandler(txSubmitter).Mount(engine)
+
+	server := httptest.NewServer(engine)
+	t.Cleanup(server.Close)
+
+	return admin.NewClient(server.URL, signer)
+}
+
+func TestAdminAPI(t *testing.T) {
+	ctx := context.Background()
+
+	pendingTX := db.NewTX(types.NewTx(&types.LegacyTx{Nonce: 5, GasPrice: big.NewInt(7)}), db.Submitted, "")
+	pendingTX.UnsafeSetCreationTime(time.Now())
+
+	otherSigner := common.HexToAddress("0x2")
+	txSubmitter := &fakeSubmitter{
+		signer: common.HexToAddress("0x1"),
+		nonces: []submitter.NonceSummary{{
+			ChainID:  big.NewInt(1),
+			Nonce:    5,
+			State:    submitter.NonceStuck,
+			Latest:   pendingTX,
+			Attempts: 3,
+			Age:      time.Hour,
+		}},
+		history: []db.TX{pendingTX},
+		other:   &fakeSubmitter{signer: otherSigner},
+	}
+	client := newTestClient(t, txSubmitter, nil)
+
+	nonces, err := client.ListNonces(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, []admin.NonceSummary{{
+		ChainID:  1,
+		Nonce:    5,
+		State:    "stuck",
+		Status:   "Submitted",
+		TxHash:   pendingTX.Hash().String(),
+		GasPrice: "7",
+		Attempts: 3,
+		Age:      "1h0m0s",
+	}}, nonces)
+
+	history, err := client.NonceHistory(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, pendingTX.Hash().String(), history[0].TxHash)
+	require.Equal(t, "7", history[0].GasFeeCap)
+
+	bumped, err := client.ForceBump(ctx, 1, 5, big.NewInt(11))
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(11)).String(), bumped.TxHash)
+
+	_, err = client.ForceBump(ctx, 1, 5, big.NewInt(9))
+	require.ErrorContains(t, err, "status 400")
+
+	_, err = client.ForceBump(ctx, 1, 5, big.NewInt(1001))
+	require.ErrorContains(t, err, "status 400")
+
+	require.NoError(t, client.Cancel(ctx, 1, 5))
+	require.Equal(t, []uint64{5}, txSubmitter.cancelled)
+	require.ErrorContains(t, client.Cancel(ctx, 1, 0), "status 409")
+
+	resync, err := client.Resync(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, admin.ResyncResponse{OnChainNonce: 4, NextNonce: 6}, resync)
+
+	// requests for another signer in the pool go to its submitter.
+	otherClient := newTestClient(t, txSubmitter, &otherSigner)
+	_, err = otherClient.NonceHistory(ctx, 1, 5)
+	require.ErrorContains(t, err, "status 404")
+
+	unknownSigner := common.HexToAddress("0x3")
+	unknownClient := newTestClient(t, txSubmitter, &unknownSigner)
+	_, err = unknownClient.ListNonces(ctx, 1, time.Minute)
+	require.ErrorContains(t, err, "status 404")
+}
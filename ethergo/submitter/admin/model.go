@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+// NonceSummary is the summary of a pending nonce.
+type NonceSummary struct {
+	ChainID  uint64 `json:"chain_id"`
+	Nonce    uint64 `json:"nonce"`
+	State    string `json:"state"`
+	Status   string `json:"status"`
+	TxHash   string `json:"tx_hash"`
+	GasPrice string `json:"gas_price"`
+	Attempts int    `json:"attempts"`
+	Age      string `json:"age"`
+}
+
+// Attempt is a single attempt to submit a nonce.
+type Attempt struct {
+	TxHash       string    `json:"tx_hash"`
+	Status       string    `json:"status"`
+	GasFeeCap    string    `json:"gas_fee_cap"`
+	GasTipCap    string    `json:"gas_tip_cap"`
+	GasLimit     uint64    `json:"gas_limit"`
+	GasUsed      uint64    `json:"gas_used"`
+	RevertReason string    `json:"revert_reason,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// BumpResponse is the response of the bump endpoint.
+type BumpResponse struct {
+	TxHash string `json:"tx_hash"`
+}
+
+// ResyncResponse is the response of the resync endpoint.
+type ResyncResponse struct {
+	OnChainNonce uint64 `json:"on_chain_nonce"`
+	NextNonce    uint64 `json:"next_nonce"`
+}
+
+func toNonceSummary(summary submitter.NonceSummary) NonceSummary {
+	return NonceSummary{
+		ChainID:  summary.ChainID.Uint64(),
+		Nonce:    summary.Nonce,
+		State:    string(summary.State),
+		Status:   summary.Latest.Status.String(),
+		TxHash:   summary.Latest.Hash().String(),
+		GasPrice: summary.Latest.GasFeeCap().String(),
+		Attempts: summary.Attempts,
+		Age:      summary.Age.Round(time.Second).String(),
+	}
+}
+
+func toAttempt(tx db.TX) Attempt {
+	return Attempt{
+		TxHash:       tx.Hash().String(),
+		Status:       tx.Status.String(),
+		GasFeeCap:    tx.GasFeeCap().String(),
+		GasTipCap:    tx.GasTipCap().String(),
+		GasLimit:     tx.Gas(),
+		GasUsed:      tx.GasUsed,
+		RevertReason: tx.RevertReason,
+		CreatedAt:    tx.CreationTime(),
+	}
+}
@@ -0,0 +1,193 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrNotCancellable is returned when a transaction can't be cancelled because it has already been mined or cancelled.
+var ErrNotCancellable = errors.New("transaction is not pending and cannot be cancelled")
+
+// pendingStatuses are the statuses of txes that are still being bumped and can be cancelled.
+var pendingStatuses = []db.Status{db.Pending, db.Stored, db.Submitted, db.FailedSubmit}
+
+// CancelTransaction cancels the pending transaction for the nonce by replacing it with a zero value self-transfer.
+//
+// All existing attempts for the nonce are marked as cancelled so they are no longer bumped, and the self-transfer is
+// bumped in their place until it is mined. Note: the original transaction may still be mined before the cancellation,
+// in which case GetSubmissionStatus will report it as confirmed.
+func (t *txSubmitterImpl) CancelTransaction(parentCtx context.Context, chainID *big.Int, nonce uint64) (err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.CancelTransaction", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.Int64("nonce", int64(nonce)),
+	))
+
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	// hold the queue lock so the chain queue can't bump the original while we replace it.
+	locker, err := t.queueMux.LockCtx(ctx, t.laneKey(chainID))
+	if err != nil {
+		return fmt.Errorf("could not lock queue: %w", err)
+	}
+	defer locker.Unlock()
+
+	attempts, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, append([]db.Status{db.Cancelled}, pendingStatuses...)...)
+	if err != nil {
+		return fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+	attempts, ok := t.cancellableAttempts(attempts)
+	if !ok {
+		return fmt.Errorf("could not cancel nonce %d on chain %s: %w", nonce, chainID, ErrNotCancellable)
+	}
+
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return fmt.Errorf("could not get client: %w", err)
+	}
+
+	onChainNonce, err := chainClient.NonceAt(ctx, t.signer.Address(), nil)
+	if err != nil {
+		return fmt.Errorf("could not get nonce: %w", err)
+	}
+	if onChainNonce > nonce {
+		return fmt.Errorf("could not cancel nonce %d on chain %s, already mined: %w", nonce, chainID, ErrNotCancellable)
+	}
+
+	cancelTx, err := t.replaceAttempts(ctx, chainID, attempts, "")
+	if err != nil {
+		return fmt.Errorf("could not replace nonce: %w", err)
+	}
+	span.SetAttributes(txToAttributes(cancelTx.Transaction, cancelTx.UUID)...)
+	t.publishStatus(ctx, chainID, nonce)
+
+	span.AddEvent("trigger reprocess")
+	t.triggerProcessQueue(ctx)
+
+	return nil
+}
+
+// cancellableAttempts returns the pending attempts to replace, or false if the nonce can't be cancelled because it has
+// no pending attempts or was already cancelled. A cancelled nonce is pending on its self-transfer, which must not be
+// replaced again.
+func (t *txSubmitterImpl) cancellableAttempts(attempts []db.TX) ([]db.TX, bool) {
+	pending := make([]db.TX, 0, len(attempts))
+	for _, attempt := range attempts {
+		if attempt.Status == db.Cancelled || isCancellation(attempt.Transaction, t.signer.Address()) {
+			return nil, false
+		}
+		pending = append(pending, attempt)
+	}
+	return pending, len(pending) > 0
+}
+
+// replaceAttempts marks the attempts for a nonce as cancelled and stores a self-transfer to replace them.
+// reason is stored as the revert reason of the attempts if they're replaced because they'd revert.
+// The caller must hold the queue lock for the chain.
+func (t *txSubmitterImpl) replaceAttempts(ctx context.Context, chainID *big.Int, attempts []db.TX, reason string) (db.TX, error) {
+	signedTx, err := t.buildCancelTx(ctx, chainID, highestPricedAttempt(attempts))
+	if err != nil {
+		return db.TX{}, fmt.Errorf("could not build cancellation: %w", err)
+	}
+	cancelTx := db.NewTX(signedTx, db.Stored, uuid.New().String())
+
+	for i := range attempts {
+		attempts[i].Status = db.Cancelled
+		attempts[i].RevertReason = reason
+	}
+
+	err = t.db.DBTransaction(ctx, func(ctx context.Context, svc db.Service) error {
+		err := svc.PutTXS(ctx, attempts...)
+		if err != nil {
+			return fmt.Errorf("could not mark txes cancelled: %w", err)
+		}
+
+		err = svc.PutTXS(ctx, cancelTx)
+		if err != nil {
+			return fmt.Errorf("could not store cancellation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.TX{}, fmt.Errorf("could not store cancellation: %w", err)
+	}
+
+	return cancelTx, nil
+}
+
+// buildCancelTx builds and signs a zero value self-transfer for the nonce of prevTx at a bumped gas price.
+func (t *txSubmitterImpl) buildCancelTx(ctx context.Context, chainID *big.Int, prevTx db.TX) (*types.Transaction, error) {
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get client: %w", err)
+	}
+
+	transactor, err := t.signer.GetTransactor(ctx, core.CopyBigInt(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("could not get transactor: %w", err)
+	}
+
+	err = t.setGasPrice(ctx, chainClient, transactor, chainID, prevTx.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("could not set gas price: %w", err)
+	}
+
+	to := t.signer.Address()
+
+	var tx *types.Transaction
+	if t.txTypeForChain(chainID) == types.DynamicFeeTxType {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   core.CopyBigInt(chainID),
+			Nonce:     prevTx.Nonce(),
+			GasTipCap: core.CopyBigInt(transactor.GasTipCap),
+			GasFeeCap: core.CopyBigInt(transactor.GasFeeCap),
+			Gas:       params.TxGas,
+			To:        &to,
+			Value:     big.NewInt(0),
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    prevTx.Nonce(),
+			GasPrice: core.CopyBigInt(transactor.GasPrice),
+			Gas:      params.TxGas,
+			To:       &to,
+			Value:    big.NewInt(0),
+		})
+	}
+
+	tx, err = transactor.Signer(transactor.From, tx)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign tx: %w", err)
+	}
+	return tx, nil
+}
+
+// highestPricedAttempt returns the attempt with the highest fee cap, which the cancellation has to be bumped from
+// to replace it in the mempool. For legacy txes, the fee cap is the gas price.
+func highestPricedAttempt(attempts []db.TX) db.TX {
+	highest := attempts[0]
+	for _, attempt := range attempts[1:] {
+		if attempt.GasFeeCap().Cmp(highest.GasFeeCap()) > 0 {
+			highest = attempt
+		}
+	}
+	return highest
+}
+
+// isCancellation returns true if the tx is a zero value self-transfer from the signer, as sent by CancelTransaction.
+func isCancellation(tx *types.Transaction, signer common.Address) bool {
+	return tx.To() != nil && *tx.To() == signer && tx.Value().Sign() == 0 && len(tx.Data()) == 0
+}
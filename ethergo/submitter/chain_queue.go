@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
@@ -11,7 +12,6 @@
 	"github.com/synapsecns/sanguine/ethergo/util"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/lmittmann/w3/module/eth"
 	"github.com/lmittmann/w3/w3types"
 	"github.com/synapsecns/sanguine/core"
@@ -54,6 +54,13 @@ func (t *txSubmitterImpl) chainPendingQueue(parentCtx context.Context, chainID *
 		metrics.EndSpanWithErr(span, err)
 	}()
 
+	// hold the queue lock so a cancellation can't replace a tx while we're bumping it.
+	locker, err := t.queueMux.LockCtx(ctx, t.laneKey(chainID))
+	if err != nil {
+		return fmt.Errorf("could not lock queue: %w", err)
+	}
+	defer locker.Unlock()
+
 	// chainClient is the client for the chain we're working on
 	chainClient, err := t.fetcher.GetClient(ctx, chainID)
 	if err != nil {
@@ -66,6 +73,9 @@ func (t *txSubmitterImpl) chainPendingQueue(parentCtx context.Context, chainID *
 	}
 	span.SetAttributes(attribute.Int("nonce", int(currentNonce)))
 
+	// hold back txes over the chain's throughput limits, they stay stored until earlier txes are confirmed.
+	txes = t.releaseTXS(ctx, chainID, currentNonce, txes)
+
 	g, gCtx := errgroup.WithContext(ctx)
 
 	cq := chainQueue{
@@ -146,6 +156,11 @@ func (c *chainQueue) storeAndSubmit(ctx context.Context, calls []w3types.Caller,
 		}
 	}()
 	wg.Wait()
+
+	// publish the bumped attempts and their submission.
+	for _, tx := range c.reprocessQueue {
+		c.publishStatus(ctx, c.chainID, tx.Nonce())
+	}
 }
 
 // nolint: cyclop
@@ -167,6 +182,17 @@ func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX) {
 			metrics.EndSpanWithErr(span, err)
 		}()
 
+		if c.config.GetSimulateBeforeSubmit(c.chainIDInt()) {
+			var revertErr *SimulationRevertError
+			if errors.As(c.simulate(ctx, c.client, tx), &revertErr) {
+				span.AddEvent("tx reverted in simulation", trace.WithAttributes(attribute.String("revert_reason", revertErr.Reason)))
+				// otherwise, keep bumping in case the revert depends on state that's about to change.
+				if c.config.GetSkipNonceOnRevert(c.chainIDInt()) {
+					return c.skipNonce(ctx, ogTx, revertErr)
+				}
+			}
+		}
+
 		newGasEstimate, err := c.getGasEstimate(ctx, c.client, c.chainIDInt(), tx)
 		if err != nil {
 			return fmt.Errorf("could not get gas estimate: %w", err)
@@ -182,33 +208,30 @@ func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX) {
 		transactor.GasLimit = newGasEstimate
 
 		err = c.setGasPrice(ctx, c.client, transactor, c.chainID, ogTx.Transaction)
+		// don't bump past the max cost, keep resubmitting the last attempt instead.
+		if errors.Is(err, ErrMaxCostExceeded) {
+			c.warnMaxCostExceeded(span, ogTx, err)
+			c.addToReprocessQueue(ogTx)
+			return nil
+		}
 		if err != nil {
 			return fmt.Errorf("could not set gas price: %w", err)
 		}
 
-		switch tx.Type() {
-		case types.LegacyTxType:
-			tx = types.NewTx(&types.LegacyTx{
-				Nonce:    tx.Nonce(),
-				GasPrice: transactor.GasPrice,
-				Gas:      transactor.GasLimit,
-				To:       tx.To(),
-				Value:    tx.Value(),
-				Data:     tx.Data(),
-			})
-		case types.DynamicFeeTxType:
-			tx = types.NewTx(&types.DynamicFeeTx{
-				ChainID:   tx.ChainId(),
-				Nonce:     tx.Nonce(),
-				GasTipCap: core.CopyBigInt(transactor.GasTipCap),
-				GasFeeCap: core.CopyBigInt(transactor.GasFeeCap),
-				Gas:       transactor.GasLimit,
-				To:        tx.To(),
-				Value:     tx.Value(),
-				Data:      tx.Data(),
-			})
-		default:
-			return fmt.Errorf("unknown tx type: %v", ogTx.Type())
+		tx, err = replacementTX(tx, transactor, tx.Type())
+		if err != nil {
+			return err
+		}
+
+		// the l1 fee is estimated from the final encoding, so check the cost again.
+		err = c.applyCostCeil(ctx, c.client, c.chainIDInt(), tx)
+		if errors.Is(err, ErrMaxCostExceeded) {
+			c.warnMaxCostExceeded(span, ogTx, err)
+			c.addToReprocessQueue(ogTx)
+			return nil
+		}
+		if err != nil {
+			span.AddEvent("could not apply cost ceil", trace.WithAttributes(attribute.String("error", err.Error())))
 		}
 
 		tx, err = transactor.Signer(transactor.From, tx)
@@ -229,6 +252,32 @@ func (c *chainQueue) bumpTX(parentCtx context.Context, ogTx db.TX) {
 	})
 }
 
+// warnMaxCostExceeded records that ogTx can't be bumped within the max total cost, so it'll be resubmitted as is.
+func (c *chainQueue) warnMaxCostExceeded(span trace.Span, ogTx db.TX, err error) {
+	logger.Warnf("not bumping tx %s (nonce %d) on chain %s past max total cost: %v", ogTx.Hash(), ogTx.Nonce(), c.chainID, err)
+	span.AddEvent("not bumping past max cost", trace.WithAttributes(attribute.String("error", err.Error())))
+}
+
+// skipNonce replaces the attempts for the nonce of tx with a self-transfer, so the txes after it aren't blocked by
+// a tx that would revert. The self-transfer is submitted with the rest of the queue.
+func (c *chainQueue) skipNonce(ctx context.Context, tx db.TX, revertErr *SimulationRevertError) error {
+	attempts, err := c.db.GetNonceAttemptsByStatus(ctx, c.signer.Address(), c.chainID, tx.Nonce(), pendingStatuses...)
+	if err != nil {
+		return fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+	if len(attempts) == 0 {
+		attempts = []db.TX{tx}
+	}
+
+	cancelTx, err := c.replaceAttempts(ctx, c.chainID, attempts, revertErr.Reason)
+	if err != nil {
+		return fmt.Errorf("could not skip nonce %d: %w", tx.Nonce(), err)
+	}
+
+	c.addToReprocessQueue(cancelTx)
+	return nil
+}
+
 // addToReprocessQueue adds a tx to the reprocess queue.
 func (c *chainQueue) addToReprocessQueue(tx db.TX) {
 	c.reprocessQueueMux.Lock()
@@ -10,6 +10,7 @@
 	"time"
 
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/synapsecns/sanguine/ethergo/chain/gas"
 )
 
 // Config contains configuration for the submitter.
@@ -20,6 +21,23 @@ type Config struct {
 	ChainConfig `yaml:",inline"`
 	// Chains overrides the global config for each chain
 	Chains map[int]ChainConfig `yaml:"chains"`
+	// Retention is the retention policy for the transaction history. By default, every attempt is kept forever.
+	Retention RetentionConfig `yaml:"retention"`
+}
+
+// RetentionConfig contains the retention policy for the transaction history. It applies to every chain.
+type RetentionConfig struct {
+	// KeepDays is the number of days to keep attempts for once their nonce has been mined.
+	// If this is zero, attempts are not pruned because of their age.
+	KeepDays int `yaml:"keep_days"`
+	// FinalAttemptOnly is whether or not to prune every attempt for a mined nonce except the one that was mined,
+	// regardless of age.
+	FinalAttemptOnly bool `yaml:"final_attempt_only"`
+	// ArchiveDir is the directory pruned attempts are written to as gzipped JSONL files before they are deleted.
+	// If this is empty, pruned attempts are not archived.
+	ArchiveDir string `yaml:"archive_dir"`
+	// PruneIntervalSeconds is the number of seconds to wait between prunes.
+	PruneIntervalSeconds int `yaml:"prune_interval_seconds"`
 }
 
 // ChainConfig contains configuration for a specific chain.
@@ -45,6 +63,27 @@ type ChainConfig struct {
 	DynamicGasEstimate bool `yaml:"dynamic_gas_estimate"`
 	// SupportsEIP1559 is whether or not this chain supports EIP1559
 	SupportsEIP1559 bool `yaml:"supports_eip_1559"`
+	// SimulateBeforeSubmit is whether or not to simulate transactions against the pending state before they are
+	// stored and before each bump. Transactions that revert in simulation are rejected instead of being submitted.
+	SimulateBeforeSubmit bool `yaml:"simulate_before_submit"`
+	// SkipNonceOnRevert is whether or not to replace a transaction that reverts in simulation before a bump with a
+	// self-transfer, so later transactions aren't blocked behind it. This is ignored if SimulateBeforeSubmit is disabled.
+	SkipNonceOnRevert bool `yaml:"skip_nonce_on_revert"`
+	// RollupType is the type of rollup the chain is (op_stack or arbitrum), used to include the L1 data fee in the
+	// cost of transactions. Leave empty for chains without an L1 data fee.
+	RollupType string `yaml:"rollup_type"`
+	// MaxTotalCost is the maximum cost of a transaction in wei, including the L1 data fee. Transactions over it
+	// are not submitted, and are not bumped past it. If this is nil, there is no limit.
+	MaxTotalCost *big.Int `yaml:"max_total_cost"`
+	// MaxInFlight is the maximum number of unconfirmed transactions that can be sent to the chain at once.
+	// Transactions over the limit stay stored until earlier ones are confirmed. If this is zero, there is no limit.
+	MaxInFlight int `yaml:"max_in_flight"`
+	// MaxTxPerMinute is the maximum number of new transactions sent to the chain per minute. Bumps of transactions that
+	// have already been sent don't count. If this is zero, there is no limit.
+	MaxTxPerMinute int `yaml:"max_tx_per_minute"`
+	// MaxPendingValue is the maximum total value in wei of unconfirmed transactions sent to the chain at once.
+	// The transaction at the on-chain nonce is always sent. If this is nil, there is no limit.
+	MaxPendingValue *big.Int `yaml:"max_pending_value"`
 }
 
 const (
@@ -60,6 +99,9 @@ type ChainConfig struct {
 
 	// DefaultGasEstimate is the default gas estimate to use for transactions.
 	DefaultGasEstimate = uint64(1200000)
+
+	// DefaultPruneIntervalSeconds is the default number of seconds to wait between prunes.
+	DefaultPruneIntervalSeconds = 3600
 )
 
 // DefaultMaxPrice is the default max price of a tx.
@@ -200,6 +242,102 @@ func (c *Config) SupportsEIP1559(chainID int) bool {
 	return c.ChainConfig.SupportsEIP1559
 }
 
+// GetSimulateBeforeSubmit returns whether or not to simulate transactions before they are submitted.
+func (c *Config) GetSimulateBeforeSubmit(chainID int) bool {
+	chainConfig, ok := c.Chains[chainID]
+	if ok {
+		return chainConfig.SimulateBeforeSubmit
+	}
+	return c.SimulateBeforeSubmit
+}
+
+// GetSkipNonceOnRevert returns whether or not to skip the nonce of a transaction that reverts in simulation.
+func (c *Config) GetSkipNonceOnRevert(chainID int) bool {
+	if !c.GetSimulateBeforeSubmit(chainID) {
+		return false
+	}
+
+	chainConfig, ok := c.Chains[chainID]
+	if ok {
+		return chainConfig.SkipNonceOnRevert
+	}
+	return c.SkipNonceOnRevert
+}
+
+// GetRollupType returns the type of rollup the chain is.
+func (c *Config) GetRollupType(chainID int) (gas.RollupType, error) {
+	rollupType := c.RollupType
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.RollupType != "" {
+		rollupType = chainConfig.RollupType
+	}
+
+	//nolint: wrapcheck
+	return gas.ParseRollupType(rollupType)
+}
+
+// GetMaxTotalCost returns the maximum cost of a transaction in wei, or nil if there is no limit.
+func (c *Config) GetMaxTotalCost(chainID int) *big.Int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.MaxTotalCost != nil {
+		return chainConfig.MaxTotalCost
+	}
+	return c.MaxTotalCost
+}
+
+// GetMaxInFlight returns the maximum number of unconfirmed transactions sent to the chain at once, or zero if there is no limit.
+func (c *Config) GetMaxInFlight(chainID int) int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.MaxInFlight != 0 {
+		return chainConfig.MaxInFlight
+	}
+	return c.MaxInFlight
+}
+
+// GetMaxTxPerMinute returns the maximum number of new transactions sent to the chain per minute, or zero if there is no limit.
+func (c *Config) GetMaxTxPerMinute(chainID int) int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.MaxTxPerMinute != 0 {
+		return chainConfig.MaxTxPerMinute
+	}
+	return c.MaxTxPerMinute
+}
+
+// GetMaxPendingValue returns the maximum total value of unconfirmed transactions sent to the chain at once, or nil if
+// there is no limit.
+func (c *Config) GetMaxPendingValue(chainID int) *big.Int {
+	chainConfig, ok := c.Chains[chainID]
+	if ok && chainConfig.MaxPendingValue != nil {
+		return chainConfig.MaxPendingValue
+	}
+	return c.MaxPendingValue
+}
+
+// GetRetentionMaxAge returns the age after which attempts for mined nonces are pruned, or zero if they are kept
+// regardless of age.
+func (c *Config) GetRetentionMaxAge() time.Duration {
+	return time.Duration(c.Retention.KeepDays) * 24 * time.Hour
+}
+
+// GetPruneFinalAttemptOnly returns whether or not to prune every attempt for a mined nonce except the one that was mined.
+func (c *Config) GetPruneFinalAttemptOnly() bool {
+	return c.Retention.FinalAttemptOnly
+}
+
+// GetArchiveDir returns the directory pruned attempts are archived to, or an empty string if they are not archived.
+func (c *Config) GetArchiveDir() string {
+	return c.Retention.ArchiveDir
+}
+
+// GetPruneInterval returns the interval between prunes.
+func (c *Config) GetPruneInterval() time.Duration {
+	pruneInterval := c.Retention.PruneIntervalSeconds
+	if pruneInterval <= 0 {
+		pruneInterval = DefaultPruneIntervalSeconds
+	}
+	return time.Duration(pruneInterval) * time.Second
+}
+
 // SetGlobalMaxGasPrice is a helper function that sets the global gas price.
 func (c *Config) SetGlobalMaxGasPrice(maxPrice *big.Int) {
 	c.MaxGasPrice = maxPrice
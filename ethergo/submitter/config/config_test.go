@@ -3,7 +3,9 @@
 import (
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/synapsecns/sanguine/ethergo/chain/gas"
 	"github.com/synapsecns/sanguine/ethergo/submitter/config"
 	"gopkg.in/yaml.v2"
 
@@ -62,7 +64,29 @@ func TestGlobalConfig(t *testing.T) {
 gas_estimate: 1000
 is_l2: true
 dynamic_gas_estimate: true
-supports_eip_1559: true`
+supports_eip_1559: true
+simulate_before_submit: true
+skip_nonce_on_revert: true
+max_total_cost: 1000000000000000
+max_in_flight: 16
+max_tx_per_minute: 60
+chains:
+  1:
+    simulate_before_submit: false
+    skip_nonce_on_revert: true
+    max_in_flight: 4
+    max_pending_value: 1000000000000000000
+  10:
+    rollup_type: op_stack
+    max_total_cost: 2000000000000000
+  42161:
+    rollup_type: arbitrum
+  5:
+    rollup_type: zk
+retention:
+  keep_days: 30
+  final_attempt_only: true
+  archive_dir: /var/archive`
 	var cfg config.Config
 	err := yaml.Unmarshal([]byte(cfgStr), &cfg)
 	assert.NoError(t, err)
@@ -72,4 +96,35 @@ func TestGlobalConfig(t *testing.T) {
 	assert.Equal(t, uint64(1000), cfg.GasEstimate)
 	assert.Equal(t, true, cfg.DynamicGasEstimate)
 	assert.Equal(t, true, cfg.SupportsEIP1559(0))
+	assert.Equal(t, true, cfg.GetSimulateBeforeSubmit(0))
+	assert.Equal(t, true, cfg.GetSkipNonceOnRevert(0))
+	assert.Equal(t, false, cfg.GetSimulateBeforeSubmit(1))
+	// skipping the nonce requires simulation
+	assert.Equal(t, false, cfg.GetSkipNonceOnRevert(1))
+
+	rollupType, err := cfg.GetRollupType(10)
+	assert.NoError(t, err)
+	assert.Equal(t, gas.OPStack, rollupType)
+	rollupType, err = cfg.GetRollupType(42161)
+	assert.NoError(t, err)
+	assert.Equal(t, gas.Arbitrum, rollupType)
+	rollupType, err = cfg.GetRollupType(1)
+	assert.NoError(t, err)
+	assert.Equal(t, gas.NotRollup, rollupType)
+	_, err = cfg.GetRollupType(5)
+	assert.Error(t, err)
+
+	assert.Equal(t, big.NewInt(2000000000000000), cfg.GetMaxTotalCost(10))
+	assert.Equal(t, big.NewInt(1000000000000000), cfg.GetMaxTotalCost(42161))
+
+	assert.Equal(t, 4, cfg.GetMaxInFlight(1))
+	assert.Equal(t, 16, cfg.GetMaxInFlight(10))
+	assert.Equal(t, 60, cfg.GetMaxTxPerMinute(1))
+	assert.Equal(t, big.NewInt(params.Ether), cfg.GetMaxPendingValue(1))
+	assert.Nil(t, cfg.GetMaxPendingValue(10))
+
+	assert.Equal(t, 30*24*time.Hour, cfg.GetRetentionMaxAge())
+	assert.Equal(t, true, cfg.GetPruneFinalAttemptOnly())
+	assert.Equal(t, "/var/archive", cfg.GetArchiveDir())
+	assert.Equal(t, config.DefaultPruneIntervalSeconds*time.Second, cfg.GetPruneInterval())
 }
@@ -5,6 +5,8 @@
 import (
 	"math/big"
 	"time"
+
+	"github.com/synapsecns/sanguine/ethergo/chain/gas"
 )
 
 // IConfig ...
@@ -31,6 +33,30 @@ type IConfig interface {
 	GetDynamicGasEstimate(chainID int) bool
 	// SupportsEIP1559 returns whether or not this chain supports EIP1559.
 	SupportsEIP1559(chainID int) bool
+	// GetSimulateBeforeSubmit returns whether or not to simulate transactions before they are submitted.
+	GetSimulateBeforeSubmit(chainID int) bool
+	// GetSkipNonceOnRevert returns whether or not to skip the nonce of a transaction that reverts in simulation.
+	GetSkipNonceOnRevert(chainID int) bool
+	// GetRollupType returns the type of rollup the chain is.
+	GetRollupType(chainID int) (gas.RollupType, error)
+	// GetMaxTotalCost returns the maximum cost of a transaction in wei, or nil if there is no limit.
+	GetMaxTotalCost(chainID int) *big.Int
+	// GetMaxInFlight returns the maximum number of unconfirmed transactions sent to the chain at once, or zero if there is no limit.
+	GetMaxInFlight(chainID int) int
+	// GetMaxTxPerMinute returns the maximum number of new transactions sent to the chain per minute, or zero if there is no limit.
+	GetMaxTxPerMinute(chainID int) int
+	// GetMaxPendingValue returns the maximum total value of unconfirmed transactions sent to the chain at once, or nil if
+	// there is no limit.
+	GetMaxPendingValue(chainID int) *big.Int
+	// GetRetentionMaxAge returns the age after which attempts for mined nonces are pruned, or zero if they are kept
+	// regardless of age.
+	GetRetentionMaxAge() time.Duration
+	// GetPruneFinalAttemptOnly returns whether or not to prune every attempt for a mined nonce except the one that was mined.
+	GetPruneFinalAttemptOnly() bool
+	// GetArchiveDir returns the directory pruned attempts are archived to, or an empty string if they are not archived.
+	GetArchiveDir() string
+	// GetPruneInterval returns the interval between prunes.
+	GetPruneInterval() time.Duration
 	// SetGlobalMaxGasPrice is a helper function that sets the global gas price.
 	SetGlobalMaxGasPrice(maxPrice *big.Int)
 	// SetMinGasPrice is a helper function that sets the base gas price.
@@ -18,6 +18,34 @@ type Service struct {
 	mock.Mock
 }
 
+// CountNoncesByStatus provides a mock function with given fields: ctx, fromAddress, chainID, matchStatuses
+func (_m *Service) CountNoncesByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, matchStatuses ...db.Status) (uint64, error) {
+	_va := make([]interface{}, len(matchStatuses))
+	for _i := range matchStatuses {
+		_va[_i] = matchStatuses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, fromAddress, chainID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, ...db.Status) uint64); ok {
+		r0 = rf(ctx, fromAddress, chainID, matchStatuses...)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, ...db.Status) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, matchStatuses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DBTransaction provides a mock function with given fields: ctx, f
 func (_m *Service) DBTransaction(ctx context.Context, f db.TransactionFunc) error {
 	ret := _m.Called(ctx, f)
@@ -32,6 +60,27 @@ func (_m *Service) DBTransaction(ctx context.Context, f db.TransactionFunc) erro
 	return r0
 }
 
+// DeleteTXS provides a mock function with given fields: ctx, txHashes
+func (_m *Service) DeleteTXS(ctx context.Context, txHashes ...common.Hash) error {
+	_va := make([]interface{}, len(txHashes))
+	for _i := range txHashes {
+		_va[_i] = txHashes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...common.Hash) error); ok {
+		r0 = rf(ctx, txHashes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllTXAttemptByStatus provides a mock function with given fields: ctx, fromAddress, chainID, matchStatuses
 func (_m *Service) GetAllTXAttemptByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, matchStatuses ...db.Status) ([]db.TX, error) {
 	_va := make([]interface{}, len(matchStatuses))
@@ -164,6 +213,29 @@ func (_m *Service) GetNonceStatus(ctx context.Context, fromAddress common.Addres
 	return r0, r1
 }
 
+// GetPrunableTXS provides a mock function with given fields: ctx, policy, limit
+func (_m *Service) GetPrunableTXS(ctx context.Context, policy db.RetentionPolicy, limit int) ([]db.TX, error) {
+	ret := _m.Called(ctx, policy, limit)
+
+	var r0 []db.TX
+	if rf, ok := ret.Get(0).(func(context.Context, db.RetentionPolicy, int) []db.TX); ok {
+		r0 = rf(ctx, policy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.TX)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, db.RetentionPolicy, int) error); ok {
+		r1 = rf(ctx, policy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTXS provides a mock function with given fields: ctx, fromAddress, chainID, statuses
 func (_m *Service) GetTXS(ctx context.Context, fromAddress common.Address, chainID *big.Int, statuses ...db.Status) ([]db.TX, error) {
 	_va := make([]interface{}, len(statuses))
@@ -194,6 +266,36 @@ func (_m *Service) GetTXS(ctx context.Context, fromAddress common.Address, chain
 	return r0, r1
 }
 
+// GetTXSWithLimit provides a mock function with given fields: ctx, fromAddress, chainID, limit, statuses
+func (_m *Service) GetTXSWithLimit(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit int, statuses ...db.Status) ([]db.TX, error) {
+	_va := make([]interface{}, len(statuses))
+	for _i := range statuses {
+		_va[_i] = statuses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, fromAddress, chainID, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []db.TX
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, int, ...db.Status) []db.TX); ok {
+		r0 = rf(ctx, fromAddress, chainID, limit, statuses...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.TX)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, int, ...db.Status) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, limit, statuses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkAllBeforeNonceReplacedOrConfirmed provides a mock function with given fields: ctx, signer, chainID, nonce
 func (_m *Service) MarkAllBeforeNonceReplacedOrConfirmed(ctx context.Context, signer common.Address, chainID *big.Int, nonce uint64) error {
 	ret := _m.Called(ctx, signer, chainID, nonce)
@@ -6,6 +6,7 @@
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/core/dbcommon"
@@ -23,6 +24,9 @@ type Service interface {
 	PutTXS(ctx context.Context, txs ...TX) error
 	// GetTXS gets all txs for a given address and chain id. If chain id is nil, it will get all txs for the address.
 	GetTXS(ctx context.Context, fromAddress common.Address, chainID *big.Int, statuses ...Status) (txs []TX, err error)
+	// GetTXSWithLimit gets the latest tx for up to limit of the lowest nonces with a tx in one of the given statuses.
+	// GetTXS is GetTXSWithLimit with the default limit.
+	GetTXSWithLimit(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit int, statuses ...Status) (txs []TX, err error)
 	// MarkAllBeforeNonceReplacedOrConfirmed marks all txs for a given chain id and address before a given nonce as replaced or confirmed.
 	// TODO: cleaner function name
 	MarkAllBeforeNonceReplacedOrConfirmed(ctx context.Context, signer common.Address, chainID *big.Int, nonce uint64) error
@@ -32,11 +36,43 @@ type Service interface {
 	// GetAllTXAttemptByStatus gets all txs for a given address and chain id with a given status.
 	GetAllTXAttemptByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, matchStatuses ...Status) (txs []TX, err error)
 	// GetNonceStatus returns the nonce status for a given nonce by aggregating all attempts and finding the highest status.
+	// Cancelled attempts are not ordered, the status is Cancelled only if the other attempts are still pending.
 	GetNonceStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64) (status Status, err error)
 	// GetNonceAttemptsByStatus gets all txs for a given address and chain id with a given status and nonce.
 	GetNonceAttemptsByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64, matchStatuses ...Status) (txs []TX, err error)
+	// CountNoncesByStatus counts the distinct nonces for a given address and chain id with a tx in one of the given
+	// statuses. If chain id is nil, it counts them on every chain. Unlike GetTXS, the count isn't capped per chain.
+	CountNoncesByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, matchStatuses ...Status) (count uint64, err error)
 	// GetChainIDsByStatus gets the distinct chain ids for a given address and status.
 	GetChainIDsByStatus(ctx context.Context, fromAddress common.Address, matchStatuses ...Status) (chainIDs []*big.Int, err error)
+	// GetPrunableTXS gets up to limit txs for any address and chain id that can be pruned under the retention policy, oldest first.
+	GetPrunableTXS(ctx context.Context, policy RetentionPolicy, limit int) (txs []TX, err error)
+	// DeleteTXS deletes the txs with the given hashes.
+	DeleteTXS(ctx context.Context, txHashes ...common.Hash) error
+}
+
+// RetentionPolicy decides which txs can be pruned. Only txs in a PrunableStatuses status can be pruned, so pending
+// txs and txs whose nonce is still being resolved are always kept. Txs at the latest nonce of an address on a chain
+// are always kept too, since GetNonceForChainID depends on them.
+type RetentionPolicy struct {
+	// MaxAge is the age after which txs are pruned. If this is zero, txs are not pruned because of their age.
+	MaxAge time.Duration
+	// FinalAttemptOnly is whether or not to prune replaced attempts regardless of age, keeping only the attempt that was mined.
+	FinalAttemptOnly bool
+}
+
+// IsEnabled returns true if the policy prunes any txs.
+func (r RetentionPolicy) IsEnabled() bool {
+	return r.MaxAge > 0 || r.FinalAttemptOnly
+}
+
+// prunableStatuses are the statuses of txs that are no longer bumped: ones whose nonce has been mined and resolved,
+// and ones replaced by a cancellation.
+var prunableStatuses = []Status{Replaced, Confirmed, Reverted, ConfirmedSuccess, Cancelled}
+
+// PrunableStatuses returns the statuses of txs that can be pruned by a retention policy.
+func PrunableStatuses() []Status {
+	return prunableStatuses
 }
 
 // TransactionFunc is a function that can be passed to DBTransaction.
@@ -52,6 +88,7 @@ type Service interface {
 // additionally, due to the GetMaxNoncestatus function, statuses are currently assumed to be in order.
 // if you need to modify this functionality, please update that function. to reflect that the highest status
 // isno longer the expected end status.
+// Cancelled is the exception: it is not part of the ordering and GetNonceStatus only returns it while the nonce is unresolved.
 const (
 	// Pending is the status of a tx that has not been processed yet.
 	Pending Status = iota + 1 // Pending
@@ -65,11 +102,38 @@ type Service interface {
 	ReplacedOrConfirmed // ReplacedOrConfirmed
 	// Replaced is the status of a tx that has been replaced by a new tx.
 	Replaced // Replaced
-	// Confirmed is the status of a tx that has been confirmed.
+	// Confirmed is the status of a tx that has been confirmed without its receipt status being checked.
+	// Txes confirmed since receipts are checked are ConfirmedSuccess or Reverted instead.
 	Confirmed // Confirmed
+	// Cancelled is the status of a tx that has been cancelled. It is no longer bumped and its nonce is being replaced by
+	// a zero value self-transfer. Once the nonce is mined, cancelled txes are marked ReplacedOrConfirmed and checked for
+	// confirmation like any other.
+	Cancelled // Cancelled
+	// Reverted is the status of a tx that has been confirmed, but reverted. The gas used and revert reason are stored on the tx.
+	Reverted // Reverted
+	// ConfirmedSuccess is the status of a tx that has been confirmed and executed successfully.
+	ConfirmedSuccess // ConfirmedSuccess
 )
 
-var allStatusTypes = []Status{Pending, Stored, Submitted, FailedSubmit, ReplacedOrConfirmed, Replaced, Confirmed}
+var allStatusTypes = []Status{Pending, Stored, Submitted, FailedSubmit, ReplacedOrConfirmed, Replaced, Confirmed, Cancelled, Reverted, ConfirmedSuccess}
+
+// confirmedStatuses are the statuses of txes that have been mined.
+var confirmedStatuses = []Status{Confirmed, Reverted, ConfirmedSuccess}
+
+// ConfirmedStatuses returns the statuses of txes that have been mined, whether or not they succeeded.
+func ConfirmedStatuses() []Status {
+	return confirmedStatuses
+}
+
+// IsConfirmed returns true if the tx has been mined, whether or not it succeeded.
+func (s Status) IsConfirmed() bool {
+	for _, status := range confirmedStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
 
 // AllStatusTypes returns all status types.
 // it is exported for testing purposes
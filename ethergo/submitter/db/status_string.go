@@ -15,11 +15,14 @@ func _() {
 	_ = x[ReplacedOrConfirmed-5]
 	_ = x[Replaced-6]
 	_ = x[Confirmed-7]
+	_ = x[Cancelled-8]
+	_ = x[Reverted-9]
+	_ = x[ConfirmedSuccess-10]
 }
 
-const _Status_name = "PendingStoredSubmittedFailedReplacedOrConfirmedReplacedConfirmed"
+const _Status_name = "PendingStoredSubmittedFailedReplacedOrConfirmedReplacedConfirmedCancelledRevertedConfirmedSuccess"
 
-var _Status_index = [...]uint8{0, 7, 13, 22, 28, 47, 55, 64}
+var _Status_index = [...]uint8{0, 7, 13, 22, 28, 47, 55, 64, 73, 81, 97}
 
 func (i Status) String() string {
 	i -= 1
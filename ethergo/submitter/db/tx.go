@@ -19,6 +19,10 @@ type TX struct {
 	creationTime time.Time
 	// Status is the status of the transaction
 	Status Status
+	// GasUsed is the gas used by the transaction. This is only set once the transaction is confirmed.
+	GasUsed uint64
+	// RevertReason is the decoded revert reason of the transaction. This is only set if the status is Reverted.
+	RevertReason string
 }
 
 // NewTX creates a new TX for use in the db package.
@@ -20,6 +20,8 @@ func init() {
 	fromFieldName = namer.GetConsistentName("From")
 	idFieldName = namer.GetConsistentName("ID")
 	uuidFieldName = namer.GetConsistentName("UUID")
+	gasUsedFieldName = namer.GetConsistentName("GasUsed")
+	revertReasonFieldName = namer.GetConsistentName("RevertReason")
 }
 
 var (
@@ -39,6 +41,10 @@ func init() {
 	idFieldName string
 	// uuidFieldName is the field name of the uuid.
 	uuidFieldName string
+	// gasUsedFieldName is the field name of the gas used.
+	gasUsedFieldName string
+	// revertReasonFieldName is the field name of the revert reason.
+	revertReasonFieldName string
 )
 
 // ETHTX contains a raw evm transaction that is unsigned.
@@ -60,6 +66,10 @@ type ETHTX struct {
 	RawTx []byte `gorm:"column:raw_tx"`
 	// Status is the status of the transaction
 	Status db.Status `gorm:"column:status;index"`
+	// GasUsed is the gas used by the transaction once confirmed
+	GasUsed uint64 `gorm:"column:gas_used"`
+	// RevertReason is the decoded revert reason if the transaction reverted
+	RevertReason string `gorm:"column:revert_reason"`
 }
 
 // GetAllModels gets all models to migrate
@@ -0,0 +1,92 @@
+package txdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"gorm.io/gorm/clause"
+)
+
+// latestNonce is the latest nonce of an address on a chain.
+type latestNonce struct {
+	LatestFrom    string
+	LatestChainID uint64
+	LatestNonce   uint64
+}
+
+// GetPrunableTXS gets up to limit txs that can be pruned under the retention policy, oldest first.
+func (s *Store) GetPrunableTXS(ctx context.Context, policy db.RetentionPolicy, limit int) (txs []db.TX, err error) {
+	if !policy.IsEnabled() {
+		return nil, nil
+	}
+
+	var latestNonces []latestNonce
+	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).
+		Select("? as latest_from, ? as latest_chain_id, max(?) as latest_nonce",
+			clause.Column{Name: fromFieldName}, clause.Column{Name: chainIDFieldName}, clause.Column{Name: nonceFieldName}).
+		Clauses(clause.GroupBy{Columns: []clause.Column{{Name: fromFieldName}, {Name: chainIDFieldName}}}).
+		Scan(&latestNonces)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not get latest nonces: %w", dbTx.Error)
+	}
+
+	var conditions []string
+	var args []interface{}
+	if policy.MaxAge > 0 {
+		conditions = append(conditions, fmt.Sprintf("(%s IN ? AND %s < ?)", statusFieldName, createdAtFieldName))
+		args = append(args, statusToArgs(db.PrunableStatuses()...), time.Now().Add(-policy.MaxAge))
+	}
+	if policy.FinalAttemptOnly {
+		conditions = append(conditions, fmt.Sprintf("%s = ?", statusFieldName))
+		args = append(args, db.Replaced.Int())
+	}
+
+	query := s.DB().WithContext(ctx).Model(&ETHTX{}).
+		Where(fmt.Sprintf("(%s)", strings.Join(conditions, " OR ")), args...)
+
+	// never prune the latest nonce, GetNonceForChainID depends on it.
+	for _, latest := range latestNonces {
+		query = query.Where("NOT (? = ? AND ? = ? AND ? >= ?)",
+			clause.Column{Name: fromFieldName}, latest.LatestFrom,
+			clause.Column{Name: chainIDFieldName}, latest.LatestChainID,
+			clause.Column{Name: nonceFieldName}, latest.LatestNonce)
+	}
+
+	var dbTXs []ETHTX
+	dbTx = query.Order(fmt.Sprintf("%s asc", idFieldName)).Limit(limit).Find(&dbTXs)
+	if dbTx.Error != nil {
+		return nil, fmt.Errorf("could not get prunable txs: %w", dbTx.Error)
+	}
+
+	txs, err = convertTXS(dbTXs)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert txes: %w", err)
+	}
+
+	return txs, nil
+}
+
+// DeleteTXS deletes the txs with the given hashes.
+func (s *Store) DeleteTXS(ctx context.Context, txHashes ...common.Hash) error {
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	hashes := make([]string, len(txHashes))
+	for i, txHash := range txHashes {
+		hashes[i] = txHash.String()
+	}
+
+	dbTx := s.DB().WithContext(ctx).
+		Where(fmt.Sprintf("%s IN ?", txHashFieldName), hashes).
+		Delete(&ETHTX{})
+	if dbTx.Error != nil {
+		return fmt.Errorf("could not delete txs: %w", dbTx.Error)
+	}
+
+	return nil
+}
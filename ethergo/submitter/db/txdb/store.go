@@ -9,7 +9,6 @@
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/imkira/go-interpol"
 	errorHelper "github.com/pkg/errors"
 	"github.com/synapsecns/sanguine/core/dbcommon"
 	"github.com/synapsecns/sanguine/core/metrics"
@@ -52,7 +51,7 @@ func (s *Store) MarkAllBeforeNonceReplacedOrConfirmed(ctx context.Context, signe
 	dbTX := s.db.WithContext(ctx).Model(&ETHTX{}).
 		Where(fmt.Sprintf("%s = ?", chainIDFieldName), chainID.Uint64()).
 		Where(fmt.Sprintf("%s < ?", nonceFieldName), nonce).
-		Where(fmt.Sprintf("`%s` = ?", fromFieldName), signer.String()).
+		Where(clause.Eq{Column: clause.Column{Name: fromFieldName}, Value: signer.String()}).
 		// just in case we're updating a tx already marked as confirmed
 		Updates(map[string]interface{}{statusFieldName: db.ReplacedOrConfirmed.Int()})
 
@@ -63,12 +62,14 @@ func (s *Store) MarkAllBeforeNonceReplacedOrConfirmed(ctx context.Context, signe
 	return nil
 }
 
-// MaxResultsPerChain is the maximum number of transactions to return per chain id.
-// it is exported for testing.
-// TODO: this should be an option passed to the GetTXs function.
+// MaxResultsPerChain is the maximum number of transactions GetTXS returns per chain id.
+// it is exported for testing. Use GetTXSWithLimit to get more.
 // TODO: temporarily reduced from 50 to 1 to increase resiliency.
 const MaxResultsPerChain = 1
 
+// subqueryAlias is the alias of the subquery GetTXS and GetAllTXAttemptByStatus join against.
+const subqueryAlias = "subquery"
+
 func statusToArgs(matchStatuses ...db.Status) []int {
 	inArgs := make([]int, len(matchStatuses))
 	for i := range matchStatuses {
@@ -87,8 +88,10 @@ func convertTXS(ethTxes []ETHTX) (txs []db.TX, err error) {
 		}
 
 		retTX := db.TX{
-			Transaction: &marshalledTx,
-			Status:      dbTX.Status,
+			Transaction:  &marshalledTx,
+			Status:       dbTX.Status,
+			GasUsed:      dbTX.GasUsed,
+			RevertReason: dbTX.RevertReason,
 		}
 
 		// this is fine since we're an implementing db package
@@ -100,9 +103,15 @@ func convertTXS(ethTxes []ETHTX) (txs []db.TX, err error) {
 }
 
 // GetTXS returns all transactions for a given address on a given (or any) chain id that match a given status.
-// there is a limit of 50 transactions per chain id. The limit does not make any guarantees about the number of nonces per chain.
+// there is a limit of MaxResultsPerChain transactions per chain id. The limit does not make any guarantees about the number of nonces per chain.
 // the submitter will get only the most recent tx submitted for each chain so this can be used for gas pricing.
 func (s *Store) GetTXS(ctx context.Context, fromAddress common.Address, chainID *big.Int, matchStatuses ...db.Status) (txs []db.TX, err error) {
+	return s.GetTXSWithLimit(ctx, fromAddress, chainID, MaxResultsPerChain, matchStatuses...)
+}
+
+// GetTXSWithLimit returns the latest tx for up to limit of the lowest nonces on a given (or any) chain id that match a
+// given status. Like GetTXS, the limit is for the whole query, so it's only per chain if a chain id is given.
+func (s *Store) GetTXSWithLimit(ctx context.Context, fromAddress common.Address, chainID *big.Int, limit int, matchStatuses ...db.Status) (txs []db.TX, err error) {
 	var dbTXs []ETHTX
 
 	inArgs := statusToArgs(matchStatuses...)
@@ -126,23 +135,14 @@ func (s *Store) GetTXS(ctx context.Context, fromAddress common.Address, chainID
 		Where(fmt.Sprintf("%s IN ?", statusFieldName), inArgs).
 		Group(fmt.Sprintf("%s, %s", nonceFieldName, chainIDFieldName)).
 		Order(fmt.Sprintf("%s asc", nonceFieldName)).
-		Limit(MaxResultsPerChain)
-
-	joinQuery, err := interpol.WithMap(
-		"INNER JOIN (?) as subquery on `{table}`.`{id}` = `subquery`.`{id}` AND `{table}`.`{chainID}` = `subquery`.`{chainID}`", map[string]string{
-			"table":   tableName,
-			"id":      idFieldName,
-			"chainID": chainIDFieldName,
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("could not build join query: %w", err)
-	}
+		Limit(limit)
 
 	tx := s.DB().WithContext(ctx).
 		Model(&ETHTX{}).
 		Where(query).
-		Joins(joinQuery, subQuery).
+		Joins("INNER JOIN (?) as subquery on ? = ? AND ? = ?", subQuery,
+			clause.Column{Table: tableName, Name: idFieldName}, clause.Column{Table: subqueryAlias, Name: idFieldName},
+			clause.Column{Table: tableName, Name: chainIDFieldName}, clause.Column{Table: subqueryAlias, Name: chainIDFieldName}).
 		Order(fmt.Sprintf("subquery.%s, subquery.%s, %s desc", chainIDFieldName, nonceFieldName, createdAtFieldName)).
 		Find(&dbTXs)
 
@@ -184,21 +184,12 @@ func (s *Store) GetAllTXAttemptByStatus(ctx context.Context, fromAddress common.
 		Limit(MaxResultsPerChain)
 
 	// one consequence of innerjoining on nonce is we can't cap the max results for the whole query. This is a known limitation
-	joinQuery, err := interpol.WithMap(
-		"INNER JOIN (?) as subquery on `{table}`.`{nonce}` = `subquery`.`{nonce}` AND `{table}`.`{chainID}` = `subquery`.`{chainID}`", map[string]string{
-			"table":   tableName,
-			"nonce":   nonceFieldName,
-			"chainID": chainIDFieldName,
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("could not build join query: %w", err)
-	}
-
 	tx := s.DB().WithContext(ctx).
 		Model(&ETHTX{}).
 		Where(query).
-		Joins(joinQuery, subQuery).
+		Joins("INNER JOIN (?) as subquery on ? = ? AND ? = ?", subQuery,
+			clause.Column{Table: tableName, Name: nonceFieldName}, clause.Column{Table: subqueryAlias, Name: nonceFieldName},
+			clause.Column{Table: tableName, Name: chainIDFieldName}, clause.Column{Table: subqueryAlias, Name: chainIDFieldName}).
 		Order(fmt.Sprintf("subquery.%s, subquery.%s, %s desc", chainIDFieldName, nonceFieldName, createdAtFieldName)).
 		Find(&dbTXs)
 
@@ -218,9 +209,7 @@ func (s *Store) GetAllTXAttemptByStatus(ctx context.Context, fromAddress common.
 func (s *Store) GetNonceForChainID(ctx context.Context, fromAddress common.Address, chainID *big.Int) (nonce uint64, err error) {
 	var newNonce sql.NullInt64
 
-	selectMaxNonce := fmt.Sprintf("max(`%s`)", nonceFieldName)
-
-	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).Select(selectMaxNonce).Where(ETHTX{
+	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).Select("max(?)", clause.Column{Name: nonceFieldName}).Where(ETHTX{
 		From:    fromAddress.String(),
 		ChainID: chainID.Uint64(),
 	}).Scan(&newNonce)
@@ -282,12 +271,14 @@ func (s Store) PutTXS(ctx context.Context, txs ...db.TX) error {
 		}
 
 		toInsert = append(toInsert, &ETHTX{
-			From:    msg.From.String(),
-			ChainID: tx.ChainId().Uint64(),
-			Nonce:   tx.Nonce(),
-			RawTx:   marshalledTX,
-			TXHash:  tx.Hash().String(),
-			Status:  tx.Status,
+			From:         msg.From.String(),
+			ChainID:      tx.ChainId().Uint64(),
+			Nonce:        tx.Nonce(),
+			RawTx:        marshalledTX,
+			TXHash:       tx.Hash().String(),
+			Status:       tx.Status,
+			GasUsed:      tx.GasUsed,
+			RevertReason: tx.RevertReason,
 		})
 	}
 
@@ -296,6 +287,8 @@ func (s Store) PutTXS(ctx context.Context, txs ...db.TX) error {
 			Columns: []clause.Column{{Name: txHashFieldName}},
 			DoUpdates: clause.AssignmentColumns([]string{
 				statusFieldName,
+				gasUsedFieldName,
+				revertReasonFieldName,
 			}),
 		}).
 		Create(toInsert)
@@ -306,28 +299,38 @@ func (s Store) PutTXS(ctx context.Context, txs ...db.TX) error {
 	return nil
 }
 
-// GetNonceStatus gets the max nonce for the given address and chain id.
+// GetNonceStatus gets the highest status of the attempts for the given nonce. Cancelled attempts are left out of the
+// ordering: the nonce is only Cancelled while the rest of its attempts are still pending.
 func (s *Store) GetNonceStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, nonce uint64) (status db.Status, err error) {
-	var maxStatus sql.NullInt32
-
-	selectMaxStatus := fmt.Sprintf("max(`%s`)", statusFieldName)
+	var statuses struct {
+		MaxStatus sql.NullInt32
+		Cancelled int64
+	}
 
-	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).Select(selectMaxStatus).Where(ETHTX{
-		From:    fromAddress.String(),
-		ChainID: chainID.Uint64(),
-		Nonce:   nonce,
-	}).Scan(&maxStatus)
+	dbTx := s.DB().WithContext(ctx).Model(&ETHTX{}).
+		Select("max(CASE WHEN ? <> ? THEN ? END) as max_status, count(CASE WHEN ? = ? THEN 1 END) as cancelled",
+			clause.Column{Name: statusFieldName}, db.Cancelled.Int(), clause.Column{Name: statusFieldName},
+			clause.Column{Name: statusFieldName}, db.Cancelled.Int()).
+		Where(ETHTX{
+			From:    fromAddress.String(),
+			ChainID: chainID.Uint64(),
+			Nonce:   nonce,
+		}).Scan(&statuses)
 
 	if dbTx.Error != nil {
 		return 0, fmt.Errorf("could not get nonce for chain id: %w", dbTx.Error)
 	}
 
 	// if no nonces, return the corresponding error.
-	if !maxStatus.Valid {
+	if !statuses.MaxStatus.Valid && statuses.Cancelled == 0 {
 		return db.Status(0), errorHelper.Wrapf(db.ErrNonceNotExist, "nonce %d does not exist for chain %d", nonce, chainID.Uint64())
 	}
 
-	return db.Status(maxStatus.Int32), nil
+	maxStatus := db.Status(statuses.MaxStatus.Int32)
+	if statuses.Cancelled > 0 && maxStatus < db.ReplacedOrConfirmed {
+		return db.Cancelled, nil
+	}
+	return maxStatus, nil
 }
 
 // GetNonceAttemptsByStatus gets the nonce attempts by status.
@@ -354,6 +357,34 @@ func (s *Store) GetNonceAttemptsByStatus(ctx context.Context, fromAddress common
 	return txs, nil
 }
 
+// CountNoncesByStatus counts the distinct nonces for a given address and (or any) chain id with a tx in one of the
+// given statuses.
+func (s *Store) CountNoncesByStatus(ctx context.Context, fromAddress common.Address, chainID *big.Int, matchStatuses ...db.Status) (count uint64, err error) {
+	query := ETHTX{
+		From: fromAddress.String(),
+	}
+
+	if chainID != nil {
+		query.ChainID = chainID.Uint64()
+	}
+
+	// the same nonce can be used on several chains, so the distinct pairs are counted in a subquery.
+	subQuery := s.DB().Model(&ETHTX{}).
+		Distinct(chainIDFieldName, nonceFieldName).
+		Where(query).
+		Where(fmt.Sprintf("%s IN ?", statusFieldName), statusToArgs(matchStatuses...))
+
+	var nonceCount int64
+	tx := s.DB().WithContext(ctx).
+		Table("(?) as nonces", subQuery).
+		Count(&nonceCount)
+	if tx.Error != nil {
+		return 0, fmt.Errorf("could not count nonces: %w", tx.Error)
+	}
+
+	return uint64(nonceCount), nil
+}
+
 // GetChainIDsByStatus returns the distinct chain ids for a given address and status.
 func (s *Store) GetChainIDsByStatus(ctx context.Context, fromAddress common.Address, matchStatuses ...db.Status) (chainIDs []*big.Int, err error) {
 	chainIDs64 := []uint64{}
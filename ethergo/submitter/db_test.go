@@ -4,6 +4,7 @@
 	"errors"
 
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/google/uuid"
@@ -11,6 +12,10 @@
 	"github.com/synapsecns/sanguine/ethergo/backends/simulated"
 	"github.com/synapsecns/sanguine/ethergo/mocks"
 	"github.com/synapsecns/sanguine/ethergo/signer/nonce"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
 	"github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
 
@@ -112,6 +117,16 @@ func (t *TXSubmitterDBSuite) TestGetTransactionsWithLimitPerChainID() {
 					t.Require().Equal(result[i].GasPrice(), big.NewInt(1), testsuite.BigIntComparer())
 				}
 
+				// a larger limit gets the latest attempt for each of the lowest nonces.
+				const windowSize = 10
+				result, err = testDB.GetTXSWithLimit(t.GetTestContext(), mockAccount.Address, backend.GetBigChainID(), windowSize, db.Pending)
+				t.Require().NoError(err)
+				t.Require().Equal(windowSize, len(result))
+				for i, tx := range result {
+					t.Require().Equal(result[0].Nonce()+uint64(i), tx.Nonce())
+					t.Require().Equal(big.NewInt(1), tx.GasPrice(), testsuite.BigIntComparer())
+				}
+
 				// make sure this returns double the number of results, 2 per tx
 				// TODO: check nonces
 				result, err = testDB.GetAllTXAttemptByStatus(t.GetTestContext(), mockAccount.Address, backend.GetBigChainID(), db.Pending)
@@ -132,6 +147,11 @@ func (t *TXSubmitterDBSuite) TestGetNonceStatus() {
 		mockTx := mocks.MockTx(t.GetTestContext(), t.T(), simulatedBackend, acct, types.LegacyTxType)
 
 		for i, status := range db.AllStatusTypes() {
+			// cancelled is not part of the status ordering, see TestGetNonceStatusCancelled.
+			if status == db.Cancelled {
+				continue
+			}
+
 			copiedTX, err := util.CopyTX(mockTx, util.WithGasPrice(big.NewInt(int64(i))))
 			t.Require().NoError(err)
 
@@ -160,6 +180,75 @@ func (t *TXSubmitterDBSuite) TestGetNonceStatus() {
 	})
 }
 
+func (t *TXSubmitterDBSuite) TestGetNonceStatusCancelled() {
+	t.RunOnAllDBs(func(dbs db.Service) {
+		simulatedBackend := simulated.NewSimulatedBackend(t.GetTestContext(), t.T())
+		acct := simulatedBackend.GetFundedAccount(t.GetTestContext(), big.NewInt(params.Ether))
+		mockTx := mocks.MockTx(t.GetTestContext(), t.T(), simulatedBackend, acct, types.LegacyTxType)
+		chainID := simulatedBackend.GetBigChainID()
+
+		putAttempt := func(gasPrice int64, status db.Status) {
+			copiedTX, err := util.CopyTX(mockTx, util.WithGasPrice(big.NewInt(gasPrice)))
+			t.Require().NoError(err)
+
+			copiedTX, err = types.SignTx(copiedTX, simulatedBackend.Signer(), acct.PrivateKey)
+			t.Require().NoError(err)
+
+			err = dbs.PutTXS(t.GetTestContext(), db.NewTX(copiedTX, status, uuid.New().String()))
+			t.Require().NoError(err)
+		}
+
+		// the nonce is cancelled while its replacement is pending.
+		putAttempt(1, db.Cancelled)
+		putAttempt(2, db.Stored)
+		nonceStatus, err := dbs.GetNonceStatus(t.GetTestContext(), acct.Address, chainID, mockTx.Nonce())
+		t.Require().NoError(err)
+		t.Require().Equal(db.Cancelled, nonceStatus)
+
+		// once the nonce is mined, cancelled attempts are resolved with the rest.
+		err = dbs.MarkAllBeforeNonceReplacedOrConfirmed(t.GetTestContext(), acct.Address, chainID, mockTx.Nonce()+1)
+		t.Require().NoError(err)
+		cancelled, err := dbs.GetNonceAttemptsByStatus(t.GetTestContext(), acct.Address, chainID, mockTx.Nonce(), db.Cancelled)
+		t.Require().NoError(err)
+		t.Require().Empty(cancelled)
+		nonceStatus, err = dbs.GetNonceStatus(t.GetTestContext(), acct.Address, chainID, mockTx.Nonce())
+		t.Require().NoError(err)
+		t.Require().Equal(db.ReplacedOrConfirmed, nonceStatus)
+
+		// a confirmed attempt takes precedence over an attempt still marked cancelled.
+		putAttempt(1, db.Cancelled)
+		putAttempt(2, db.Confirmed)
+		nonceStatus, err = dbs.GetNonceStatus(t.GetTestContext(), acct.Address, chainID, mockTx.Nonce())
+		t.Require().NoError(err)
+		t.Require().Equal(db.Confirmed, nonceStatus)
+	})
+}
+
+func (t *TXSubmitterDBSuite) TestPutTXSReceiptFields() {
+	t.RunOnAllDBs(func(testDB db.Service) {
+		simulatedBackend := simulated.NewSimulatedBackend(t.GetTestContext(), t.T())
+		acct := simulatedBackend.GetFundedAccount(t.GetTestContext(), big.NewInt(params.Ether))
+		mockTx := mocks.MockTx(t.GetTestContext(), t.T(), simulatedBackend, acct, types.LegacyTxType)
+
+		err := testDB.PutTXS(t.GetTestContext(), db.NewTX(mockTx, db.Submitted, uuid.New().String()))
+		t.Require().NoError(err)
+
+		// confirming the tx updates the receipt fields.
+		reverted := db.NewTX(mockTx, db.Reverted, uuid.New().String())
+		reverted.GasUsed = params.TxGas
+		reverted.RevertReason = "not enough"
+		err = testDB.PutTXS(t.GetTestContext(), reverted)
+		t.Require().NoError(err)
+
+		txs, err := testDB.GetNonceAttemptsByStatus(t.GetTestContext(), acct.Address, simulatedBackend.GetBigChainID(), mockTx.Nonce(), db.ConfirmedStatuses()...)
+		t.Require().NoError(err)
+		t.Require().Len(txs, 1)
+		t.Require().Equal(db.Reverted, txs[0].Status)
+		t.Require().Equal(params.TxGas, txs[0].GasUsed)
+		t.Require().Equal("not enough", txs[0].RevertReason)
+	})
+}
+
 func (t *TXSubmitterDBSuite) TestGetChainIDsByStatus() {
 	t.RunOnAllDBs(func(testDB db.Service) {
 		chainIDToStatus := map[int64]db.Status{
@@ -205,3 +294,132 @@ func (t *TXSubmitterDBSuite) TestGetChainIDsByStatus() {
 		}
 	})
 }
+
+func (t *TXSubmitterDBSuite) TestCountNoncesByStatus() {
+	t.RunOnAllDBs(func(testDB db.Service) {
+		for _, mockAccount := range t.mockAccounts {
+			for _, backend := range t.testBackends {
+				for i := 0; i < 5; i++ {
+					// store two attempts per nonce so the count has to be distinct
+					for j := 0; j < 2; j++ {
+						legacyTx := &types.LegacyTx{
+							To:       &mockAccount.Address,
+							Value:    big.NewInt(0),
+							Nonce:    uint64(i),
+							GasPrice: big.NewInt(int64(j + 1)),
+						}
+						// sign directly, the nonce manager would assign a new nonce to every attempt
+						tx, err := types.SignTx(types.NewTx(legacyTx), backend.Signer(), mockAccount.PrivateKey)
+						t.Require().NoError(err)
+
+						status := db.Pending
+						if i == 4 {
+							status = db.ReplacedOrConfirmed
+						}
+						err = testDB.PutTXS(t.GetTestContext(), db.NewTX(tx, status, uuid.New().String()))
+						t.Require().NoError(err)
+					}
+				}
+			}
+
+			// nonces 0-3 are pending on every chain, nonce 4 isn't
+			count, err := testDB.CountNoncesByStatus(t.GetTestContext(), mockAccount.Address, nil, db.Pending, db.Stored)
+			t.Require().NoError(err)
+			t.Equal(uint64(4*len(t.testBackends)), count)
+
+			count, err = testDB.CountNoncesByStatus(t.GetTestContext(), mockAccount.Address, t.testBackends[0].GetBigChainID(), db.Pending)
+			t.Require().NoError(err)
+			t.Equal(uint64(4), count)
+
+			count, err = testDB.CountNoncesByStatus(t.GetTestContext(), mockAccount.Address, nil, db.FailedSubmit)
+			t.Require().NoError(err)
+			t.Zero(count)
+		}
+	})
+}
+
+func (t *TXSubmitterDBSuite) TestSignerPoolRouting() {
+	t.RunOnAllDBs(func(testDB db.Service) {
+		backend := t.testBackends[0]
+		chainID := backend.GetBigChainID()
+
+		signers := make([]signer.Signer, len(t.mockAccounts))
+		addresses := make([]common.Address, len(t.mockAccounts))
+		for i, account := range t.mockAccounts {
+			signers[i] = localsigner.NewSigner(account.PrivateKey)
+			addresses[i] = account.Address
+		}
+
+		newSubmitter := func(policy submitter.RoutingPolicy) submitter.TestTransactionSubmitter {
+			return submitter.NewTestTransactionSubmitter(t.metrics, signers[0], nil, testDB, &config.Config{}, submitter.WithSignerPool(policy, signers...))
+		}
+
+		// the first signer is only added once.
+		ts := newSubmitter(submitter.RoundRobin)
+		t.Require().Equal(addresses, ts.Signers())
+
+		// the submitter for each signer shares the pool.
+		lane, err := ts.ForSigner(addresses[1])
+		t.Require().NoError(err)
+		t.Require().Equal(addresses, lane.Signers())
+
+		_, err = ts.ForSigner(common.Address{})
+		t.Require().ErrorIs(err, submitter.ErrUnknownSigner)
+
+		// round robin cycles through the signers.
+		for i := 0; i < len(addresses)*2; i++ {
+			picked, err := ts.PickSigner(t.GetTestContext(), chainID, "")
+			t.Require().NoError(err)
+			t.Require().Equal(addresses[i%len(addresses)], picked)
+		}
+
+		// sticky picks the same signer for the same key.
+		ts = newSubmitter(submitter.Sticky)
+		first, err := ts.PickSigner(t.GetTestContext(), chainID, "relay-1")
+		t.Require().NoError(err)
+		for i := 0; i < 5; i++ {
+			picked, err := ts.PickSigner(t.GetTestContext(), chainID, "relay-1")
+			t.Require().NoError(err)
+			t.Require().Equal(first, picked)
+		}
+
+		// least pending picks the only signer without pending txes.
+		manager := t.managers[backend.GetChainID()]
+		for _, account := range t.mockAccounts[:len(t.mockAccounts)-1] {
+			tx, err := manager.SignTx(types.NewTx(&types.LegacyTx{
+				To:    &account.Address,
+				Value: big.NewInt(0),
+			}), backend.Signer(), account.PrivateKey)
+			t.Require().NoError(err)
+
+			err = testDB.PutTXS(t.GetTestContext(), db.NewTX(tx, db.Pending, uuid.New().String()))
+			t.Require().NoError(err)
+		}
+
+		ts = newSubmitter(submitter.LeastPending)
+		picked, err := ts.PickSigner(t.GetTestContext(), chainID, "")
+		t.Require().NoError(err)
+		t.Require().Equal(addresses[len(addresses)-1], picked)
+
+		// least pending compares the whole backlog, not just whether a signer has one.
+		backlogs := []uint64{4, 3, 5, 2, 6}
+		t.Require().Len(t.mockAccounts, len(backlogs))
+		for i, account := range t.mockAccounts {
+			for nonce := uint64(100); nonce < 100+backlogs[i]; nonce++ {
+				tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+					To:    &account.Address,
+					Value: big.NewInt(0),
+					Nonce: nonce,
+				}), backend.Signer(), account.PrivateKey)
+				t.Require().NoError(err)
+
+				err = testDB.PutTXS(t.GetTestContext(), db.NewTX(tx, db.Stored, uuid.New().String()))
+				t.Require().NoError(err)
+			}
+		}
+
+		picked, err = ts.PickSigner(t.GetTestContext(), chainID, "")
+		t.Require().NoError(err)
+		t.Require().Equal(addresses[3], picked)
+	})
+}
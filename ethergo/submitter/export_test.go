@@ -3,7 +3,9 @@
 import (
 	"context"
 	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -48,6 +50,21 @@ func GroupTxesByNonce(txs []db.TX) map[uint64][]db.TX {
 	return groupTxesByNonce(txs)
 }
 
+// GetRevertReason exports getRevertReason for testing.
+func GetRevertReason(ctx context.Context, chainClient client.EVM, tx *types.Transaction, blockNumber *big.Int, abis ...*abi.ABI) string {
+	return getRevertReason(ctx, chainClient, tx, blockNumber, abis...)
+}
+
+// DecodeRevertReason exports decodeRevertReason for testing.
+func DecodeRevertReason(err error, abis ...*abi.ABI) string {
+	return decodeRevertReason(err, abis...)
+}
+
+// NewSimulationRevertError exports newSimulationRevertError for testing.
+func NewSimulationRevertError(data []byte, abis ...*abi.ABI) *SimulationRevertError {
+	return newSimulationRevertError(data, abis)
+}
+
 const (
 	// HashAttr exports hashAttr for testing.
 	HashAttr = hashAttr
@@ -74,8 +91,8 @@ func GroupTxesByNonce(txs []db.TX) map[uint64][]db.TX {
 )
 
 // NewTestTransactionSubmitter wraps TestTransactionSubmitter in a TransactionSubmitter interface.
-func NewTestTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config *config.Config) TestTransactionSubmitter {
-	txSubmitter := NewTransactionSubmitter(metrics, signer, fetcher, db, config)
+func NewTestTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config *config.Config, opts ...Option) TestTransactionSubmitter {
+	txSubmitter := NewTransactionSubmitter(metrics, signer, fetcher, db, config, opts...)
 	//nolint: forcetypeassert
 	return txSubmitter.(TestTransactionSubmitter)
 }
@@ -92,6 +109,31 @@ type TestTransactionSubmitter interface {
 	GetNonce(parentCtx context.Context, chainID *big.Int, address common.Address) (_ uint64, err error)
 	// CheckAndSetConfirmation exports checkAndSetConfirmation for testing.
 	CheckAndSetConfirmation(ctx context.Context, chainClient client.EVM, txes []db.TX) error
+	// PickSigner exports the signer pool's routing for testing.
+	PickSigner(ctx context.Context, chainID *big.Int, key string) (common.Address, error)
+	// ReleaseTXS exports releaseTXS for testing.
+	ReleaseTXS(ctx context.Context, chainID *big.Int, currentNonce uint64, txes []db.TX) []db.TX
+	// ProcessQueue exports processQueue for testing.
+	ProcessQueue(ctx context.Context) error
+}
+
+// PickSigner exports the signer pool's routing for testing.
+func (t *txSubmitterImpl) PickSigner(ctx context.Context, chainID *big.Int, key string) (common.Address, error) {
+	lane, err := t.pool.pick(ctx, chainID, key)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return lane.signer.Address(), nil
+}
+
+// ReleaseTXS exports releaseTXS for testing.
+func (t *txSubmitterImpl) ReleaseTXS(ctx context.Context, chainID *big.Int, currentNonce uint64, txes []db.TX) []db.TX {
+	return t.releaseTXS(ctx, chainID, currentNonce, txes)
+}
+
+// ProcessQueue exports processQueue for testing.
+func (t *txSubmitterImpl) ProcessQueue(ctx context.Context) error {
+	return t.processQueue(ctx)
 }
 
 // SetGasPrice exports setGasPrice for testing.
@@ -114,3 +156,18 @@ func (t *txSubmitterImpl) GetNonce(parentCtx context.Context, chainID *big.Int,
 func (t *txSubmitterImpl) CheckAndSetConfirmation(ctx context.Context, chainClient client.EVM, txes []db.TX) error {
 	return t.checkAndSetConfirmation(ctx, chainClient, txes)
 }
+
+// SummarizeNonce exports summarizeNonce for testing.
+func SummarizeNonce(chainID *big.Int, nonce uint64, attempts []db.TX, stuckAfter time.Duration) (NonceSummary, bool) {
+	return summarizeNonce(chainID, nonce, attempts, stuckAfter)
+}
+
+// Prune prunes the transaction history of the store under the retention policy, archiving pruned txs to archiveDir
+// if it isn't empty.
+func Prune(ctx context.Context, handler metrics.Handler, store db.Service, policy db.RetentionPolicy, archiveDir string) (int, error) {
+	p, err := newPruner(handler, store, policy, archiveDir)
+	if err != nil {
+		return 0, err
+	}
+	return p.prune(ctx)
+}
@@ -0,0 +1,27 @@
+package submitter
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+)
+
+// Option is an option for the transaction submitter.
+type Option func(t *txSubmitterImpl)
+
+// WithRevertABIs sets the abis used to decode custom errors when a transaction reverts, either in simulation or
+// on chain. These should be the abis of the contracts the submitter calls.
+func WithRevertABIs(abis ...*abi.ABI) Option {
+	return func(t *txSubmitterImpl) {
+		t.revertABIs = append(t.revertABIs, abis...)
+	}
+}
+
+// WithSignerPool adds signers to the pool the submitter sends transactions from, alongside the signer passed to
+// NewTransactionSubmitter. Each signer gets its own nonces and chain queues, so a stuck transaction only blocks
+// the transactions sent from the same signer. RouteTransaction picks the signer with the policy.
+func WithSignerPool(policy RoutingPolicy, signers ...signer.Signer) Option {
+	return func(t *txSubmitterImpl) {
+		t.pool.policy = policy
+		t.pool.signers = append(t.pool.signers, signers...)
+	}
+}
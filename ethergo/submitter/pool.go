@@ -0,0 +1,183 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/observer"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RoutingPolicy decides which signer in the pool a routed transaction is sent from.
+//
+//go:generate go run golang.org/x/tools/cmd/stringer -type=RoutingPolicy -linecomment
+type RoutingPolicy uint8
+
+const (
+	// RoundRobin sends transactions from each signer in turn.
+	RoundRobin RoutingPolicy = iota // round_robin
+	// LeastPending sends transactions from the signer with the fewest pending transactions on the chain.
+	LeastPending // least_pending
+	// Sticky always sends transactions with the same routing key from the same signer, as long as the pool doesn't
+	// change. Transactions without a key are sent round robin.
+	Sticky // sticky
+)
+
+// ErrUnknownSigner is returned by ForSigner when the address isn't in the signer pool.
+var ErrUnknownSigner = errors.New("signer is not in the pool")
+
+// signerPool is the set of signers a submitter sends transactions from. Each signer has its own lane: a
+// txSubmitterImpl with its own nonces and chain queues.
+type signerPool struct {
+	// policy is the routing policy used by RouteTransaction.
+	policy RoutingPolicy
+	// signers are the signers passed to WithSignerPool.
+	signers []signer.Signer
+	// lanes are the submitters for each signer, starting with the signer passed to NewTransactionSubmitter.
+	lanes []*txSubmitterImpl
+	// next is the counter used for round robin routing.
+	next atomic.Uint64
+}
+
+// laneKey identifies the nonce lane of a signer on a chain.
+type laneKey struct {
+	signer  common.Address
+	chainID *big.Int
+}
+
+func (l laneKey) String() string {
+	return fmt.Sprintf("%s-%s", l.signer, l.chainID)
+}
+
+// laneKey returns the key for this submitter's lane on the chain.
+func (t *txSubmitterImpl) laneKey(chainID *big.Int) laneKey {
+	return laneKey{signer: t.signer.Address(), chainID: chainID}
+}
+
+// newLane creates the submitter for another signer in the pool. It shares everything with t except the signer,
+// its queue and its status subscriptions.
+func (t *txSubmitterImpl) newLane(laneSigner signer.Signer) *txSubmitterImpl {
+	return &txSubmitterImpl{
+		db:                t.db,
+		config:            t.config,
+		metrics:           t.metrics,
+		signer:            laneSigner,
+		fetcher:           t.fetcher,
+		nonceMux:          t.nonceMux,
+		statusMux:         t.statusMux,
+		queueMux:          t.queueMux,
+		retryNow:          make(chan bool, 1),
+		lastGasBlockCache: t.lastGasBlockCache,
+		statusObserver:    observer.NewObserver[statusKey, SubmissionStatus](),
+		watched:           make(map[statusKey]int),
+		revertABIs:        t.revertABIs,
+		pool:              t.pool,
+		throttler:         t.throttler,
+	}
+}
+
+// buildLanes creates a lane for each signer in the pool. Signers with the same address share a lane.
+func (t *txSubmitterImpl) buildLanes() {
+	t.pool.lanes = []*txSubmitterImpl{t}
+
+	seen := map[common.Address]bool{t.signer.Address(): true}
+	for _, poolSigner := range t.pool.signers {
+		if seen[poolSigner.Address()] {
+			continue
+		}
+		seen[poolSigner.Address()] = true
+
+		t.pool.lanes = append(t.pool.lanes, t.newLane(poolSigner))
+	}
+}
+
+// RouteTransaction submits a transaction from the signer picked by the routing policy.
+func (t *txSubmitterImpl) RouteTransaction(parentCtx context.Context, chainID *big.Int, key string, call ContractCallType) (from common.Address, nonce uint64, err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.RouteTransaction", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.String("key", key),
+		attribute.Stringer("policy", t.pool.policy),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	lane, err := t.pool.pick(ctx, chainID, key)
+	if err != nil {
+		return common.Address{}, 0, fmt.Errorf("could not pick signer: %w", err)
+	}
+
+	from = lane.signer.Address()
+	span.SetAttributes(attribute.String("signer", from.String()))
+
+	nonce, err = lane.SubmitTransaction(ctx, chainID, call)
+	if err != nil {
+		return common.Address{}, 0, fmt.Errorf("could not submit transaction from %s: %w", from, err)
+	}
+	return from, nonce, nil
+}
+
+// ForSigner returns the submitter for a signer in the pool.
+func (t *txSubmitterImpl) ForSigner(from common.Address) (TransactionSubmitter, error) {
+	for _, lane := range t.pool.lanes {
+		if lane.signer.Address() == from {
+			return lane, nil
+		}
+	}
+	return nil, fmt.Errorf("could not get submitter for %s: %w", from, ErrUnknownSigner)
+}
+
+// Signers returns the addresses of the signers in the pool.
+func (t *txSubmitterImpl) Signers() []common.Address {
+	addresses := make([]common.Address, len(t.pool.lanes))
+	for i, lane := range t.pool.lanes {
+		addresses[i] = lane.signer.Address()
+	}
+	return addresses
+}
+
+// pick picks the lane to send a transaction from.
+func (p *signerPool) pick(ctx context.Context, chainID *big.Int, key string) (*txSubmitterImpl, error) {
+	if len(p.lanes) == 1 {
+		return p.lanes[0], nil
+	}
+
+	switch {
+	case p.policy == LeastPending:
+		return p.leastPending(ctx, chainID)
+	case p.policy == Sticky && key != "":
+		h := fnv.New64a()
+		// fnv never returns an error.
+		_, _ = h.Write([]byte(key))
+		return p.lanes[h.Sum64()%uint64(len(p.lanes))], nil
+	default:
+		return p.lanes[(p.next.Add(1)-1)%uint64(len(p.lanes))], nil
+	}
+}
+
+// leastPending returns the lane with the fewest pending nonces on the chain. Ties go to the earliest lane.
+func (p *signerPool) leastPending(ctx context.Context, chainID *big.Int) (*txSubmitterImpl, error) {
+	var picked *txSubmitterImpl
+	var fewest uint64
+
+	for i, lane := range p.lanes {
+		pending, err := lane.db.CountNoncesByStatus(ctx, lane.signer.Address(), chainID, pendingStatuses...)
+		if err != nil {
+			return nil, fmt.Errorf("could not count pending nonces for %s: %w", lane.signer.Address(), err)
+		}
+
+		if i == 0 || pending < fewest {
+			picked = lane
+			fewest = pending
+		}
+	}
+	return picked, nil
+}
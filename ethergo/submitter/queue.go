@@ -79,7 +79,7 @@ func (t *txSubmitterImpl) processQueue(parentCtx context.Context) (err error) {
 			defer wg.Done()
 
 			// get all the pendingTxes in the queue
-			pendingTxes, err := t.db.GetTXS(ctx, t.signer.Address(), chainID, db.Stored, db.Pending, db.FailedSubmit, db.Submitted)
+			pendingTxes, err := t.getPendingTXS(ctx, chainID)
 			if err != nil {
 				span.AddEvent("could not get pendingTxes", trace.WithAttributes(
 					attribute.String("error", err.Error()), attribute.Int64("chainID", chainID.Int64()),
@@ -127,6 +127,8 @@ func (t *txSubmitterImpl) processConfirmedQueue(parentCtx context.Context) (err
 	}
 
 	wg.Wait()
+
+	t.publishStatuses(ctx)
 	return nil
 }
 
@@ -191,13 +193,13 @@ func (t *txSubmitterImpl) checkAndSetConfirmation(ctx context.Context, chainClie
 				txes[i].Status = db.Replaced
 			} else {
 				foundSuccessfulTX = true
-				txes[i].Status = db.Confirmed
+				t.setConfirmed(ctx, chainClient, &txes[i], &receipts[i])
 			}
 		}
 	} else if receipts[0].TxHash == txes[0].Hash() {
 		// there must be only one tx, so we can just check the first one
 		// TODO: handle the case where there is more than one
-		txes[0].Status = db.Confirmed
+		t.setConfirmed(ctx, chainClient, &txes[0], &receipts[0])
 		foundSuccessfulTX = true
 	}
 
@@ -210,3 +212,16 @@ func (t *txSubmitterImpl) checkAndSetConfirmation(ctx context.Context, chainClie
 
 	return nil
 }
+
+// setConfirmed sets the status of a mined tx from its receipt, along with the gas used and, if it reverted,
+// the revert reason.
+func (t *txSubmitterImpl) setConfirmed(ctx context.Context, chainClient client.EVM, tx *db.TX, receipt *types.Receipt) {
+	tx.GasUsed = receipt.GasUsed
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		tx.Status = db.ConfirmedSuccess
+		return
+	}
+
+	tx.Status = db.Reverted
+	tx.RevertReason = getRevertReason(ctx, chainClient, tx.Transaction, receipt.BlockNumber, t.revertABIs...)
+}
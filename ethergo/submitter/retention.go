@@ -0,0 +1,276 @@
+package submitter
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"github.com/synapsecns/sanguine/ethergo/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	pruneMeterName         = "github.com/synapsecns/sanguine/ethergo/submitter"
+	prunedTXSCounterName   = "submitter_pruned_txs"
+	archivedTXSCounterName = "submitter_archived_txs"
+	pruneDurationName      = "submitter_prune_duration_ms"
+)
+
+// pruneBatchSize is the number of txs pruned in each db transaction.
+const pruneBatchSize = 1000
+
+// ArchivedTX is a pruned tx as it is written to the archive, one per line.
+type ArchivedTX struct {
+	TXHash       string        `json:"tx_hash"`
+	From         string        `json:"from"`
+	ChainID      uint64        `json:"chain_id"`
+	Nonce        uint64        `json:"nonce"`
+	Status       string        `json:"status"`
+	GasUsed      uint64        `json:"gas_used"`
+	RevertReason string        `json:"revert_reason,omitempty"`
+	CreatedAt    time.Time     `json:"created_at"`
+	RawTX        hexutil.Bytes `json:"raw_tx"`
+}
+
+// pruner prunes the transaction history under the retention policy, archiving pruned txs first if configured.
+// The db is shared by every signer in the pool, so there is one pruner per submitter.
+type pruner struct {
+	db         db.Service
+	metrics    metrics.Handler
+	policy     db.RetentionPolicy
+	archiveDir string
+	// pruned counts pruned txs.
+	pruned metric.Int64Counter
+	// archived counts archived txs.
+	archived metric.Int64Counter
+	// duration records how long each prune takes.
+	duration metric.Int64Histogram
+}
+
+// newPruner creates a new pruner.
+func newPruner(handler metrics.Handler, store db.Service, policy db.RetentionPolicy, archiveDir string) (_ *pruner, err error) {
+	p := &pruner{
+		db:         store,
+		metrics:    handler,
+		policy:     policy,
+		archiveDir: archiveDir,
+	}
+
+	meter := handler.Meter(pruneMeterName)
+	p.pruned, err = meter.Int64Counter(prunedTXSCounterName, metric.WithDescription("txs pruned from the submitter db"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+	p.archived, err = meter.Int64Counter(archivedTXSCounterName, metric.WithDescription("pruned txs written to the archive"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create counter: %w", err)
+	}
+	p.duration, err = meter.Int64Histogram(pruneDurationName, metric.WithDescription("duration of a prune"), metric.WithUnit("ms"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create histogram: %w", err)
+	}
+
+	return p, nil
+}
+
+// runPruner prunes the transaction history on the configured interval until the context is cancelled.
+func (t *txSubmitterImpl) runPruner(ctx context.Context) error {
+	p, err := newPruner(t.metrics, t.db, t.retentionPolicy(), t.config.GetArchiveDir())
+	if err != nil {
+		return fmt.Errorf("could not create pruner: %w", err)
+	}
+
+	for {
+		_, err = p.prune(ctx)
+		if err != nil {
+			logger.Warn(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(t.config.GetPruneInterval()):
+		}
+	}
+}
+
+// retentionPolicy returns the retention policy from the config.
+func (t *txSubmitterImpl) retentionPolicy() db.RetentionPolicy {
+	return db.RetentionPolicy{
+		MaxAge:           t.config.GetRetentionMaxAge(),
+		FinalAttemptOnly: t.config.GetPruneFinalAttemptOnly(),
+	}
+}
+
+// prune prunes every tx allowed by the retention policy in batches, and returns the number of txs pruned.
+// Each batch is archived before it's deleted, in the same db transaction, so a tx may be archived twice if the delete
+// fails but is never deleted without being archived.
+func (p *pruner) prune(parentCtx context.Context) (pruned int, err error) {
+	ctx, span := p.metrics.Tracer().Start(parentCtx, "submitter.Prune", trace.WithAttributes(
+		attribute.Int64("max_age_seconds", int64(p.policy.MaxAge.Seconds())),
+		attribute.Bool("final_attempt_only", p.policy.FinalAttemptOnly),
+	))
+	startTime := time.Now()
+
+	defer func() {
+		span.SetAttributes(attribute.Int("pruned", pruned))
+		p.duration.Record(ctx, time.Since(startTime).Milliseconds())
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	var archive *archiveWriter
+	if p.archiveDir != "" {
+		archive, err = newArchiveWriter(p.archiveDir, startTime)
+		if err != nil {
+			return 0, fmt.Errorf("could not open archive: %w", err)
+		}
+		defer func() {
+			closeErr := archive.close(pruned == 0)
+			if err == nil && closeErr != nil {
+				err = fmt.Errorf("could not close archive: %w", closeErr)
+			}
+		}()
+	}
+
+	for {
+		var batchSize int
+		err = p.db.DBTransaction(ctx, func(ctx context.Context, svc db.Service) error {
+			txs, err := svc.GetPrunableTXS(ctx, p.policy, pruneBatchSize)
+			if err != nil {
+				return fmt.Errorf("could not get prunable txs: %w", err)
+			}
+			if len(txs) == 0 {
+				return nil
+			}
+
+			if archive != nil {
+				err = archive.write(txs)
+				if err != nil {
+					return fmt.Errorf("could not archive txs: %w", err)
+				}
+				p.archived.Add(ctx, int64(len(txs)))
+			}
+
+			txHashes := make([]common.Hash, len(txs))
+			for i, tx := range txs {
+				txHashes[i] = tx.Hash()
+			}
+
+			err = svc.DeleteTXS(ctx, txHashes...)
+			if err != nil {
+				return fmt.Errorf("could not delete txs: %w", err)
+			}
+
+			batchSize = len(txs)
+			return nil
+		})
+		if err != nil {
+			return pruned, fmt.Errorf("could not prune txs: %w", err)
+		}
+
+		pruned += batchSize
+		p.pruned.Add(ctx, int64(batchSize))
+
+		if batchSize < pruneBatchSize {
+			return pruned, nil
+		}
+	}
+}
+
+// archiveWriter writes pruned txs to a gzipped JSONL file.
+type archiveWriter struct {
+	file *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+}
+
+// newArchiveWriter creates a new archive file in dir, named after the time of the prune.
+func newArchiveWriter(dir string, pruneTime time.Time) (*archiveWriter, error) {
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("could not create archive dir: %w", err)
+	}
+
+	fileName := filepath.Join(dir, fmt.Sprintf("submitter-txs-%s.jsonl.gz", pruneTime.UTC().Format("20060102T150405.000000000Z")))
+	// nolint: gosec
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("could not create archive file: %w", err)
+	}
+
+	gz := gzip.NewWriter(file)
+	return &archiveWriter{
+		file: file,
+		gz:   gz,
+		enc:  json.NewEncoder(gz),
+	}, nil
+}
+
+// write writes the txs to the archive and flushes them to disk, so they're archived before they're deleted.
+func (a *archiveWriter) write(txs []db.TX) error {
+	for _, tx := range txs {
+		rawTX, err := tx.MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("could not marshal tx: %w", err)
+		}
+
+		call, err := util.TxToCall(tx.Transaction)
+		if err != nil {
+			return fmt.Errorf("could not recover sender: %w", err)
+		}
+
+		err = a.enc.Encode(ArchivedTX{
+			TXHash:       tx.Hash().String(),
+			From:         call.From.String(),
+			ChainID:      tx.ChainId().Uint64(),
+			Nonce:        tx.Nonce(),
+			Status:       tx.Status.String(),
+			GasUsed:      tx.GasUsed,
+			RevertReason: tx.RevertReason,
+			CreatedAt:    tx.CreationTime(),
+			RawTX:        rawTX,
+		})
+		if err != nil {
+			return fmt.Errorf("could not encode tx: %w", err)
+		}
+	}
+
+	err := a.gz.Flush()
+	if err != nil {
+		return fmt.Errorf("could not flush archive: %w", err)
+	}
+	err = a.file.Sync()
+	if err != nil {
+		return fmt.Errorf("could not sync archive: %w", err)
+	}
+	return nil
+}
+
+// close closes the archive. If remove is true, the archive is removed, e.g. because nothing was pruned.
+func (a *archiveWriter) close(remove bool) error {
+	err := a.gz.Close()
+	if err != nil {
+		return fmt.Errorf("could not close gzip writer: %w", err)
+	}
+	err = a.file.Close()
+	if err != nil {
+		return fmt.Errorf("could not close file: %w", err)
+	}
+	if remove {
+		err = os.Remove(a.file.Name())
+		if err != nil {
+			return fmt.Errorf("could not remove empty archive: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package submitter_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+func (t *TXSubmitterDBSuite) TestPrune() {
+	t.RunOnAllDBs(func(testDB db.Service) {
+		// each nonce has a replaced attempt and a final attempt, except nonce 3 which is still pending after its first
+		// attempt was cancelled. nonce 4 is the latest nonce, so it's always kept.
+		statuses := map[uint64][]db.Status{
+			1: {db.Replaced, db.ConfirmedSuccess},
+			2: {db.Replaced, db.Reverted},
+			3: {db.Cancelled, db.Submitted},
+			4: {db.Replaced, db.ConfirmedSuccess},
+		}
+
+		for _, backend := range t.testBackends {
+			for _, mockAccount := range t.mockAccounts {
+				for nonce, nonceStatuses := range statuses {
+					for i, status := range nonceStatuses {
+						tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+							To:       &mockAccount.Address,
+							Value:    big.NewInt(0),
+							Nonce:    nonce,
+							GasPrice: big.NewInt(int64(i + 1)),
+						}), backend.Signer(), mockAccount.PrivateKey)
+						t.Require().NoError(err)
+
+						err = testDB.PutTXS(t.GetTestContext(), db.NewTX(tx, status, uuid.New().String()))
+						t.Require().NoError(err)
+					}
+				}
+			}
+		}
+		lanes := len(t.testBackends) * len(t.mockAccounts)
+
+		// only the replaced attempts of mined nonces before the latest are pruned.
+		archiveDir := filet.TmpDir(t.T(), "")
+		pruned, err := submitter.Prune(t.GetTestContext(), t.metrics, testDB, db.RetentionPolicy{FinalAttemptOnly: true}, archiveDir)
+		t.Require().NoError(err)
+		t.Require().Equal(2*lanes, pruned)
+
+		archived := readArchive(t, archiveDir)
+		t.Require().Len(archived, pruned)
+		for _, archivedTX := range archived {
+			t.Require().Equal(db.Replaced.String(), archivedTX.Status)
+			t.Require().Less(archivedTX.Nonce, uint64(3))
+		}
+
+		// pruning again is a no-op, and doesn't leave an empty archive behind.
+		emptyDir := filet.TmpDir(t.T(), "")
+		pruned, err = submitter.Prune(t.GetTestContext(), t.metrics, testDB, db.RetentionPolicy{FinalAttemptOnly: true}, emptyDir)
+		t.Require().NoError(err)
+		t.Require().Zero(pruned)
+		entries, err := os.ReadDir(emptyDir)
+		t.Require().NoError(err)
+		t.Require().Empty(entries)
+
+		// every old attempt of a mined nonce and every cancelled attempt is pruned, but pending txs and the latest nonce are kept.
+		time.Sleep(time.Millisecond)
+		pruned, err = submitter.Prune(t.GetTestContext(), t.metrics, testDB, db.RetentionPolicy{MaxAge: time.Nanosecond}, "")
+		t.Require().NoError(err)
+		t.Require().Equal(3*lanes, pruned)
+
+		for _, backend := range t.testBackends {
+			for _, mockAccount := range t.mockAccounts {
+				dbNonce, err := testDB.GetNonceForChainID(t.GetTestContext(), mockAccount.Address, backend.GetBigChainID())
+				t.Require().NoError(err)
+				t.Require().Equal(uint64(4), dbNonce)
+
+				for nonce, expected := range map[uint64]int{1: 0, 2: 0, 3: 1, 4: 2} {
+					attempts, err := testDB.GetNonceAttemptsByStatus(t.GetTestContext(), mockAccount.Address, backend.GetBigChainID(), nonce, db.AllStatusTypes()...)
+					t.Require().NoError(err)
+					t.Require().Len(attempts, expected)
+				}
+			}
+		}
+	})
+}
+
+// readArchive reads every tx in the archives in dir.
+func readArchive(t *TXSubmitterDBSuite, dir string) (archived []submitter.ArchivedTX) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.jsonl.gz"))
+	t.Require().NoError(err)
+	t.Require().Len(files, 1)
+
+	file, err := os.Open(files[0])
+	t.Require().NoError(err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gz, err := gzip.NewReader(file)
+	t.Require().NoError(err)
+
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var archivedTX submitter.ArchivedTX
+		t.Require().NoError(json.Unmarshal(scanner.Bytes(), &archivedTX))
+		t.Require().NotEqual(common.Address{}.String(), archivedTX.From)
+		archived = append(archived, archivedTX)
+	}
+	t.Require().NoError(scanner.Err())
+	return archived
+}
@@ -0,0 +1,117 @@
+package submitter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/util"
+)
+
+// unknownRevertReason is the revert reason used when the reverted call can't be replayed.
+const unknownRevertReason = "unknown"
+
+// getRevertReason replays a reverted tx against the state before the block it was mined in and decodes the revert
+// reason. Replaying against the mined block would see every state change in it, including ones that happened after
+// the tx and can make the replay succeed. Custom errors that aren't defined in abis are returned as hex encoded revert data.
+func getRevertReason(ctx context.Context, chainClient client.EVM, tx *types.Transaction, blockNumber *big.Int, abis ...*abi.ABI) string {
+	call, err := util.TxToCall(tx)
+	if err != nil {
+		return unknownRevertReason
+	}
+
+	// the fees were already paid, so don't let the replay fail on the balance check.
+	call.GasPrice, call.GasFeeCap, call.GasTipCap = nil, nil, nil
+
+	var parentBlock *big.Int
+	if blockNumber != nil && blockNumber.Sign() > 0 {
+		parentBlock = new(big.Int).Sub(blockNumber, big.NewInt(1))
+	}
+
+	_, err = chainClient.CallContract(ctx, *call, parentBlock)
+	if err == nil {
+		// earlier txs in the same block changed the state it ran against, so we can't tell why it reverted.
+		return unknownRevertReason
+	}
+
+	return decodeRevertReason(err, abis...)
+}
+
+// revertData extracts the revert data from a call error. ok is false if the error isn't a revert, such as when the
+// rpc call itself fails. Reverts without a reason return empty data.
+func revertData(err error) (data []byte, ok bool) {
+	var dataErr interface{ ErrorData() interface{} }
+	if errors.As(err, &dataErr) {
+		if hexData, isString := dataErr.ErrorData().(string); isString {
+			data, decodeErr := hexutil.Decode(hexData)
+			if decodeErr == nil {
+				return data, true
+			}
+		}
+	}
+
+	if strings.Contains(err.Error(), vm.ErrExecutionReverted.Error()) {
+		return nil, true
+	}
+	return nil, false
+}
+
+// decodeRevertReason decodes the revert reason from a call error.
+// Custom errors are decoded with the first abi that defines them, otherwise they're returned hex encoded.
+func decodeRevertReason(err error, abis ...*abi.ABI) string {
+	data, ok := revertData(err)
+	if !ok || len(data) == 0 {
+		return err.Error()
+	}
+
+	reason, unpackErr := abi.UnpackRevert(data)
+	if unpackErr == nil {
+		return reason
+	}
+
+	customErr, args, ok := decodeCustomError(data, abis)
+	if ok {
+		return formatCustomError(customErr, args)
+	}
+	return hexutil.Encode(data)
+}
+
+// decodeCustomError finds the custom error matching the selector of the revert data in abis and unpacks its args.
+func decodeCustomError(data []byte, abis []*abi.ABI) (customErr *abi.Error, args []interface{}, ok bool) {
+	if len(data) < 4 {
+		return nil, nil, false
+	}
+
+	for _, contractABI := range abis {
+		for _, abiErr := range contractABI.Errors {
+			if !bytes.Equal(abiErr.ID[:4], data[:4]) {
+				continue
+			}
+
+			abiErr := abiErr
+			args, err := abiErr.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			return &abiErr, args, true
+		}
+	}
+	return nil, nil, false
+}
+
+// formatCustomError formats a custom error like it would be called in solidity, e.g. Unauthorized(0x1234).
+func formatCustomError(customErr *abi.Error, args []interface{}) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%v", arg)
+	}
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(formatted, ", "))
+}
@@ -0,0 +1,94 @@
+package submitter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/util"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SimulationRevertError is returned by SubmitTransaction when simulate_before_submit is enabled and the transaction
+// reverts against the pending state.
+type SimulationRevertError struct {
+	// Reason is the decoded revert reason. See CustomError for custom errors.
+	Reason string
+	// CustomError is the custom error the transaction reverted with, if it's defined in one of the abis passed to
+	// WithRevertABIs.
+	CustomError *abi.Error
+	// Args are the unpacked arguments of CustomError.
+	Args []interface{}
+	// Data is the raw revert data. This is empty if the transaction reverted without a reason.
+	Data []byte
+}
+
+func (s *SimulationRevertError) Error() string {
+	return fmt.Sprintf("transaction reverted in simulation: %s", s.Reason)
+}
+
+// newSimulationRevertError decodes the revert data of a simulation into a SimulationRevertError.
+func newSimulationRevertError(data []byte, abis []*abi.ABI) *SimulationRevertError {
+	revertErr := &SimulationRevertError{
+		Data:   data,
+		Reason: hexutil.Encode(data),
+	}
+
+	if len(data) == 0 {
+		revertErr.Reason = vm.ErrExecutionReverted.Error()
+		return revertErr
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		revertErr.Reason = reason
+		return revertErr
+	}
+
+	customErr, args, ok := decodeCustomError(data, abis)
+	if ok {
+		revertErr.CustomError = customErr
+		revertErr.Args = args
+		revertErr.Reason = formatCustomError(customErr, args)
+	}
+	return revertErr
+}
+
+// simulate calls the tx against the pending state. A *SimulationRevertError is returned if it reverts.
+// Other errors, like the rpc being unavailable, aren't deterministic, so they're only recorded and nil is returned.
+func (t *txSubmitterImpl) simulate(parentCtx context.Context, chainClient client.EVM, tx *types.Transaction) (err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.simulate", trace.WithAttributes(
+		attribute.String(metrics.TxHash, tx.Hash().String()),
+		attribute.Int64("nonce", int64(tx.Nonce())),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	call, convertErr := util.TxToCall(tx)
+	if convertErr != nil {
+		span.AddEvent("could not convert tx to call", trace.WithAttributes(attribute.String("error", convertErr.Error())))
+		return nil
+	}
+
+	// only the outcome of the call matters, so don't let it fail on the balance check for the fees.
+	call.GasPrice, call.GasFeeCap, call.GasTipCap = nil, nil, nil
+
+	_, callErr := chainClient.PendingCallContract(ctx, *call)
+	if callErr == nil {
+		return nil
+	}
+
+	data, isRevert := revertData(callErr)
+	if !isRevert {
+		span.AddEvent("could not simulate tx", trace.WithAttributes(attribute.String("error", callErr.Error())))
+		return nil
+	}
+
+	return newSimulationRevertError(data, t.revertABIs)
+}
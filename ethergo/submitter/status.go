@@ -1,6 +1,9 @@
 package submitter
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
 
 // SubmissionState is the status of a submission. This is not used internally and only serves as
 // a way to communicate the status of a submission to the caller.
@@ -17,7 +20,14 @@
 	// no txhash has been associated with it yet.
 	Confirming // confirming
 	// Confirmed indicates that the submission is confirmed and txhash data is available.
+	// Unless it was confirmed before receipts were checked, it also executed successfully.
 	Confirmed // confirmed
+	// Cancelled indicates that the submission was cancelled. If the cancellation has been mined, the txhash
+	// of the cancellation is available.
+	Cancelled // cancelled
+	// Reverted indicates that the submission was confirmed, but reverted. The txhash, gas used and
+	// revert reason are available.
+	Reverted // reverted
 )
 
 // SubmissionStatus is the status of a submission.
@@ -28,11 +38,25 @@ type SubmissionStatus interface {
 	HasTx() bool
 	// TxHash is the hash of the transaction. This will be the zero hash if HasTx is false.
 	TxHash() common.Hash
+	// GasUsed is the gas used by the transaction. This will be 0 if HasTx is false.
+	GasUsed() uint64
+	// RevertReason is the decoded revert reason. This is only set if the state is Reverted.
+	RevertReason() string
+	// AttemptStatus is the status of the latest attempt for the nonce, e.g. Stored or Submitted while the submission
+	// is pending. This will be 0 if the state is NotFound.
+	AttemptStatus() db.Status
+	// AttemptHash is the hash of the latest attempt for the nonce, which changes every time the tx is bumped.
+	// Once the submission has a tx, this is the hash of the mined tx.
+	AttemptHash() common.Hash
 }
 
 type submissionStatusImpl struct {
-	state  SubmissionState
-	txHash common.Hash
+	state        SubmissionState
+	txHash       common.Hash
+	gasUsed      uint64
+	revertReason string
+	// attempt is the latest attempt for the nonce.
+	attempt *db.TX
 }
 
 func (s submissionStatusImpl) State() SubmissionState {
@@ -40,11 +64,39 @@ func (s submissionStatusImpl) State() SubmissionState {
 }
 
 func (s submissionStatusImpl) HasTx() bool {
-	return s.state == Confirmed
+	return s.state == Confirmed || s.state == Reverted || (s.state == Cancelled && s.txHash != common.Hash{})
 }
 
 func (s submissionStatusImpl) TxHash() common.Hash {
 	return s.txHash
 }
 
+func (s submissionStatusImpl) GasUsed() uint64 {
+	return s.gasUsed
+}
+
+func (s submissionStatusImpl) RevertReason() string {
+	return s.revertReason
+}
+
+func (s submissionStatusImpl) AttemptStatus() db.Status {
+	if s.attempt == nil {
+		return 0
+	}
+	return s.attempt.Status
+}
+
+func (s submissionStatusImpl) AttemptHash() common.Hash {
+	if s.attempt == nil {
+		return common.Hash{}
+	}
+	return s.attempt.Hash()
+}
+
+// sameStatus returns true if neither the state nor the latest attempt changed between the statuses.
+func sameStatus(a, b SubmissionStatus) bool {
+	return a.State() == b.State() && a.TxHash() == b.TxHash() &&
+		a.AttemptStatus() == b.AttemptStatus() && a.AttemptHash() == b.AttemptHash()
+}
+
 var _ SubmissionStatus = &submissionStatusImpl{}
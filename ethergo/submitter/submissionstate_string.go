@@ -12,11 +12,13 @@ func _() {
 	_ = x[Pending-1]
 	_ = x[Confirming-2]
 	_ = x[Confirmed-3]
+	_ = x[Cancelled-4]
+	_ = x[Reverted-5]
 }
 
-const _SubmissionState_name = "NotFoundpendingconfirmingconfirmed"
+const _SubmissionState_name = "NotFoundpendingconfirmingconfirmedcancelledreverted"
 
-var _SubmissionState_index = [...]uint8{0, 8, 15, 25, 34}
+var _SubmissionState_index = [...]uint8{0, 8, 15, 25, 34, 43, 51}
 
 func (i SubmissionState) String() string {
 	if i >= SubmissionState(len(_SubmissionState_index)-1) {
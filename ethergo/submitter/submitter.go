@@ -14,6 +14,7 @@
 	"github.com/google/uuid"
 	"github.com/puzpuzpuz/xsync/v2"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -22,6 +23,7 @@
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/mapmutex"
 	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/observer"
 	"github.com/synapsecns/sanguine/core/retry"
 	"github.com/synapsecns/sanguine/ethergo/chain/gas"
 	"github.com/synapsecns/sanguine/ethergo/client"
@@ -40,12 +42,42 @@
 type TransactionSubmitter interface {
 	// Start starts the transaction submitter.
 	Start(ctx context.Context) error
-	// SubmitTransaction submits a transaction to the chain.
+	// SubmitTransaction submits a transaction to the chain from the signer the submitter was created with.
 	// the transaction is not guaranteed to be executed immediately, only at some point in the future.
 	// the nonce is returned, and can be used to track the status of the transaction.
+	// If simulation is enabled for the chain, a *SimulationRevertError is returned if the transaction reverts.
 	SubmitTransaction(ctx context.Context, chainID *big.Int, call ContractCallType) (nonce uint64, err error)
 	// GetSubmissionStatus returns the status of a transaction and any metadata associated with it if it is complete.
 	GetSubmissionStatus(ctx context.Context, chainID *big.Int, nonce uint64) (status SubmissionStatus, err error)
+	// CancelTransaction cancels a pending transaction by replacing its nonce with a zero value self-transfer.
+	// ErrNotCancellable is returned if the nonce has already been mined or cancelled.
+	CancelTransaction(ctx context.Context, chainID *big.Int, nonce uint64) error
+	// SubscribeStatus returns a channel that receives the status of a transaction each time it changes, starting with
+	// the current status. The channel is closed once the status is final or the context is cancelled.
+	SubscribeStatus(ctx context.Context, chainID *big.Int, nonce uint64) <-chan SubmissionStatus
+	// WaitForConfirmation blocks until the transaction is confirmed with at least confs confirmations.
+	// ErrCancelled is returned if the transaction was cancelled instead, and ErrReverted if it reverted.
+	WaitForConfirmation(ctx context.Context, chainID *big.Int, nonce uint64, confs uint64) (SubmissionStatus, error)
+	// RouteTransaction submits a transaction from the signer in the pool picked by the routing policy.
+	// key is used by the Sticky policy, and ignored otherwise. The nonce is for the returned signer, use ForSigner
+	// to track it.
+	RouteTransaction(ctx context.Context, chainID *big.Int, key string, call ContractCallType) (from common.Address, nonce uint64, err error)
+	// ForSigner returns the submitter for a signer in the pool. Its nonces are the nonces of that signer.
+	// The returned submitter is started by Start, so it should not be started separately.
+	ForSigner(from common.Address) (TransactionSubmitter, error)
+	// Signers returns the addresses of the signers in the pool, starting with the signer the submitter was created with.
+	Signers() []common.Address
+	// ListNonces returns the pending nonces on the chain with their age and attempt count. Nonces that have been
+	// pending for longer than stuckAfter are reported as stuck.
+	ListNonces(ctx context.Context, chainID *big.Int, stuckAfter time.Duration) ([]NonceSummary, error)
+	// NonceHistory returns every attempt for a nonce, oldest first.
+	NonceHistory(ctx context.Context, chainID *big.Int, nonce uint64) ([]db.TX, error)
+	// ForceBump replaces the pending transaction for a nonce with a copy at the given gas price.
+	// ErrNotPending is returned if the nonce is not pending, ErrPriceTooLow if the price isn't a bump, and ErrPriceTooHigh or
+	// ErrMaxCostExceeded if it's over the configured max gas price or max total cost.
+	ForceBump(ctx context.Context, chainID *big.Int, nonce uint64, gasPrice *big.Int) (txHash common.Hash, err error)
+	// ResyncNonce re-syncs the nonce from the chain, marking every attempt before the on-chain nonce as replaced or confirmed.
+	ResyncNonce(ctx context.Context, chainID *big.Int) (NonceSync, error)
 }
 
 // txSubmitterImpl is the implementation of the transaction submitter.
@@ -53,10 +85,13 @@ type txSubmitterImpl struct {
 	metrics metrics.Handler
 	// signer is the signer for signing transactions.
 	signer signer.Signer
-	// nonceMux is the mutex for the nonces. It is keyed by chain.
+	// nonceMux is the mutex for the nonces. It is keyed by lane.
 	nonceMux mapmutex.StringerMapMutex
 	// statusMux is the mutex for the status of a tx. It is keyed by tx hash.
 	statusMux mapmutex.StringMapMutex
+	// queueMux is the mutex for processing a chain's pending queue. It is keyed by lane.
+	// It is held by cancellations so txes aren't bumped while they're being replaced.
+	queueMux mapmutex.StringerMapMutex
 	// fetcher is used to fetch the chain client for a given chain id.
 	fetcher ClientFetcher
 	// db is the database for storing transactions.
@@ -72,6 +107,18 @@ type txSubmitterImpl struct {
 	lastGasBlockCache *xsync.MapOf[int, *types.Header]
 	// config is the config for the transaction submitter.
 	config config.IConfig
+	// statusObserver publishes the status of watched nonces each time the confirmed queue is processed.
+	statusObserver *observer.Observer[statusKey, SubmissionStatus]
+	// watchMux protects watched.
+	watchMux sync.Mutex
+	// watched is the number of subscribers for each nonce.
+	watched map[statusKey]int
+	// revertABIs are used to decode custom errors from reverted transactions.
+	revertABIs []*abi.ABI
+	// pool is the signer pool, shared by the submitters for each signer.
+	pool *signerPool
+	// throttler holds the state of the per chain throughput limits, shared by the submitters for each signer.
+	throttler *throttler
 }
 
 // ClientFetcher is the interface for fetching a chain client.
@@ -82,18 +129,30 @@ type ClientFetcher interface {
 }
 
 // NewTransactionSubmitter creates a new transaction submitter.
-func NewTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config config.IConfig) TransactionSubmitter {
-	return &txSubmitterImpl{
+func NewTransactionSubmitter(metrics metrics.Handler, signer signer.Signer, fetcher ClientFetcher, db db.Service, config config.IConfig, opts ...Option) TransactionSubmitter {
+	submitter := &txSubmitterImpl{
 		db:                db,
 		config:            config,
 		metrics:           metrics,
 		signer:            signer,
 		fetcher:           fetcher,
-		nonceMux:          mapmutex.NewStringerMapMutex(),
-		statusMux:         mapmutex.NewStringMapMutex(),
+		nonceMux:          mapmutex.NewStringerMapMutex(mapmutex.WithMetrics(metrics, "submitter_nonce")),
+		statusMux:         mapmutex.NewStringMapMutex(mapmutex.WithMetrics(metrics, "submitter_status")),
+		queueMux:          mapmutex.NewStringerMapMutex(mapmutex.WithMetrics(metrics, "submitter_queue")),
 		retryNow:          make(chan bool, 1),
 		lastGasBlockCache: xsync.NewIntegerMapOf[int, *types.Header](),
+		statusObserver:    observer.NewObserver[statusKey, SubmissionStatus](),
+		watched:           make(map[statusKey]int),
+		pool:              &signerPool{},
+		throttler:         newThrottler(metrics),
 	}
+
+	for _, opt := range opts {
+		opt(submitter)
+	}
+	submitter.buildLanes()
+
+	return submitter
 }
 
 // GetRetryInterval returns the retry interval for the transaction submitter.
@@ -105,7 +164,32 @@ func (t *txSubmitterImpl) GetRetryInterval() time.Duration {
 	return retryInterval
 }
 
+// Start starts the submitter for every signer in the pool, and the pruner if a retention policy is configured.
 func (t *txSubmitterImpl) Start(ctx context.Context) error {
+	if len(t.pool.lanes) == 1 && !t.retentionPolicy().IsEnabled() {
+		return t.run(ctx)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, lane := range t.pool.lanes {
+		lane := lane
+		g.Go(func() error {
+			return lane.run(ctx)
+		})
+	}
+
+	if t.retentionPolicy().IsEnabled() {
+		g.Go(func() error {
+			return t.runPruner(ctx)
+		})
+	}
+
+	//nolint: wrapcheck
+	return g.Wait()
+}
+
+// run runs the queue of this signer until the context is cancelled.
+func (t *txSubmitterImpl) run(ctx context.Context) error {
 	i := 0
 	for {
 		i++
@@ -132,14 +216,8 @@ func (t *txSubmitterImpl) GetSubmissionStatus(ctx context.Context, chainID *big.
 		return nil, fmt.Errorf("could not get nonce status: %w", err)
 	}
 
-	if nonceStatus == db.ReplacedOrConfirmed {
-		return submissionStatusImpl{
-			state: Confirming,
-		}, nil
-	}
-
-	if nonceStatus == db.Confirmed {
-		txs, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.Confirmed)
+	if nonceStatus.IsConfirmed() {
+		txs, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.ConfirmedStatuses()...)
 		if err != nil {
 			return nil, fmt.Errorf("could not get nonce attempts by status: %w", err)
 		}
@@ -148,14 +226,45 @@ func (t *txSubmitterImpl) GetSubmissionStatus(ctx context.Context, chainID *big.
 			return nil, fmt.Errorf("unexpected error: no transactions found for nonce %d", nonce)
 		}
 
+		state := Confirmed
+		switch {
+		case isCancellation(txs[0].Transaction, t.signer.Address()):
+			state = Cancelled
+		case txs[0].Status == db.Reverted:
+			state = Reverted
+		}
+
 		return submissionStatusImpl{
-			state:  Confirmed,
-			txHash: txs[0].Hash(),
+			state:        state,
+			txHash:       txs[0].Hash(),
+			gasUsed:      txs[0].GasUsed,
+			revertReason: txs[0].RevertReason,
+			attempt:      &txs[0],
 		}, nil
 	}
 
+	attempts, err := t.db.GetNonceAttemptsByStatus(ctx, t.signer.Address(), chainID, nonce, db.AllStatusTypes()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce attempts: %w", err)
+	}
+	if len(attempts) == 0 {
+		return nil, fmt.Errorf("unexpected error: no transactions found for nonce %d", nonce)
+	}
+	// every bump raises the price, so the highest priced attempt is the latest one.
+	latest := highestPricedAttempt(attempts)
+
+	state := Pending
+	switch nonceStatus {
+	case db.ReplacedOrConfirmed:
+		state = Confirming
+	case db.Cancelled:
+		// the cancellation hasn't been mined yet.
+		state = Cancelled
+	}
+
 	return submissionStatusImpl{
-		state: Pending,
+		state:   state,
+		attempt: &latest,
 	}, nil
 }
 
@@ -231,6 +340,7 @@ func (t *txSubmitterImpl) storeTX(ctx context.Context, tx *types.Transaction, st
 		return fmt.Errorf("could not put tx: %w", err)
 	}
 
+	t.publishStatus(ctx, tx.ChainId(), tx.Nonce())
 	return nil
 }
 
@@ -295,7 +405,10 @@ func (t *txSubmitterImpl) SubmitTransaction(parentCtx context.Context, chainID *
 	}
 
 	transactor.Signer = func(address common.Address, transaction *types.Transaction) (_ *types.Transaction, err error) {
-		locker = t.nonceMux.Lock(chainID)
+		locker, err = t.nonceMux.LockCtx(ctx, t.laneKey(chainID))
+		if err != nil {
+			return nil, fmt.Errorf("could not lock nonce: %w", err)
+		}
 		// it's important that we unlock the nonce if we fail to sign the transaction.
 		// this is why we use a defer here. The second defer should only be called if the first defer is not called.
 		defer func() {
@@ -325,6 +438,19 @@ func (t *txSubmitterImpl) SubmitTransaction(parentCtx context.Context, chainID *
 	}
 	defer locker.Unlock()
 
+	// the tx hasn't been stored yet, so rejecting it here doesn't use up the nonce.
+	err = t.applyCostCeil(ctx, chainClient, int(chainID.Uint64()), tx)
+	if err != nil {
+		return 0, fmt.Errorf("could not submit transaction: %w", err)
+	}
+
+	if t.config.GetSimulateBeforeSubmit(int(chainID.Uint64())) {
+		err = t.simulate(ctx, chainClient, tx)
+		if err != nil {
+			return 0, fmt.Errorf("could not submit transaction: %w", err)
+		}
+	}
+
 	// now that we've stored the tx
 	err = t.storeTX(ctx, tx, db.Stored, uuid.New().String())
 	if err != nil {
@@ -381,6 +507,11 @@ func (t *txSubmitterImpl) setGasPrice(ctx context.Context, client client.EVM,
 	if err != nil {
 		return fmt.Errorf("could not apply gas ceil: %w", err)
 	}
+
+	err = t.applyCostBudget(ctx, client, transactor, chainID, prevTx, useDynamic)
+	if err != nil {
+		return fmt.Errorf("could not apply cost budget: %w", err)
+	}
 	return nil
 }
 
@@ -523,6 +654,162 @@ func (t *txSubmitterImpl) applyGasCeil(ctx context.Context, transactor *bind.Tra
 	return nil
 }
 
+// ErrMaxCostExceeded is returned when the total cost of a transaction, including the L1 data fee, exceeds the
+// configured max total cost.
+var ErrMaxCostExceeded = errors.New("transaction cost exceeds max total cost")
+
+// applyCostCeil estimates the total cost of the tx, including the L1 data fee on rollups, and returns
+// ErrMaxCostExceeded if it's above the configured max total cost.
+func (t *txSubmitterImpl) applyCostCeil(parentCtx context.Context, chainClient client.EVM, chainID int, tx *types.Transaction) (err error) {
+	maxCost := t.config.GetMaxTotalCost(chainID)
+	rollupType, err := t.config.GetRollupType(chainID)
+	if err != nil {
+		return fmt.Errorf("could not get rollup type: %w", err)
+	}
+
+	// nothing to estimate.
+	if maxCost == nil && rollupType == gas.NotRollup {
+		return nil
+	}
+
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.applyCostCeil", trace.WithAttributes(
+		attribute.Int(metrics.ChainID, chainID),
+		attribute.Stringer("rollup_type", rollupType),
+		attribute.String("max_total_cost", bigPtrToString(maxCost)),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	cost, err := gas.EstimateCost(ctx, chainClient, rollupType, tx)
+	if err != nil {
+		return fmt.Errorf("could not estimate cost: %w", err)
+	}
+
+	// dynamic gas estimates already include the l1 gas on arbitrum.
+	total := cost.Total(t.config.GetDynamicGasEstimate(chainID))
+	span.SetAttributes(
+		attribute.String("execution_cost", bigPtrToString(cost.Execution)),
+		attribute.String("l1_data_fee", bigPtrToString(cost.L1Data)),
+		attribute.String("total_cost", bigPtrToString(total)),
+	)
+
+	if maxCost != nil && total.Cmp(maxCost) > 0 {
+		return fmt.Errorf("total cost %s exceeds %s: %w", total, maxCost, ErrMaxCostExceeded)
+	}
+	return nil
+}
+
+// minReplacementBumpPercent is the minimum price increase nodes accept for a replacement tx.
+const minReplacementBumpPercent = 10
+
+// applyCostBudget caps the price of a bump so the total cost, including the L1 data fee on rollups, stays within the
+// configured max total cost. It returns ErrMaxCostExceeded if the capped price is too low to replace prevTx.
+// New txes have no gas limit or calldata yet, so they're checked by applyCostCeil once built instead.
+//
+//nolint:cyclop
+func (t *txSubmitterImpl) applyCostBudget(parentCtx context.Context, chainClient client.EVM, transactor *bind.TransactOpts,
+	chainID int, prevTx *types.Transaction, useDynamic bool) (err error) {
+	maxCost := t.config.GetMaxTotalCost(chainID)
+	if maxCost == nil || prevTx == nil || transactor.GasLimit == 0 {
+		return nil
+	}
+
+	rollupType, err := t.config.GetRollupType(chainID)
+	if err != nil {
+		return fmt.Errorf("could not get rollup type: %w", err)
+	}
+
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.applyCostBudget", trace.WithAttributes(
+		attribute.Int(metrics.ChainID, chainID),
+		attribute.Stringer("rollup_type", rollupType),
+		attribute.String("max_total_cost", bigPtrToString(maxCost)),
+	))
+	defer func() {
+		span.SetAttributes(
+			attribute.String("gas_price", bigPtrToString(transactor.GasPrice)),
+			attribute.String("gas_fee_cap", bigPtrToString(transactor.GasFeeCap)),
+			attribute.String("gas_tip_cap", bigPtrToString(transactor.GasTipCap)),
+		)
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	txType := uint8(types.LegacyTxType)
+	if useDynamic {
+		txType = types.DynamicFeeTxType
+	}
+	candidate, err := replacementTX(prevTx, transactor, txType)
+	if err != nil {
+		return err
+	}
+
+	cost, err := gas.EstimateCost(ctx, chainClient, rollupType, candidate)
+	if err != nil {
+		return fmt.Errorf("could not estimate cost: %w", err)
+	}
+
+	// dynamic gas estimates already include the l1 gas on arbitrum.
+	includesL1 := t.config.GetDynamicGasEstimate(chainID)
+	total := cost.Total(includesL1)
+	span.SetAttributes(attribute.String("total_cost", bigPtrToString(total)))
+	if total.Cmp(maxCost) <= 0 {
+		return nil
+	}
+
+	budgetPrice := cost.MaxPricePerGas(maxCost, transactor.GasLimit, includesL1)
+	span.SetAttributes(attribute.String("budget_price", bigPtrToString(budgetPrice)))
+
+	minPrice := gas.BumpByPercent(prevTx.GasFeeCap(), minReplacementBumpPercent)
+	if budgetPrice == nil || budgetPrice.Cmp(minPrice) < 0 {
+		return fmt.Errorf("total cost %s exceeds %s and the budget price %s can't replace %s: %w",
+			total, maxCost, bigPtrToString(budgetPrice), prevTx.GasFeeCap(), ErrMaxCostExceeded)
+	}
+
+	if !useDynamic {
+		transactor.GasPrice = budgetPrice
+		return nil
+	}
+
+	transactor.GasFeeCap = budgetPrice
+	if transactor.GasTipCap.Cmp(budgetPrice) > 0 {
+		transactor.GasTipCap = core.CopyBigInt(budgetPrice)
+	}
+	minTip := gas.BumpByPercent(prevTx.GasTipCap(), minReplacementBumpPercent)
+	if transactor.GasTipCap.Cmp(minTip) < 0 {
+		return fmt.Errorf("tip cap %s within the max total cost %s can't replace %s: %w",
+			transactor.GasTipCap, maxCost, prevTx.GasTipCap(), ErrMaxCostExceeded)
+	}
+	return nil
+}
+
+// replacementTX builds a tx of txType replacing tx, with the gas values of transactor.
+func replacementTX(tx *types.Transaction, transactor *bind.TransactOpts, txType uint8) (*types.Transaction, error) {
+	switch txType {
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: core.CopyBigInt(transactor.GasPrice),
+			Gas:      transactor.GasLimit,
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: core.CopyBigInt(transactor.GasTipCap),
+			GasFeeCap: core.CopyBigInt(transactor.GasFeeCap),
+			Gas:       transactor.GasLimit,
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown tx type: %v", txType)
+	}
+}
+
 func maxOfBig(a, b *big.Int) *big.Int {
 	if a == nil {
 		return b
@@ -5,6 +5,7 @@
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/mock"
@@ -205,6 +206,62 @@ func (s *SubmitterSuite) TestSetGasPrice() {
 	})
 }
 
+func (s *SubmitterSuite) TestSetGasPriceMaxTotalCost() {
+	wall, err := wallet.FromRandom()
+	s.Require().NoError(err)
+
+	signer := localsigner.NewSigner(wall.PrivateKey())
+	chainID := s.testBackends[0].GetBigChainID()
+
+	transactor, err := signer.GetTransactor(s.GetTestContext(), chainID)
+	s.Require().NoError(err)
+
+	// the op stack l1 fee is charged on top of execution.
+	l1Fee := big.NewInt(1000 * params.GWei * 1000)
+	client := new(clientMocks.EVM)
+	client.On(testsuite.GetFunctionName(client.CallContract), mock.Anything, mock.Anything, mock.Anything).
+		Return(common.LeftPadBytes(l1Fee.Bytes(), 32), nil)
+
+	maxTotalCost := new(big.Int)
+	cfg := &config.Config{
+		Chains: map[int]config.ChainConfig{
+			int(chainID.Int64()): {
+				MinGasPrice:  big.NewInt(1 * params.GWei),
+				MaxGasPrice:  big.NewInt(1000 * params.GWei),
+				RollupType:   "op_stack",
+				MaxTotalCost: maxTotalCost,
+			},
+		},
+	}
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, signer, s, s.store, cfg)
+
+	prevTx := types.NewTx(&types.LegacyTx{
+		GasPrice: big.NewInt(100 * params.GWei),
+		Gas:      100_000,
+	})
+
+	s.Run("CappedToBudget", func() {
+		transactor.GasPrice = nil
+		transactor.GasLimit = prevTx.Gas()
+		// (max total cost - l1 fee) / gas limit is 115 gwei, enough to replace the prev tx.
+		maxTotalCost.SetUint64(12_500_000 * params.GWei)
+		client.On(testsuite.GetFunctionName(client.SuggestGasPrice), mock.Anything).Once().Return(big.NewInt(200*params.GWei), nil)
+		err = ts.SetGasPrice(s.GetTestContext(), client, transactor, chainID, prevTx)
+		s.Require().NoError(err)
+		s.Equal(big.NewInt(115*params.GWei), transactor.GasPrice, testsuite.BigIntComparer())
+	})
+
+	s.Run("BudgetTooLowToReplace", func() {
+		transactor.GasPrice = nil
+		transactor.GasLimit = prevTx.Gas()
+		// the budget price of 100 gwei isn't a valid replacement.
+		maxTotalCost.SetUint64(11_000_000 * params.GWei)
+		client.On(testsuite.GetFunctionName(client.SuggestGasPrice), mock.Anything).Once().Return(big.NewInt(50*params.GWei), nil)
+		err = ts.SetGasPrice(s.GetTestContext(), client, transactor, chainID, prevTx)
+		s.ErrorIs(err, submitter.ErrMaxCostExceeded)
+	})
+}
+
 func (s *SubmitterSuite) TestGetGasBlock() {
 	wall, err := wallet.FromRandom()
 	s.Require().NoError(err)
@@ -355,7 +412,7 @@ func (s *SubmitterSuite) TestCheckAndSetConfirmation() {
 	err = ts.CheckAndSetConfirmation(s.GetTestContext(), chainClient, allTxes)
 	s.Require().NoError(err)
 
-	txs, err := s.store.GetAllTXAttemptByStatus(s.GetTestContext(), s.signer.Address(), tb.GetBigChainID(), db.ReplacedOrConfirmed, db.Confirmed, db.Replaced)
+	txs, err := s.store.GetAllTXAttemptByStatus(s.GetTestContext(), s.signer.Address(), tb.GetBigChainID(), db.ReplacedOrConfirmed, db.ConfirmedSuccess, db.Replaced)
 	s.Require().NoError(err)
 
 	var replacedCount int
@@ -364,13 +421,15 @@ func (s *SubmitterSuite) TestCheckAndSetConfirmation() {
 		switch tx.Status {
 		case db.Replaced:
 			replacedCount++
-		case db.Confirmed:
+		case db.ConfirmedSuccess:
 			s.Require().Equal(tx.Hash(), confirmedTx.Hash())
 			// make sure submission status is congruent
 			status, err := ts.GetSubmissionStatus(s.GetTestContext(), tb.GetBigChainID(), tx.Nonce())
 			s.Require().NoError(err)
 			s.Require().Equal(submitter.Confirmed, status.State())
 			s.Require().Equal(confirmedTx.Hash(), status.TxHash())
+			s.Require().NotZero(status.GasUsed())
+			s.Require().Empty(status.RevertReason())
 
 		default:
 			s.Failf("unexpected status: %s", tx.Status.String())
@@ -397,16 +456,177 @@ func (s *SubmitterSuite) TestCheckAndSetConfirmationSingleTx() {
 	err = ts.CheckAndSetConfirmation(s.GetTestContext(), chainClient, allTxes)
 	s.Require().NoError(err)
 
-	txs, err := s.store.GetAllTXAttemptByStatus(s.GetTestContext(), s.signer.Address(), tb.GetBigChainID(), db.ReplacedOrConfirmed, db.Confirmed, db.Replaced)
+	txs, err := s.store.GetAllTXAttemptByStatus(s.GetTestContext(), s.signer.Address(), tb.GetBigChainID(), db.ReplacedOrConfirmed, db.ConfirmedSuccess, db.Replaced)
 	s.Require().NoError(err)
 
 	for _, tx := range txs {
 		//nolint: exhaustive
 		switch tx.Status {
-		case db.Confirmed:
+		case db.ConfirmedSuccess:
 			s.Require().Equal(tx.Hash(), confirmedTx.Hash())
 		default:
 			s.Failf("unexpected status: %s", tx.Status.String())
 		}
 	}
 }
+
+func (s *SubmitterSuite) TestCancelTransaction() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{}
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ogCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	// cancel the tx before the submitter is started so the original can never be mined.
+	err = ts.CancelTransaction(s.GetTestContext(), chainID, nonce)
+	s.Require().NoError(err)
+
+	status, err := ts.GetSubmissionStatus(s.GetTestContext(), chainID, nonce)
+	s.Require().NoError(err)
+	s.Equal(submitter.Cancelled, status.State())
+	s.False(status.HasTx())
+
+	// a nonce can only be cancelled once.
+	err = ts.CancelTransaction(s.GetTestContext(), chainID, nonce)
+	s.Require().ErrorIs(err, submitter.ErrNotCancellable)
+
+	go func() {
+		err = ts.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	// wait for the cancellation to be mined.
+	s.Eventually(func() bool {
+		status, err = ts.GetSubmissionStatus(s.GetTestContext(), chainID, nonce)
+		s.Require().NoError(err)
+
+		return status.State() == submitter.Cancelled && status.HasTx()
+	})
+
+	currentCounter, err := cntr.GetCount(&bind.CallOpts{
+		Context: s.GetTestContext(),
+	})
+	s.Require().NoError(err)
+	s.Equal(ogCounter.Uint64(), currentCounter.Uint64())
+}
+
+func (s *SubmitterSuite) TestSubscribeStatus() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{}
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+
+	statuses := ts.SubscribeStatus(s.GetTestContext(), chainID, nonce)
+
+	// the current status is sent before the submitter is started.
+	status := <-statuses
+	s.Equal(submitter.Pending, status.State())
+	s.Equal(db.Stored, status.AttemptStatus())
+	s.NotEqual(common.Hash{}, status.AttemptHash())
+
+	// collect the statuses as they're published, the subscription is closed after the final status.
+	received := make(chan []submitter.SubmissionStatus, 1)
+	go func() {
+		var all []submitter.SubmissionStatus
+		for status := range statuses {
+			all = append(all, status)
+		}
+		received <- all
+	}()
+
+	go func() {
+		err = ts.Start(s.GetTestContext())
+		s.Require().NoError(err)
+	}()
+
+	confirmed, err := ts.WaitForConfirmation(s.GetTestContext(), chainID, nonce, 1)
+	s.Require().NoError(err)
+	s.Equal(submitter.Confirmed, confirmed.State())
+
+	all := <-received
+	s.Require().NotEmpty(all)
+
+	// the submission is published before the tx is confirmed.
+	var submitted bool
+	for _, status := range all {
+		if status.State() == submitter.Pending && status.AttemptStatus() == db.Submitted {
+			submitted = true
+		}
+	}
+	s.True(submitted)
+
+	last := all[len(all)-1]
+	s.Equal(submitter.Confirmed, last.State())
+	s.Equal(confirmed.TxHash(), last.TxHash())
+	s.Equal(confirmed.TxHash(), last.AttemptHash())
+}
+
+func (s *SubmitterSuite) TestSimulateBeforeSubmit() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	cfg := &config.Config{
+		ChainConfig: config.ChainConfig{
+			SimulateBeforeSubmit: true,
+		},
+	}
+	chainID := s.testBackends[0].GetBigChainID()
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+	_, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		// the signer isn't vitalik, so this reverts.
+		tx, err = cntr.VitalikIncrement(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+
+	var revertErr *submitter.SimulationRevertError
+	s.Require().ErrorAs(err, &revertErr)
+	s.Equal("Only Vitalik can count by 10", revertErr.Reason)
+
+	// the reverted tx was never stored, so its nonce is reused.
+	expectedNonce, err := ts.GetNonce(s.GetTestContext(), chainID, s.signer.Address())
+	s.Require().NoError(err)
+
+	nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+		tx, err = cntr.IncrementCounter(transactor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to increment counter: %w", err)
+		}
+
+		return tx, nil
+	})
+	s.Require().NoError(err)
+	s.Equal(expectedNonce, nonce)
+}
@@ -0,0 +1,240 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/observer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// ErrCancelled is returned by WaitForConfirmation when the nonce was cancelled rather than confirmed.
+	ErrCancelled = errors.New("transaction was cancelled")
+	// ErrReverted is returned by WaitForConfirmation when the transaction was confirmed, but reverted.
+	ErrReverted = errors.New("transaction reverted")
+)
+
+// statusKey identifies the nonce a status subscription is for.
+type statusKey struct {
+	chainID uint64
+	nonce   uint64
+}
+
+func newStatusKey(chainID *big.Int, nonce uint64) statusKey {
+	return statusKey{chainID: chainID.Uint64(), nonce: nonce}
+}
+
+// isFinal returns true if the status can no longer change.
+func isFinal(status SubmissionStatus) bool {
+	return status.HasTx()
+}
+
+// watchStatus subscribes to the status of the nonce every time it is published.
+// The returned func must be called to unsubscribe.
+func (t *txSubmitterImpl) watchStatus(key statusKey) (<-chan SubmissionStatus, func()) {
+	// only the latest status is useful, so slow subscribers skip intermediate ones.
+	updates, sub := t.statusObserver.Subscribe(key, observer.WithBufferSize(1), observer.WithPolicy(observer.DropOldest))
+
+	t.watchMux.Lock()
+	t.watched[key]++
+	t.watchMux.Unlock()
+
+	return updates, func() {
+		t.watchMux.Lock()
+		t.watched[key]--
+		if t.watched[key] <= 0 {
+			delete(t.watched, key)
+		}
+		t.watchMux.Unlock()
+
+		sub.Unsubscribe()
+	}
+}
+
+// publishStatuses emits the current status of every watched nonce. This is called each time the confirmed queue
+// is processed, so subscribers see state transitions as soon as the submitter does.
+func (t *txSubmitterImpl) publishStatuses(parentCtx context.Context) {
+	t.watchMux.Lock()
+	keys := make([]statusKey, 0, len(t.watched))
+	for key := range t.watched {
+		keys = append(keys, key)
+	}
+	t.watchMux.Unlock()
+
+	if len(keys) == 0 {
+		return
+	}
+
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.publishStatuses", trace.WithAttributes(
+		attribute.Int("watched", len(keys)),
+	))
+	defer span.End()
+
+	for _, key := range keys {
+		t.emitStatus(ctx, key)
+	}
+}
+
+// publishStatus emits the current status of the nonce if it is watched. This is called whenever an attempt for
+// the nonce is stored, submitted, bumped or cancelled.
+func (t *txSubmitterImpl) publishStatus(ctx context.Context, chainID *big.Int, nonce uint64) {
+	key := newStatusKey(chainID, nonce)
+
+	t.watchMux.Lock()
+	_, watched := t.watched[key]
+	t.watchMux.Unlock()
+
+	if watched {
+		t.emitStatus(ctx, key)
+	}
+}
+
+// emitStatus emits the current status of the nonce to its subscribers.
+func (t *txSubmitterImpl) emitStatus(ctx context.Context, key statusKey) {
+	status, err := t.GetSubmissionStatus(ctx, new(big.Int).SetUint64(key.chainID), key.nonce)
+	if err != nil {
+		trace.SpanFromContext(ctx).AddEvent("could not get submission status", trace.WithAttributes(
+			attribute.String("error", err.Error()), attribute.Int64("chainID", int64(key.chainID)),
+			attribute.Int64("nonce", int64(key.nonce))))
+		return
+	}
+	t.statusObserver.Emit(key, status)
+}
+
+// SubscribeStatus returns a channel that receives the status of the nonce each time it changes, starting with the
+// current status. The channel is closed once the status is final or the context is cancelled.
+//
+// Statuses are published as attempts are stored, submitted, bumped or cancelled and as the confirmed queue is processed,
+// so the submitter must be started. A slow receiver only sees the latest status.
+func (t *txSubmitterImpl) SubscribeStatus(ctx context.Context, chainID *big.Int, nonce uint64) <-chan SubmissionStatus {
+	updates, unsubscribe := t.watchStatus(newStatusKey(chainID, nonce))
+	statuses := make(chan SubmissionStatus, 1)
+
+	go func() {
+		defer close(statuses)
+		defer unsubscribe()
+
+		var last SubmissionStatus
+		// send sends the status if it changed, and returns false if the subscription is done.
+		send := func(status SubmissionStatus) bool {
+			if last != nil && sameStatus(last, status) {
+				return true
+			}
+			last = status
+
+			select {
+			case <-ctx.Done():
+				return false
+			case statuses <- status:
+				return !isFinal(status)
+			}
+		}
+
+		status, err := t.GetSubmissionStatus(ctx, chainID, nonce)
+		if err == nil && !send(status) {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case status, ok := <-updates:
+				if !ok || !send(status) {
+					return
+				}
+			}
+		}
+	}()
+
+	return statuses
+}
+
+// WaitForConfirmation blocks until the nonce is confirmed and the block it was mined in has at least confs
+// confirmations, counting that block. ErrCancelled is returned if the nonce was cancelled instead, and ErrReverted
+// if the transaction reverted.
+//
+// Like SubscribeStatus, this relies on the submitter being started.
+func (t *txSubmitterImpl) WaitForConfirmation(parentCtx context.Context, chainID *big.Int, nonce uint64, confs uint64) (status SubmissionStatus, err error) {
+	ctx, span := t.metrics.Tracer().Start(parentCtx, "submitter.WaitForConfirmation", trace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+		attribute.Int64("nonce", int64(nonce)),
+		attribute.Int64("confs", int64(confs)),
+	))
+	defer func() {
+		metrics.EndSpanWithErr(span, err)
+	}()
+
+	// subscribe before getting the current status so no update is missed.
+	updates, unsubscribe := t.watchStatus(newStatusKey(chainID, nonce))
+	defer unsubscribe()
+
+	status, err = t.GetSubmissionStatus(ctx, chainID, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("could not get submission status: %w", err)
+	}
+
+	// minedIn is the block the tx was mined in, fetched once the status is final.
+	var minedIn uint64
+	for {
+		if isFinal(status) {
+			switch status.State() {
+			case Cancelled:
+				return status, fmt.Errorf("nonce %d on chain %s: %w", nonce, chainID, ErrCancelled)
+			case Reverted:
+				return status, fmt.Errorf("nonce %d on chain %s: %w: %s", nonce, chainID, ErrReverted, status.RevertReason())
+			}
+
+			done, err := t.hasConfirmations(ctx, chainID, status, confs, &minedIn)
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				return status, nil
+			}
+		}
+
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("context done while waiting for confirmation: %w", ctx.Err())
+		case status, ok = <-updates:
+			if !ok {
+				return nil, fmt.Errorf("status subscription closed for nonce %d on chain %s", nonce, chainID)
+			}
+		}
+	}
+}
+
+// hasConfirmations checks if the confirmed tx has at least confs confirmations.
+// minedIn caches the block the tx was mined in across calls.
+func (t *txSubmitterImpl) hasConfirmations(ctx context.Context, chainID *big.Int, status SubmissionStatus, confs uint64, minedIn *uint64) (bool, error) {
+	if confs <= 1 {
+		return true, nil
+	}
+
+	chainClient, err := t.fetcher.GetClient(ctx, chainID)
+	if err != nil {
+		return false, fmt.Errorf("could not get client: %w", err)
+	}
+
+	if *minedIn == 0 {
+		receipt, err := chainClient.TransactionReceipt(ctx, status.TxHash())
+		if err != nil {
+			return false, fmt.Errorf("could not get receipt: %w", err)
+		}
+		*minedIn = receipt.BlockNumber.Uint64()
+	}
+
+	latest, err := chainClient.BlockNumber(ctx)
+	if err != nil {
+		return false, fmt.Errorf("could not get block number: %w", err)
+	}
+
+	return latest+1 >= *minedIn+confs, nil
+}
@@ -0,0 +1,225 @@
+package submitter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	throttleMeterName = "github.com/synapsecns/sanguine/ethergo/submitter"
+	queueDepthName    = "submitter_queue_depth"
+	throttleTimeName  = "submitter_throttle_time_ms"
+)
+
+// rateWindow is the window max_tx_per_minute is measured over.
+const rateWindow = time.Minute
+
+// throttler holds the state of the per chain throughput limits for every lane. It's shared by the lanes in the pool.
+type throttler struct {
+	mux   sync.Mutex
+	lanes map[string]*laneThrottle
+	// throttleTime records how long each tx was stored before it was first sent.
+	throttleTime metric.Int64Histogram
+}
+
+// laneThrottle is the throttle state of a signer on a chain.
+type laneThrottle struct {
+	signer  common.Address
+	chainID uint64
+	// hasReleased is true once a tx has been released since the submitter started.
+	hasReleased bool
+	// releasedNonce is the highest nonce that has been released. Txes with a higher nonce haven't been sent yet.
+	releasedNonce uint64
+	// releases are the times nonces were released in the last rateWindow.
+	releases []time.Time
+	// queueDepth is the number of txes held back the last time the chain queue ran.
+	queueDepth uint64
+}
+
+// newThrottler creates a new throttler. Metrics that can't be set up are logged and skipped.
+func newThrottler(handler metrics.Handler) *throttler {
+	t := &throttler{
+		lanes: make(map[string]*laneThrottle),
+	}
+
+	err := t.setupMetrics(handler)
+	if err != nil {
+		logger.Warnf("could not setup throttle metrics: %v", err)
+	}
+	return t
+}
+
+func (t *throttler) setupMetrics(handler metrics.Handler) (err error) {
+	meter := handler.Meter(throttleMeterName)
+
+	t.throttleTime, err = meter.Int64Histogram(throttleTimeName, metric.WithDescription("time a tx was stored before it was sent"), metric.WithUnit("ms"))
+	if err != nil {
+		return fmt.Errorf("could not create histogram: %w", err)
+	}
+
+	queueDepthGauge, err := meter.Int64ObservableGauge(queueDepthName, metric.WithDescription("txes held back by the throughput limits"))
+	if err != nil {
+		return fmt.Errorf("could not create gauge: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		t.mux.Lock()
+		defer t.mux.Unlock()
+
+		for _, lane := range t.lanes {
+			o.ObserveInt64(queueDepthGauge, int64(lane.queueDepth), metric.WithAttributes(
+				attribute.String("signer", lane.signer.String()),
+				attribute.Int64(metrics.ChainID, int64(lane.chainID)),
+			))
+		}
+		return nil
+	}, queueDepthGauge)
+	if err != nil {
+		return fmt.Errorf("could not register callback: %w", err)
+	}
+
+	return nil
+}
+
+// lane gets the throttle state of a lane. The throttler must be locked.
+func (t *throttler) lane(key laneKey) *laneThrottle {
+	lane, ok := t.lanes[key.String()]
+	if !ok {
+		lane = &laneThrottle{signer: key.signer, chainID: key.chainID.Uint64()}
+		t.lanes[key.String()] = lane
+	}
+	return lane
+}
+
+// defaultThrottledWindow is the number of pending nonces loaded for a chain with throughput limits but no
+// max_in_flight.
+const defaultThrottledWindow = 50
+
+// getPendingTXS gets the pending txes the chain queue works through. Without throughput limits this is the db's
+// default window. With them, the window has to cover every nonce the limits could release, otherwise they'd only ever
+// see the lowest pending nonce.
+func (t *txSubmitterImpl) getPendingTXS(ctx context.Context, chainID *big.Int) (txes []db.TX, err error) {
+	chainIDInt := int(chainID.Uint64())
+
+	window := t.config.GetMaxInFlight(chainIDInt)
+	if window == 0 && (t.config.GetMaxTxPerMinute(chainIDInt) > 0 || t.config.GetMaxPendingValue(chainIDInt) != nil) {
+		window = defaultThrottledWindow
+	}
+
+	if window == 0 {
+		txes, err = t.db.GetTXS(ctx, t.signer.Address(), chainID, pendingStatuses...)
+	} else {
+		txes, err = t.db.GetTXSWithLimit(ctx, t.signer.Address(), chainID, window, pendingStatuses...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get pending txes: %w", err)
+	}
+	return txes, nil
+}
+
+// releaseTXS returns the pending txes that can be sent to the chain under its throughput limits. txes must be sorted
+// by nonce. Once a tx is held back, every tx after it is held back too, so nonces are always sent in order.
+// Held back txes stay stored and are released on a later run, as earlier txes are confirmed.
+func (t *txSubmitterImpl) releaseTXS(ctx context.Context, chainID *big.Int, currentNonce uint64, txes []db.TX) (released []db.TX) {
+	released, reason := t.throttle(ctx, chainID, currentNonce, txes)
+	if reason == "" {
+		t.setQueueDepth(chainID, 0)
+		return released
+	}
+
+	// every tx before the held one was released, so the rest are held.
+	held := txes[len(released):]
+	// the depth comes from the db, so it's fetched without holding the throttler, which every lane shares.
+	queueDepth := t.queueDepth(ctx, chainID, held[0].Nonce(), uint64(len(held)))
+	t.setQueueDepth(chainID, queueDepth)
+
+	trace.SpanFromContext(ctx).AddEvent("throttled", trace.WithAttributes(
+		attribute.String("reason", reason),
+		attribute.Int64("nonce", int64(held[0].Nonce())),
+		attribute.Int64("queue_depth", int64(queueDepth)),
+	))
+	return released
+}
+
+// throttle applies the throughput limits to txes. It returns the txes that are released and, if a tx is held back,
+// the reason why.
+func (t *txSubmitterImpl) throttle(ctx context.Context, chainID *big.Int, currentNonce uint64, txes []db.TX) (released []db.TX, reason string) {
+	chainIDInt := int(chainID.Uint64())
+	maxInFlight := t.config.GetMaxInFlight(chainIDInt)
+	maxTxPerMinute := t.config.GetMaxTxPerMinute(chainIDInt)
+	maxPendingValue := t.config.GetMaxPendingValue(chainIDInt)
+
+	t.throttler.mux.Lock()
+	defer t.throttler.mux.Unlock()
+
+	lane := t.throttler.lane(t.laneKey(chainID))
+
+	now := time.Now()
+	for len(lane.releases) > 0 && now.Sub(lane.releases[0]) >= rateWindow {
+		lane.releases = lane.releases[1:]
+	}
+
+	pendingValue := new(big.Int)
+	for _, tx := range txes {
+		// already confirmed, these are only used to update the statuses.
+		if tx.Nonce() < currentNonce {
+			released = append(released, tx)
+			continue
+		}
+
+		isNew := !lane.hasReleased || tx.Nonce() > lane.releasedNonce
+		pendingValue.Add(pendingValue, tx.Value())
+
+		switch {
+		case maxInFlight > 0 && tx.Nonce() >= currentNonce+uint64(maxInFlight):
+			return released, "max_in_flight"
+		// the tx at the on-chain nonce is always sent, so a single tx over the limit can't block the queue.
+		case maxPendingValue != nil && tx.Nonce() != currentNonce && pendingValue.Cmp(maxPendingValue) > 0:
+			return released, "max_pending_value"
+		case isNew && maxTxPerMinute > 0 && len(lane.releases) >= maxTxPerMinute:
+			return released, "max_tx_per_minute"
+		}
+
+		if isNew {
+			lane.hasReleased = true
+			lane.releasedNonce = tx.Nonce()
+			lane.releases = append(lane.releases, now)
+			if t.throttler.throttleTime != nil {
+				t.throttler.throttleTime.Record(ctx, now.Sub(tx.CreationTime()).Milliseconds(), metric.WithAttributes(
+					attribute.Int(metrics.ChainID, chainIDInt),
+				))
+			}
+		}
+		released = append(released, tx)
+	}
+	return released, ""
+}
+
+// setQueueDepth sets the number of txes the lane is holding back on the chain.
+func (t *txSubmitterImpl) setQueueDepth(chainID *big.Int, queueDepth uint64) {
+	t.throttler.mux.Lock()
+	defer t.throttler.mux.Unlock()
+
+	t.throttler.lane(t.laneKey(chainID)).queueDepth = queueDepth
+}
+
+// queueDepth returns the number of stored txes from heldNonce on. The chain queue only sees some of the pending txes,
+// so this is calculated from the db nonce. held is the number of txes the chain queue is holding back, which is
+// used if the db nonce can't be fetched.
+func (t *txSubmitterImpl) queueDepth(ctx context.Context, chainID *big.Int, heldNonce uint64, held uint64) uint64 {
+	dbNonce, err := t.db.GetNonceForChainID(ctx, t.signer.Address(), chainID)
+	if err != nil || dbNonce < heldNonce {
+		return held
+	}
+	return dbNonce - heldNonce + 1
+}
@@ -0,0 +1,110 @@
+package submitter_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/synapsecns/sanguine/ethergo/example"
+	"github.com/synapsecns/sanguine/ethergo/example/counter"
+	"github.com/synapsecns/sanguine/ethergo/manager"
+	"github.com/synapsecns/sanguine/ethergo/signer/signer/localsigner"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/config"
+	"github.com/synapsecns/sanguine/ethergo/submitter/db"
+)
+
+func (t *TXSubmitterDBSuite) TestReleaseTXS() {
+	t.RunOnAllDBs(func(testDB db.Service) {
+		backend := t.testBackends[0]
+		chainID := backend.GetBigChainID()
+		mockAccount := t.mockAccounts[0]
+
+		// nonces 1 through 5 are stored, each sending 1 wei.
+		var txes []db.TX
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+				To:       &mockAccount.Address,
+				Value:    big.NewInt(1),
+				Nonce:    nonce,
+				GasPrice: big.NewInt(1),
+			}), backend.Signer(), mockAccount.PrivateKey)
+			t.Require().NoError(err)
+
+			stored := db.NewTX(tx, db.Stored, uuid.New().String())
+			t.Require().NoError(testDB.PutTXS(t.GetTestContext(), stored))
+			txes = append(txes, stored)
+		}
+
+		releasedNonces := func(cfg config.ChainConfig, currentNonce uint64, runs int) (nonces []uint64) {
+			ts := submitter.NewTestTransactionSubmitter(t.metrics, localsigner.NewSigner(mockAccount.PrivateKey), nil, testDB, &config.Config{
+				Chains: map[int]config.ChainConfig{int(chainID.Uint64()): cfg},
+			})
+			for i := 0; i < runs; i++ {
+				nonces = nil
+				for _, tx := range ts.ReleaseTXS(t.GetTestContext(), chainID, currentNonce, txes) {
+					nonces = append(nonces, tx.Nonce())
+				}
+			}
+			return nonces
+		}
+
+		// without limits, everything is released.
+		t.Require().Equal([]uint64{1, 2, 3, 4, 5}, releasedNonces(config.ChainConfig{}, 1, 1))
+
+		// txes below the on-chain nonce are always passed through.
+		t.Require().Equal([]uint64{1, 2, 3, 4}, releasedNonces(config.ChainConfig{MaxInFlight: 2}, 3, 1))
+
+		// the tx at the on-chain nonce is released even if it's over the pending value limit.
+		t.Require().Equal([]uint64{1}, releasedNonces(config.ChainConfig{MaxPendingValue: big.NewInt(0)}, 1, 1))
+		t.Require().Equal([]uint64{1, 2, 3}, releasedNonces(config.ChainConfig{MaxPendingValue: big.NewInt(3)}, 1, 1))
+
+		// txes that were already released don't count towards the rate limit when they're bumped.
+		t.Require().Equal([]uint64{1, 2}, releasedNonces(config.ChainConfig{MaxTxPerMinute: 2}, 1, 1))
+		t.Require().Equal([]uint64{1, 2}, releasedNonces(config.ChainConfig{MaxTxPerMinute: 2}, 1, 3))
+	})
+}
+
+func (s *SubmitterSuite) TestProcessQueueThrottled() {
+	_, cntr := manager.GetContract[*counter.CounterRef](s.GetTestContext(), s.T(),
+		s.deployer, s.testBackends[0], example.CounterType)
+
+	chainID := s.testBackends[0].GetBigChainID()
+	cfg := &config.Config{
+		Chains: map[int]config.ChainConfig{
+			int(chainID.Uint64()): {MaxInFlight: 3},
+		},
+	}
+
+	ts := submitter.NewTestTransactionSubmitter(s.metrics, s.signer, s, s.store, cfg)
+
+	var nonces []uint64
+	for i := 0; i < 5; i++ {
+		nonce, err := ts.SubmitTransaction(s.GetTestContext(), chainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+			tx, err = cntr.IncrementCounter(transactor)
+			if err != nil {
+				return nil, fmt.Errorf("failed to increment counter: %w", err)
+			}
+			return tx, nil
+		})
+		s.Require().NoError(err)
+		nonces = append(nonces, nonce)
+	}
+
+	// a single run sends the whole in flight window and holds back the rest.
+	err := ts.ProcessQueue(s.GetTestContext())
+	s.Require().NoError(err)
+
+	for i, nonce := range nonces {
+		status, err := s.store.GetNonceStatus(s.GetTestContext(), s.signer.Address(), chainID, nonce)
+		s.Require().NoError(err)
+
+		if i < 3 {
+			s.NotEqual(db.Stored, status, "nonce %d should have been sent", nonce)
+		} else {
+			s.Equal(db.Stored, status, "nonce %d should have been held back", nonce)
+		}
+	}
+}
@@ -2,22 +2,29 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/synapsecns/sanguine/core"
 	"github.com/synapsecns/sanguine/core/testsuite"
 	"github.com/synapsecns/sanguine/ethergo/backends/simulated"
+	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
 	"github.com/synapsecns/sanguine/ethergo/mocks"
 	"github.com/synapsecns/sanguine/ethergo/submitter"
 	"github.com/synapsecns/sanguine/ethergo/submitter/db"
@@ -277,6 +284,103 @@ func (s *SubmitterSuite) TestGroupTxesByNonce() {
 	}
 }
 
+// dataError is an rpc error carrying revert data.
+type dataError struct {
+	data string
+}
+
+func (d dataError) Error() string {
+	return "execution reverted"
+}
+
+func (d dataError) ErrorData() interface{} {
+	return d.data
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	// Error(string) with the reason "not enough"
+	errorString := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000a" +
+		"6e6f7420656e6f75676800000000000000000000000000000000000000000000"
+	assert.Equal(t, "not enough", submitter.DecodeRevertReason(dataError{data: errorString}))
+
+	// custom errors are returned hex encoded.
+	customError := "0x2f8b2d1c"
+	assert.Equal(t, customError, submitter.DecodeRevertReason(dataError{data: customError}))
+
+	// errors without data fall back to the error message.
+	assert.Equal(t, "execution reverted", submitter.DecodeRevertReason(errors.New("execution reverted")))
+
+	// custom errors defined in the abis are decoded.
+	contractABI, caller, customErrorData := unauthorizedError(t)
+	assert.Equal(t, fmt.Sprintf("Unauthorized(%s)", caller), submitter.DecodeRevertReason(
+		dataError{data: hexutil.Encode(customErrorData)}, contractABI))
+}
+
+func TestGetRevertReasonReplaysParentBlock(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	assert.NilError(t, err)
+
+	chainID := big.NewInt(1)
+	to := common.BigToAddress(big.NewInt(1))
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		To:       &to,
+		Value:    big.NewInt(1),
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	}), types.LatestSignerForChainID(chainID), key)
+	assert.NilError(t, err)
+
+	// the tx was mined in block 10 and reverted because of the state it ran against. A later tx in block 10 changed
+	// that state, so the call only reverts against block 9.
+	errorString := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000a" +
+		"6e6f7420656e6f75676800000000000000000000000000000000000000000000"
+	chainClient := new(clientMocks.EVM)
+	chainClient.On(testsuite.GetFunctionName(chainClient.CallContract), mock.Anything, mock.Anything, big.NewInt(9)).
+		Return(nil, dataError{data: errorString})
+	chainClient.On(testsuite.GetFunctionName(chainClient.CallContract), mock.Anything, mock.Anything, big.NewInt(10)).
+		Return([]byte{}, nil)
+
+	assert.Equal(t, "not enough", submitter.GetRevertReason(context.Background(), chainClient, tx, big.NewInt(10)))
+}
+
+// unauthorizedError returns an abi with an Unauthorized(address) custom error, the caller and the revert data.
+func unauthorizedError(t *testing.T) (*abi.ABI, common.Address, []byte) {
+	t.Helper()
+
+	contractABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"}]}]`))
+	assert.NilError(t, err)
+
+	caller := common.BigToAddress(big.NewInt(1))
+	customErr := contractABI.Errors["Unauthorized"]
+	args, err := customErr.Inputs.Pack(caller)
+	assert.NilError(t, err)
+
+	return &contractABI, caller, append(common.CopyBytes(customErr.ID[:4]), args...)
+}
+
+func TestNewSimulationRevertError(t *testing.T) {
+	contractABI, caller, customErrorData := unauthorizedError(t)
+
+	revertErr := submitter.NewSimulationRevertError(customErrorData, contractABI)
+	assert.Equal(t, "Unauthorized", revertErr.CustomError.Name)
+	assert.DeepEqual(t, []interface{}{caller}, revertErr.Args)
+	assert.Equal(t, fmt.Sprintf("Unauthorized(%s)", caller), revertErr.Reason)
+
+	// without the abi, the custom error can't be decoded.
+	revertErr = submitter.NewSimulationRevertError(customErrorData)
+	assert.Assert(t, revertErr.CustomError == nil)
+	assert.Equal(t, hexutil.Encode(customErrorData), revertErr.Reason)
+
+	// reverts without data are still reverts.
+	revertErr = submitter.NewSimulationRevertError(nil)
+	assert.Equal(t, "execution reverted", revertErr.Reason)
+	assert.Equal(t, "transaction reverted in simulation: execution reverted", revertErr.Error())
+}
+
 func makeAttrMap(tx *types.Transaction, UUID string) map[string]attribute.Value {
 	mapAttr := make(map[string]attribute.Value)
 	attr := submitter.TxToAttributes(tx, UUID)
@@ -285,3 +389,44 @@ func makeAttrMap(tx *types.Transaction, UUID string) map[string]attribute.Value
 	}
 	return mapAttr
 }
+
+func TestSummarizeNonce(t *testing.T) {
+	chainID := big.NewInt(1)
+	newAttempt := func(gasPrice int64, status db.Status, age time.Duration) db.TX {
+		tx := db.NewTX(types.NewTx(&types.LegacyTx{Nonce: 3, GasPrice: big.NewInt(gasPrice)}), status, "")
+		tx.UnsafeSetCreationTime(time.Now().Add(-age))
+		return tx
+	}
+
+	// the latest pending attempt is reported, and the age is from the first attempt.
+	summary, ok := submitter.SummarizeNonce(chainID, 3, []db.TX{
+		newAttempt(1, db.Submitted, time.Minute),
+		newAttempt(2, db.Submitted, time.Second),
+	}, time.Hour)
+	assert.Assert(t, ok)
+	assert.Equal(t, submitter.NoncePending, summary.State)
+	assert.Equal(t, 2, summary.Attempts)
+	assert.Equal(t, int64(2), summary.Latest.GasPrice().Int64())
+	assert.Assert(t, summary.Age >= time.Minute)
+
+	summary, ok = submitter.SummarizeNonce(chainID, 3, []db.TX{newAttempt(1, db.Submitted, time.Hour)}, time.Minute)
+	assert.Assert(t, ok)
+	assert.Equal(t, submitter.NonceStuck, summary.State)
+
+	// failed takes precedence over stuck.
+	summary, ok = submitter.SummarizeNonce(chainID, 3, []db.TX{newAttempt(1, db.FailedSubmit, time.Hour)}, time.Minute)
+	assert.Assert(t, ok)
+	assert.Equal(t, submitter.NonceFailed, summary.State)
+
+	// cancelled attempts are counted, but the cancellation is the latest attempt.
+	summary, ok = submitter.SummarizeNonce(chainID, 3, []db.TX{
+		newAttempt(1, db.Cancelled, time.Minute),
+		newAttempt(2, db.Stored, time.Hour),
+	}, time.Hour*2)
+	assert.Assert(t, ok)
+	assert.Equal(t, 2, summary.Attempts)
+	assert.Equal(t, db.Stored, summary.Latest.Status)
+
+	_, ok = submitter.SummarizeNonce(chainID, 3, []db.TX{newAttempt(1, db.ConfirmedSuccess, time.Minute)}, time.Minute)
+	assert.Assert(t, !ok)
+}
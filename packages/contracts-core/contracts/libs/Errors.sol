@@ -50,6 +50,7 @@ error UnformattedStateReport();
 
 // ═══════════════════════════════ MERKLE TREES ════════════════════════════════
 
+error IncorrectMultiProof();
 error LeafNotProven();
 error MerkleTreeFull();
 error NotEnoughLeafs();
@@ -1,7 +1,7 @@
 // SPDX-License-Identifier: MIT
 pragma solidity 0.8.17;
 
-import {TreeHeightTooLow} from "../Errors.sol";
+import {IncorrectMultiProof, IndexOutOfRange, TreeHeightTooLow} from "../Errors.sol";
 
 library MerkleMath {
     // ═════════════════════════════════════════ BASIC MERKLE CALCULATIONS ═════════════════════════════════════════════
@@ -38,6 +38,68 @@ library MerkleMath {
         }
     }
 
+    /**
+     * @notice Calculates the merkle root for several leafs and their multi-leaf proof of inclusion.
+     * The proof only contains the siblings that could not be derived from the leafs themselves, or from `count`
+     * (siblings that are still empty). The siblings are ordered from leafs to root, and by index within
+     * the same level. This matches the proofs generated by `MultiProof` in the Go merkle package.
+     * > Note: `indices` and `leafs` values are overwritten in the process to avoid excessive memory allocations.
+     * Caller is expected not to reuse them after the call.
+     * @dev Will revert if indices are not strictly increasing, or are not less than `count`.
+     * Will revert if the proof has too few or too many elements.
+     * @param indices   Indices of `leafs` in the tree (to be overwritten)
+     * @param leafs     Leafs of the merkle tree (to be overwritten)
+     * @param count     Amount of leafs inserted in the tree
+     * @param proof     Multi-leaf proof of inclusion of `leafs` in the tree
+     * @param height    Height of the merkle tree
+     * @return root_    Calculated Merkle Root
+     */
+    function multiProofRoot(
+        uint256[] memory indices,
+        bytes32[] memory leafs,
+        uint256 count,
+        bytes32[] memory proof,
+        uint256 height
+    ) internal pure returns (bytes32 root_) {
+        uint256 levelLength = indices.length;
+        if (levelLength == 0 || levelLength != leafs.length) revert IncorrectMultiProof();
+        if (height < 256 && count > (1 << height)) revert TreeHeightTooLow();
+        for (uint256 i = 0; i < levelLength; ++i) {
+            if (indices[i] >= count || (i > 0 && indices[i] <= indices[i - 1])) revert IndexOutOfRange();
+        }
+        uint256 proofIndex = 0;
+        /// @dev h, i, next and proofIndex never overflow
+        unchecked {
+            // Go up the tree levels from the leafs, keeping the nodes of the current level in place
+            for (uint256 h = 0; h < height; ++h) {
+                uint256 next = 0;
+                for (uint256 i = 0; i < levelLength; ++i) {
+                    uint256 index = indices[i];
+                    bytes32 node = leafs[i];
+                    bytes32 sibling;
+                    if (index & 1 == 0 && i + 1 < levelLength && indices[i + 1] == index ^ 1) {
+                        // Sibling is the next proven node on this level
+                        sibling = leafs[++i];
+                    } else if (count <= ((index ^ 1) << h)) {
+                        // Sibling is EMPTY: none of its leafs were inserted
+                        sibling = bytes32(0);
+                    } else {
+                        if (proofIndex >= proof.length) revert IncorrectMultiProof();
+                        sibling = proof[proofIndex++];
+                    }
+                    // Record the parent in the same arrays. This will not affect
+                    // further calculations for the same level: next <= i.
+                    leafs[next] = index & 1 == 0 ? getParent(node, sibling) : getParent(sibling, node);
+                    indices[next] = index >> 1;
+                    ++next;
+                }
+                levelLength = next;
+            }
+        }
+        if (proofIndex != proof.length) revert IncorrectMultiProof();
+        return leafs[0];
+    }
+
     /**
      * @notice Calculates the parent of a node on the path from one of the leafs to root.
      * @param node          Node on a path from tree leaf to root
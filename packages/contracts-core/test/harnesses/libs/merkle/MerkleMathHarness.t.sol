@@ -18,6 +18,16 @@ contract MerkleMathHarness {
         return MerkleMath.proofRoot(index, leaf, proof, height);
     }
 
+    function multiProofRoot(
+        uint256[] memory indices,
+        bytes32[] memory leafs,
+        uint256 count,
+        bytes32[] memory proof,
+        uint256 height
+    ) public pure returns (bytes32) {
+        return MerkleMath.multiProofRoot(indices, leafs, count, proof, height);
+    }
+
     function getParent(bytes32 node, bytes32 sibling, uint256 leafIndex, uint256 nodeHeight)
         public
         pure
@@ -1,15 +1,20 @@
 // SPDX-License-Identifier: MIT
 pragma solidity 0.8.17;
 
+import {IncorrectMultiProof, IndexOutOfRange} from "../../../../contracts/libs/Errors.sol";
 import {MerkleMath} from "../../../../contracts/libs/merkle/MerkleMath.sol";
 
 import {SynapseLibraryTest} from "../../../utils/SynapseLibraryTest.t.sol";
 import {MerkleMathHarness} from "../../../harnesses/libs/merkle/MerkleMathHarness.t.sol";
 
+import {Strings} from "@openzeppelin/contracts/utils/Strings.sol";
+
 // solhint-disable func-name-mixedcase
 contract MerkleMathLibraryTest is SynapseLibraryTest {
     uint256 public constant HEIGHT = 8;
     uint256 public constant MAX_LENGTH = 1 << HEIGHT;
+    /// @dev Amount of cases in test/fixtures/multiproof.json
+    uint256 public constant GO_PARITY_CASES = 4;
 
     MerkleMathHarness public libHarness;
 
@@ -88,8 +93,138 @@ contract MerkleMathLibraryTest is SynapseLibraryTest {
         assertEq(root, expected);
     }
 
+    function test_multiProofRoot(uint256 length, uint256 seed) public {
+        // length should be in [1 .. MAX_LENGTH] range
+        length = bound(length, 1, MAX_LENGTH);
+        bytes32[] memory hashes = generateHashes(length);
+        bytes32 expectedRoot = calculateRoot(extendHashes(hashes));
+        uint256[] memory indices = generateIndices(length, seed);
+        bytes32[] memory leafs = new bytes32[](indices.length);
+        for (uint256 i = 0; i < indices.length; ++i) {
+            leafs[i] = hashes[indices[i]];
+        }
+        bytes32[] memory proof = calculateMultiProof(hashes, indices);
+        bytes32 root = libHarness.multiProofRoot(indices, leafs, length, proof, HEIGHT);
+        assertEq(root, expectedRoot, "!multiProofRoot");
+    }
+
+    function test_multiProofRoot_revert_incorrectProofLength() public {
+        bytes32[] memory hashes = generateHashes(10);
+        uint256[] memory indices = new uint256[](2);
+        indices[0] = 2;
+        indices[1] = 8;
+        bytes32[] memory leafs = new bytes32[](2);
+        leafs[0] = hashes[2];
+        leafs[1] = hashes[8];
+        bytes32[] memory proof = calculateMultiProof(hashes, indices);
+        bytes32[] memory longProof = new bytes32[](proof.length + 1);
+        bytes32[] memory shortProof = new bytes32[](proof.length - 1);
+        for (uint256 i = 0; i < proof.length; ++i) {
+            longProof[i] = proof[i];
+            if (i < shortProof.length) shortProof[i] = proof[i];
+        }
+        vm.expectRevert(IncorrectMultiProof.selector);
+        libHarness.multiProofRoot(indices, leafs, 10, longProof, HEIGHT);
+        vm.expectRevert(IncorrectMultiProof.selector);
+        libHarness.multiProofRoot(indices, leafs, 10, shortProof, HEIGHT);
+    }
+
+    function test_multiProofRoot_revert_indexOutOfRange() public {
+        bytes32[] memory hashes = generateHashes(10);
+        uint256[] memory indices = new uint256[](2);
+        bytes32[] memory leafs = new bytes32[](2);
+        // Indices must be strictly increasing
+        indices[0] = 8;
+        indices[1] = 2;
+        vm.expectRevert(IndexOutOfRange.selector);
+        libHarness.multiProofRoot(indices, leafs, 10, new bytes32[](0), HEIGHT);
+        // Indices must be less than count
+        indices[0] = 2;
+        indices[1] = 10;
+        vm.expectRevert(IndexOutOfRange.selector);
+        libHarness.multiProofRoot(indices, leafs, hashes.length, new bytes32[](0), HEIGHT);
+    }
+
+    /// @dev Checks the proofs generated by the Go merkle package, see TestSnapshotMultiProofParity in agents/types.
+    function test_multiProofRoot_goParity() public {
+        string memory json = vm.readFile("test/fixtures/multiproof.json");
+        uint256 height = abi.decode(vm.parseJson(json, ".height"), (uint256));
+        uint256 count = abi.decode(vm.parseJson(json, ".count"), (uint256));
+        bytes32 expectedRoot = abi.decode(vm.parseJson(json, ".root"), (bytes32));
+        bytes32[] memory hashes = abi.decode(vm.parseJson(json, ".leafs"), (bytes32[]));
+        for (uint256 c = 0; c < GO_PARITY_CASES; ++c) {
+            string memory key = string.concat(".cases[", Strings.toString(c), "]");
+            uint256[] memory indices = abi.decode(vm.parseJson(json, string.concat(key, ".indices")), (uint256[]));
+            bytes32[] memory proof = abi.decode(vm.parseJson(json, string.concat(key, ".proof")), (bytes32[]));
+            bytes32[] memory leafs = new bytes32[](indices.length);
+            for (uint256 i = 0; i < indices.length; ++i) {
+                leafs[i] = hashes[indices[i]];
+            }
+            bytes32 root = libHarness.multiProofRoot(indices, leafs, count, proof, height);
+            assertEq(root, expectedRoot, string.concat("!multiProofRoot: case ", Strings.toString(c)));
+        }
+    }
+
     // ══════════════════════════════════════════════════ HELPERS ══════════════════════════════════════════════════════
 
+    /// @dev Generate a multi-leaf proof the straightforward way: walk up the full tree from the given indices,
+    /// skipping the siblings that are proven nodes themselves, or are still EMPTY.
+    function calculateMultiProof(bytes32[] memory hashes, uint256[] memory indices)
+        public
+        pure
+        returns (bytes32[] memory proof)
+    {
+        bytes32[][] memory levels = new bytes32[][](HEIGHT + 1);
+        levels[0] = extendHashes(hashes);
+        for (uint256 h = 0; h < HEIGHT; ++h) {
+            levels[h + 1] = new bytes32[](levels[h].length / 2);
+            for (uint256 i = 0; i < levels[h + 1].length; ++i) {
+                levels[h + 1][i] = getParent(levels[h][2 * i], levels[h][2 * i + 1]);
+            }
+        }
+        proof = new bytes32[](indices.length * HEIGHT);
+        uint256 proofLength = 0;
+        uint256[] memory level = new uint256[](indices.length);
+        for (uint256 i = 0; i < indices.length; ++i) {
+            level[i] = indices[i];
+        }
+        uint256 levelLength = level.length;
+        for (uint256 h = 0; h < HEIGHT; ++h) {
+            uint256 next = 0;
+            for (uint256 i = 0; i < levelLength; ++i) {
+                uint256 index = level[i];
+                if (index % 2 == 0 && i + 1 < levelLength && level[i + 1] == index + 1) {
+                    ++i;
+                } else if (hashes.length > (index ^ 1) << h) {
+                    proof[proofLength++] = levels[h][index ^ 1];
+                }
+                level[next++] = index / 2;
+            }
+            levelLength = next;
+        }
+        // Shrink proof to the used length
+        assembly {
+            mstore(proof, proofLength)
+        }
+    }
+
+    /// @dev Pick a non-empty, strictly increasing list of indices in [0 .. length) range.
+    function generateIndices(uint256 length, uint256 seed) public pure returns (uint256[] memory indices) {
+        indices = new uint256[](length);
+        uint256 amount = 0;
+        for (uint256 i = 0; i < length; ++i) {
+            if (uint256(keccak256(abi.encode(seed, i))) % 4 == 0) {
+                indices[amount++] = i;
+            }
+        }
+        if (amount == 0) {
+            indices[amount++] = seed % length;
+        }
+        assembly {
+            mstore(indices, amount)
+        }
+    }
+
     /// @dev Calculate merkle root for a list of 2**N leafs in the most straightforward way.
     function calculateRoot(bytes32[] memory hashes) public pure returns (bytes32) {
         if (hashes.length == 1) return hashes[0];
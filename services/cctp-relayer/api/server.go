@@ -9,6 +9,7 @@
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/synapsecns/sanguine/core/ginhelper"
 	db2 "github.com/synapsecns/sanguine/services/cctp-relayer/db"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
@@ -20,24 +21,37 @@ type RelayerAPIServer struct {
 	host             string
 	db               db2.CCTPRelayerDB
 	relayRequestChan chan *RelayRequest
+	health           *ginhelper.HealthRegistry
 }
 
 // NewRelayerAPIServer creates a new RelayerAPIServer.
 func NewRelayerAPIServer(port uint16, host string, db db2.CCTPRelayerDB, relayRequestChan chan *RelayRequest) *RelayerAPIServer {
+	health := ginhelper.NewHealthRegistry()
+	if gormStore, ok := db.(interface{ DB() *gorm.DB }); ok {
+		health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+	}
+
 	return &RelayerAPIServer{
 		port:             port,
 		host:             host,
 		db:               db,
 		relayRequestChan: relayRequestChan,
+		health:           health,
 	}
 }
 
+// Health returns the health registry served by the api, so other relayer components can register checks.
+func (r RelayerAPIServer) Health() *ginhelper.HealthRegistry {
+	return r.health
+}
+
 // Start starts the RelayerAPIServer.
 func (r RelayerAPIServer) Start(ctx context.Context) error {
 	engine := gin.Default()
 	engine.GET("/tx", func(ctx *gin.Context) {
 		r.GetTx(ctx)
 	})
+	r.health.Mount(engine)
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%d", r.port),
 		ReadHeaderTimeout: 5 * time.Second,
@@ -8,6 +8,7 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/mysql"
+	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/postgres"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/db/sql/sqlite"
 )
 
@@ -27,6 +28,13 @@ func Connect(ctx context.Context, dbType dbcommon.DBType, path string, metrics m
 			return nil, fmt.Errorf("could not create sqlite store: %w", err)
 		}
 
+		return store, nil
+	case dbcommon.Postgres:
+		store, err := postgres.NewPostgresStore(ctx, path, metrics)
+		if err != nil {
+			return nil, fmt.Errorf("could not create postgres store: %w", err)
+		}
+
 		return store, nil
 	case dbcommon.Clickhouse:
 		return nil, errors.New("driver not supported")
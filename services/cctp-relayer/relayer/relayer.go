@@ -18,6 +18,7 @@
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/core/retry"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/config"
@@ -120,6 +121,23 @@ func NewCCTPRelayer(ctx context.Context, cfg config.Config, store db2.CCTPRelaye
 	}
 	relayerRequestChan := make(chan *api.RelayRequest, 1000)
 	relayerAPI := api.NewRelayerAPIServer(cfg.Port, cfg.Host, store, relayerRequestChan)
+	relayerAPI.Health().Register("scribe", func(ctx context.Context) error {
+		res, err := grpcClient.Check(ctx, &pbscribe.HealthCheckRequest{})
+		if err != nil {
+			return fmt.Errorf("could not check scribe: %w", err)
+		}
+		if res.Status != pbscribe.HealthCheckResponse_SERVING {
+			return fmt.Errorf("scribe not serving: %s", res.Status)
+		}
+		return nil
+	})
+	relayerAPI.Health().Register("message_backlog", ginhelper.ThresholdCheck("message backlog", func(ctx context.Context) (uint64, error) {
+		msgs, err := store.GetMessagesByState(ctx, relayTypes.Pending, relayTypes.Attested)
+		if err != nil {
+			return 0, fmt.Errorf("could not get pending messages: %w", err)
+		}
+		return uint64(len(msgs)), nil
+	}, maxMessageBacklog))
 
 	cctpType, err := cfg.GetCCTPType()
 	if err != nil {
@@ -174,6 +192,9 @@ func (c *CCTPRelayer) triggerProcessQueue(ctx context.Context) {
 
 const defaultRetryInterval = 5 * time.Second
 
+// maxMessageBacklog is the number of unprocessed messages the relayer can hold before it is not ready.
+const maxMessageBacklog = 1000
+
 // getRetryInterval returns the retry interval.
 // on the first try this is 0 and it is the configured interval (or default) after that.
 func (c *CCTPRelayer) getRetryInterval() time.Duration {
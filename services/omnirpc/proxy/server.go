@@ -59,6 +59,7 @@ func (r *RPCProxy) Run(ctx context.Context) {
 	go r.startProxyLoop(ctx)
 
 	router := ginhelper.New(logger)
+	r.healthRegistry().Mount(router)
 	router.Use(r.handler.Gin())
 
 	router.POST("/rpc/:id", func(c *gin.Context) {
@@ -113,6 +114,25 @@ func (r *RPCProxy) Run(ctx context.Context) {
 	}
 }
 
+// healthRegistry creates a health registry that checks each chain has enough rpcs to meet its confirmation threshold.
+func (r *RPCProxy) healthRegistry() *ginhelper.HealthRegistry {
+	health := ginhelper.NewHealthRegistry()
+	for _, chainID := range r.chainManager.GetChainIDs() {
+		chainID := chainID
+		health.Register(fmt.Sprintf("chain_%d", chainID), func(ctx context.Context) error {
+			chain := r.chainManager.GetChain(chainID)
+			if chain == nil {
+				return fmt.Errorf("chain %d not found", chainID)
+			}
+			if len(chain.URLs()) < int(chain.ConfirmationsThreshold()) {
+				return fmt.Errorf("chain %d has %d rpcs, below the confirmation threshold of %d", chainID, len(chain.URLs()), chain.ConfirmationsThreshold())
+			}
+			return nil
+		})
+	}
+	return health
+}
+
 // scanInterval is how long to wait between latency scans.
 const scanInterval = time.Second * 60
 
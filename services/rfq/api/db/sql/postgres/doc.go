@@ -0,0 +1,2 @@
+// Package postgres contains a postgres db
+package postgres
@@ -9,6 +9,7 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/rfq/api/db"
 	"github.com/synapsecns/sanguine/services/rfq/api/db/sql/mysql"
+	"github.com/synapsecns/sanguine/services/rfq/api/db/sql/postgres"
 	"github.com/synapsecns/sanguine/services/rfq/api/db/sql/sqlite"
 )
 
@@ -28,6 +29,13 @@ func Connect(ctx context.Context, dbType dbcommon.DBType, path string, metrics m
 			return nil, fmt.Errorf("could not create sqlite store: %w", err)
 		}
 
+		return store, nil
+	case dbcommon.Postgres:
+		store, err := postgres.NewPostgresStore(ctx, path, metrics)
+		if err != nil {
+			return nil, fmt.Errorf("could not create postgres store: %w", err)
+		}
+
 		return store, nil
 	case dbcommon.Clickhouse:
 		return nil, errors.New("driver not supported")
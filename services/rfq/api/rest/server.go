@@ -21,6 +21,7 @@
 	"github.com/synapsecns/sanguine/services/rfq/api/db"
 	"github.com/synapsecns/sanguine/services/rfq/api/model"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
+	"gorm.io/gorm"
 )
 
 // QuoterAPIServer is a struct that holds the configuration, database connection, gin engine, RPC client, metrics handler, and fast bridge contracts.
@@ -32,6 +33,7 @@ type QuoterAPIServer struct {
 	omnirpcClient       omniClient.RPCClient
 	handler             metrics.Handler
 	fastBridgeContracts map[uint32]*fastbridge.FastBridge
+	health              *ginhelper.HealthRegistry
 }
 
 // NewAPI holds the configuration, database connection, gin engine, RPC client, metrics handler, and fast bridge contracts.
@@ -56,12 +58,24 @@ func NewAPI(
 		return nil, fmt.Errorf("store is nil")
 	}
 
+	health := ginhelper.NewHealthRegistry()
+	if gormStore, ok := store.(interface{ DB() *gorm.DB }); ok {
+		health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+	}
+
 	bridges := make(map[uint32]*fastbridge.FastBridge)
 	for chainID, bridge := range cfg.Bridges {
 		chainClient, err := omniRPCClient.GetChainClient(ctx, int(chainID))
 		if err != nil {
 			return nil, fmt.Errorf("could not create omnirpc client: %w", err)
 		}
+		health.Register(fmt.Sprintf("rpc_%d", chainID), func(ctx context.Context) error {
+			_, err := chainClient.BlockNumber(ctx)
+			if err != nil {
+				return fmt.Errorf("could not get block number: %w", err)
+			}
+			return nil
+		})
 		bridges[chainID], err = fastbridge.NewFastBridge(common.HexToAddress(bridge), chainClient)
 		if err != nil {
 			return nil, fmt.Errorf("could not create bridge contract: %w", err)
@@ -74,6 +88,7 @@ func NewAPI(
 		omnirpcClient:       omniRPCClient,
 		handler:             handler,
 		fastBridgeContracts: bridges,
+		health:              health,
 	}, nil
 }
 
@@ -98,6 +113,7 @@ func (r *QuoterAPIServer) Run(ctx context.Context) error {
 	// engine.PUT("/quotes", h.ModifyQuote)
 	engine.GET(QuoteRoute, h.GetQuotes)
 	engine.GET(fmt.Sprintf("%s/filter", QuoteRoute), h.GetFilteredQuotes)
+	r.health.Mount(engine)
 
 	r.engine = engine
 
@@ -56,6 +56,18 @@ func (c Chain) SubmitTransaction(ctx context.Context, call submitter.ContractCal
 	return c.submitter.SubmitTransaction(ctx, big.NewInt(int64(c.ChainID)), call)
 }
 
+// CancelTransaction cancels a pending transaction on the chain.
+func (c Chain) CancelTransaction(ctx context.Context, nonce uint64) error {
+	//nolint: wrapcheck
+	return c.submitter.CancelTransaction(ctx, big.NewInt(int64(c.ChainID)), nonce)
+}
+
+// GetSubmissionStatus gets the status of a transaction submitted to the chain.
+func (c Chain) GetSubmissionStatus(ctx context.Context, nonce uint64) (submitter.SubmissionStatus, error) {
+	//nolint: wrapcheck
+	return c.submitter.GetSubmissionStatus(ctx, big.NewInt(int64(c.ChainID)), nonce)
+}
+
 // LatestBlock returns the latest block.
 func (c Chain) LatestBlock() uint64 {
 	return c.listener.LatestBlock()
@@ -38,10 +38,8 @@ type rebalanceManagerCircleCCTP struct {
 	boundMessageTransmitters map[int]*messagetransmitter.MessageTransmitter
 	// relayerAddress contains the relayer address
 	relayerAddress common.Address
-	// messengerListeners is the map of chain listeners for DepositForBurn events
-	messengerListeners map[int]listener.ContractListener
-	// transmitterListeners is the map of chain listeners for MessageReceived events
-	transmitterListeners map[int]listener.ContractListener
+	// chainListeners is the map of chain listeners for DepositForBurn and MessageReceived events
+	chainListeners map[int]listener.ContractListener
 	// db is the database
 	db reldb.Service
 }
@@ -55,8 +53,7 @@ func newRebalanceManagerCircleCCTP(cfg relconfig.Config, handler metrics.Handler
 		boundTokenMessengers:     make(map[int]*tokenmessenger.TokenMessenger),
 		boundMessageTransmitters: make(map[int]*messagetransmitter.MessageTransmitter),
 		relayerAddress:           relayerAddress,
-		messengerListeners:       make(map[int]listener.ContractListener),
-		transmitterListeners:     make(map[int]listener.ContractListener),
+		chainListeners:           make(map[int]listener.ContractListener),
 		db:                       db,
 	}
 }
@@ -81,10 +78,7 @@ func (c *rebalanceManagerCircleCCTP) Start(ctx context.Context) (err error) {
 			return fmt.Errorf("could not get chain client: %w", err)
 		}
 		g.Go(func() error {
-			return c.listenDepositForBurn(ctx, chainID, ethClient)
-		})
-		g.Go(func() error {
-			return c.listenMessageReceived(ctx, chainID, ethClient)
+			return c.listen(ctx, chainID, ethClient)
 		})
 	}
 
@@ -154,7 +148,6 @@ func (c *rebalanceManagerCircleCCTP) initListeners(parentCtx context.Context) (e
 			return fmt.Errorf("could not get cctp start block: %w", err)
 		}
 
-		// build listener for TokenMessenger
 		messengerAddr, err := c.cfg.GetTokenMessengerAddress(chainID)
 		if err != nil {
 			return fmt.Errorf("could not get cctp address: %w", err)
@@ -163,20 +156,28 @@ func (c *rebalanceManagerCircleCCTP) initListeners(parentCtx context.Context) (e
 			span.AddEvent(fmt.Sprintf("token messenger address not found for chain %d; skipping", chainID))
 			continue
 		}
-		messengerListener, err := listener.NewChainListener(chainClient, c.db, common.HexToAddress(messengerAddr), initialBlock, c.handler)
-		if err != nil {
-			return fmt.Errorf("could not get messenger listener: %w", err)
-		}
-		c.messengerListeners[chainID] = messengerListener
-
-		// build listener for MessageTransmitter
 		transmitterAddr, err := cctpRelay.GetMessageTransmitterAddress(ctx, common.HexToAddress(messengerAddr), chainClient)
 		if err != nil {
 			return fmt.Errorf("could not get message transmitter addr")
 		}
-		c.transmitterListeners[chainID], err = listener.NewChainListener(chainClient, c.db, transmitterAddr, initialBlock, c.handler)
+		listenerCfg, err := c.cfg.GetListenerConfig(chainID)
+		if err != nil {
+			return fmt.Errorf("could not get listener config: %w", err)
+		}
+		listenerOpts, err := listenerCfg.Options(ctx, c.handler)
+		if err != nil {
+			return fmt.Errorf("could not get listener options: %w", err)
+		}
+
+		// build one listener for the TokenMessenger and MessageTransmitter
+		opts := append(listenerOpts,
+			listener.WithAddresses(transmitterAddr),
+			listener.WithTopics([]common.Hash{tokenmessenger.DepositForBurnTopic, messagetransmitter.MessageReceivedTopic}),
+			listener.WithName(circleListenerName),
+		)
+		c.chainListeners[chainID], err = listener.NewChainListener(chainClient, c.db, common.HexToAddress(messengerAddr), initialBlock, c.handler, opts...)
 		if err != nil {
-			return fmt.Errorf("could not get transmitter listener: %w", err)
+			return fmt.Errorf("could not get cctp listener: %w", err)
 		}
 		span.AddEvent(fmt.Sprintf("assigned contracts on chain %d", chainID), trace.WithAttributes(
 			attribute.String("token_messenger", messengerAddr),
@@ -253,66 +254,41 @@ func (c *rebalanceManagerCircleCCTP) Execute(parentCtx context.Context, rebalanc
 	return nil
 }
 
-// nolint:cyclop,dupl
-func (c *rebalanceManagerCircleCCTP) listenDepositForBurn(parentCtx context.Context, chainID int, ethClient client.EVM) (err error) {
-	listener, ok := c.messengerListeners[chainID]
-	if !ok {
-		return fmt.Errorf("could not find listener for chain %d", chainID)
-	}
+// circleListenerName is the name of the listener for the TokenMessenger and MessageTransmitter on each chain.
+const circleListenerName = "circle_cctp"
 
-	err = listener.Listen(parentCtx, func(parentCtx context.Context, log types.Log) (err error) {
-		ctx, span := c.handler.Tracer().Start(parentCtx, "rebalance.listenDepositForBurn", trace.WithAttributes(
-			attribute.Int(metrics.ChainID, chainID),
-		))
-		defer func(err error) {
-			metrics.EndSpanWithErr(span, err)
-		}(err)
-
-		if log.Topics[0] != tokenmessenger.DepositForBurnTopic {
-			logger.Warnf("unknown event on TokenMessenger: %s", log.Topics[0])
-			return nil
-		}
-
-		err = c.handleDepositForBurn(ctx, log, chainID, ethClient)
-		if err != nil {
-			return fmt.Errorf("could not handle DepositForBurn event: %w", err)
-		}
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("could not listen for DepositForBurn events: %w", err)
-	}
-	return nil
-}
-
-//nolint:cyclop,dupl
-func (c *rebalanceManagerCircleCCTP) listenMessageReceived(parentCtx context.Context, chainID int, ethClient client.EVM) (err error) {
-	listener, ok := c.transmitterListeners[chainID]
+func (c *rebalanceManagerCircleCCTP) listen(parentCtx context.Context, chainID int, ethClient client.EVM) (err error) {
+	listener, ok := c.chainListeners[chainID]
 	if !ok {
 		return fmt.Errorf("could not find listener for chain %d", chainID)
 	}
 
 	err = listener.Listen(parentCtx, func(parentCtx context.Context, log types.Log) (err error) {
-		ctx, span := c.handler.Tracer().Start(parentCtx, "rebalance.listenMessageReceived", trace.WithAttributes(
+		ctx, span := c.handler.Tracer().Start(parentCtx, "rebalance.listen", trace.WithAttributes(
 			attribute.Int(metrics.ChainID, chainID),
 		))
 		defer func(err error) {
 			metrics.EndSpanWithErr(span, err)
 		}(err)
 
-		if log.Topics[0] != messagetransmitter.MessageReceivedTopic {
-			logger.Warnf("unknown event on MessageTransmitter: %s", log.Topics[0])
-			return nil
-		}
-
-		err = c.handleMessageReceived(ctx, log, chainID, ethClient)
-		if err != nil {
-			return fmt.Errorf("could not handle MessageReceived: %w", err)
+		switch log.Topics[0] {
+		case tokenmessenger.DepositForBurnTopic:
+			err = c.handleDepositForBurn(ctx, log, chainID, ethClient)
+			if err != nil {
+				return fmt.Errorf("could not handle DepositForBurn event: %w", err)
+			}
+		case messagetransmitter.MessageReceivedTopic:
+			err = c.handleMessageReceived(ctx, log, chainID, ethClient)
+			if err != nil {
+				return fmt.Errorf("could not handle MessageReceived: %w", err)
+			}
+		default:
+			logger.Warnf("unknown event on cctp contracts: %s", log.Topics[0])
 		}
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("could not listen for MessageReceived events: %w", err)
+		return fmt.Errorf("could not listen for cctp events: %w", err)
 	}
 	return nil
 }
@@ -121,7 +121,15 @@ func (c *rebalanceManagerSynapseCCTP) initListeners(ctx context.Context) (err er
 		if err != nil {
 			return fmt.Errorf("could not get cctp start block: %w", err)
 		}
-		chainListener, err := listener.NewChainListener(chainClient, c.db, common.HexToAddress(cctpAddr), initialBlock, c.handler)
+		listenerCfg, err := c.cfg.GetListenerConfig(chainID)
+		if err != nil {
+			return fmt.Errorf("could not get listener config: %w", err)
+		}
+		listenerOpts, err := listenerCfg.Options(ctx, c.handler)
+		if err != nil {
+			return fmt.Errorf("could not get listener options: %w", err)
+		}
+		chainListener, err := listener.NewChainListener(chainClient, c.db, common.HexToAddress(cctpAddr), initialBlock, c.handler, listenerOpts...)
 		if err != nil {
 			return fmt.Errorf("could not get chain listener: %w", err)
 		}
@@ -0,0 +1,13 @@
+package relapi
+
+import (
+	"github.com/synapsecns/sanguine/core/ginhelper"
+	"github.com/synapsecns/sanguine/ethergo/client"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+)
+
+// ListenerLagCheck exports listenerLagCheck for testing.
+func ListenerLagCheck(chainClient client.EVM, store reldb.Service, chainListener listener.ContractListener, chainID uint64) ginhelper.CheckFunc {
+	return listenerLagCheck(chainClient, store, chainListener, chainID)
+}
@@ -0,0 +1,43 @@
+package relapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	clientMocks "github.com/synapsecns/sanguine/ethergo/client/mocks"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/relapi"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/connect"
+)
+
+func TestListenerLagCheck(t *testing.T) {
+	ctx := context.Background()
+	const chainID = 1
+
+	store, err := connect.Connect(ctx, dbcommon.Sqlite, filet.TmpDir(t, ""), metrics.NewNullHandler())
+	require.NoError(t, err)
+
+	chainClient := new(clientMocks.EVM)
+	chainClient.On("BlockNumber", mock.Anything).Return(uint64(1000), nil)
+
+	chainListener, err := listener.NewChainListener(chainClient, store, common.HexToAddress("0x1"), 0, metrics.NewNullHandler())
+	require.NoError(t, err)
+
+	check := relapi.ListenerLagCheck(chainClient, store, chainListener, chainID)
+
+	// nothing indexed yet.
+	require.Error(t, check(ctx))
+
+	// the listener stores its latest block under its key, since the store implements CursorDB.
+	require.NoError(t, store.PutListenerBlock(ctx, chainID, chainListener.Key(), 950))
+	require.NoError(t, check(ctx))
+
+	require.NoError(t, store.PutListenerBlock(ctx, chainID, chainListener.Key(), 850))
+	require.Error(t, check(ctx))
+}
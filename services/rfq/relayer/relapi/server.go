@@ -7,7 +7,11 @@
 
 	"github.com/ipfs/go-log"
 	"github.com/synapsecns/sanguine/core/ginhelper"
+	"github.com/synapsecns/sanguine/ethergo/client"
 	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/ethergo/submitter/admin"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +34,9 @@ type RelayerAPIServer struct {
 	engine  *gin.Engine
 	handler metrics.Handler
 	chains  map[uint32]*chain.Chain
+	health  *ginhelper.HealthRegistry
+	// submitter is served by the submitter admin api.
+	submitter submitter.TransactionSubmitter
 }
 
 // NewRelayerAPI holds the configuration, database connection, gin engine, RPC client, metrics handler, and fast bridge contracts.
@@ -57,6 +64,11 @@ func NewRelayerAPI(
 		return nil, fmt.Errorf("store is nil")
 	}
 
+	health := ginhelper.NewHealthRegistry()
+	if gormStore, ok := store.(interface{ DB() *gorm.DB }); ok {
+		health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+	}
+
 	chains := make(map[uint32]*chain.Chain)
 	for chainID, chainCfg := range cfg.Chains {
 		chainClient, err := omniRPCClient.GetChainClient(ctx, chainID)
@@ -83,16 +95,46 @@ func NewRelayerAPI(
 		if err != nil {
 			return nil, fmt.Errorf("could not create chain: %w", err)
 		}
+		health.Register(fmt.Sprintf("chain_listener_lag_%d", chainID), listenerLagCheck(chainClient, store, chainListener, uint64(chainID)))
 	}
 
 	return &RelayerAPIServer{
-		cfg:     cfg,
-		db:      store,
-		handler: handler,
-		chains:  chains,
+		cfg:       cfg,
+		db:        store,
+		handler:   handler,
+		chains:    chains,
+		health:    health,
+		submitter: submitter,
 	}, nil
 }
 
+// maxListenerLag is the number of blocks the chain listener can fall behind the rpc before the relayer is not ready.
+const maxListenerLag = 100
+
+// listenerLagCheck checks that the last block indexed by the chain listener is within maxListenerLag of the chain head.
+// The store implements listenerDB.CursorDB, so the listener's latest block is read under its key.
+func listenerLagCheck(chainClient client.EVM, store reldb.Service, chainListener listener.ContractListener, chainID uint64) ginhelper.CheckFunc {
+	return ginhelper.ThresholdCheck("chain listener lag", func(ctx context.Context) (uint64, error) {
+		latestBlock, err := chainClient.BlockNumber(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("could not get latest block: %w", err)
+		}
+		lastIndexed, err := store.ListenerBlock(ctx, chainID, chainListener.Key())
+		if err != nil {
+			return 0, fmt.Errorf("could not get last indexed block: %w", err)
+		}
+		if lastIndexed > latestBlock {
+			return 0, nil
+		}
+		return latestBlock - lastIndexed, nil
+	}, maxListenerLag)
+}
+
+// Health returns the health registry served by the api, so other relayer components can register checks.
+func (r *RelayerAPIServer) Health() *ginhelper.HealthRegistry {
+	return r.health
+}
+
 const (
 	getHealthRoute              = "/health"
 	getQuoteStatusByTxHashRoute = "/status"
@@ -113,15 +155,44 @@ func (r *RelayerAPIServer) Run(ctx context.Context) error {
 	engine.GET(getQuoteStatusByTxHashRoute, h.GetQuoteRequestStatusByTxHash)
 	engine.GET(getQuoteStatusByTxIDRoute, h.GetQuoteRequestStatusByTxID)
 	engine.GET(getRetryRoute, h.GetTxRetry)
+	r.health.Mount(engine)
 
 	r.engine = engine
 
-	connection := baseServer.Server{}
-	fmt.Printf("starting api at http://localhost:%s\n", r.cfg.RelayerAPIPort)
-	err := connection.ListenAndServe(ctx, fmt.Sprintf(":%s", r.cfg.RelayerAPIPort), r.engine)
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		connection := baseServer.Server{}
+		fmt.Printf("starting api at http://localhost:%s\n", r.cfg.RelayerAPIPort)
+		err := connection.ListenAndServe(ctx, fmt.Sprintf(":%s", r.cfg.RelayerAPIPort), r.engine)
+		if err != nil {
+			return fmt.Errorf("could not start relayer api server: %w", err)
+		}
+		return nil
+	})
+
+	if r.cfg.AdminAPIAddr != "" {
+		g.Go(func() error {
+			return r.runAdmin(ctx)
+		})
+	}
+
+	err := g.Wait()
 	if err != nil {
-		return fmt.Errorf("could not start relayer api server: %w", err)
+		return fmt.Errorf("relayer api server failed: %w", err)
 	}
+	return nil
+}
+
+// runAdmin serves the submitter admin api on its own listener, so it isn't exposed with the public api.
+func (r *RelayerAPIServer) runAdmin(ctx context.Context) error {
+	engine := ginhelper.New(logger)
+	admin.NewHandler(r.submitter).Mount(engine)
 
+	connection := baseServer.Server{}
+	fmt.Printf("starting admin api at http://%s\n", r.cfg.AdminAPIAddr)
+	err := connection.ListenAndServe(ctx, r.cfg.AdminAPIAddr, engine)
+	if err != nil {
+		return fmt.Errorf("could not start relayer admin api server: %w", err)
+	}
 	return nil
 }
@@ -10,6 +10,7 @@
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jftuga/ellipsis"
+	"github.com/synapsecns/sanguine/ethergo/listener"
 	"github.com/synapsecns/sanguine/ethergo/signer/config"
 	submitterConfig "github.com/synapsecns/sanguine/ethergo/submitter/config"
 	cctpConfig "github.com/synapsecns/sanguine/services/cctp-relayer/config"
@@ -33,6 +34,9 @@ type Config struct {
 	RfqAPIURL string `yaml:"rfq_url"`
 	// RelayerAPIPort is the port of the relayer API.
 	RelayerAPIPort string `yaml:"relayer_api_port"`
+	// AdminAPIAddr is the address the submitter admin API listens on, e.g. 127.0.0.1:9998. The admin API can move
+	// funds, so it's served on its own listener that should only be reachable by operators. It's disabled if empty.
+	AdminAPIAddr string `yaml:"admin_api_addr"`
 	// Database is the database config.
 	Database DatabaseConfig `yaml:"database"`
 	// QuotableTokens is a map of token -> list of quotable tokens.
@@ -74,6 +78,7 @@ type ChainConfig struct {
 	// DestGasEstimate is the gas estimate to use for destination transactions (this will override base gas estimates).
 	DestGasEstimate int `yaml:"dest_gas_estimate"`
 	// L1FeeChainID indicates the chain ID for the L1 fee (if needed, for example on optimism).
+	// The L1 fee settings are only used to quote fees, the submitter prices the L1 data fee of the txes it sends.
 	L1FeeChainID uint32 `yaml:"l1_fee_chain_id"`
 	// L1FeeOriginGasEstimate is the gas estimate for the L1 fee on origin.
 	L1FeeOriginGasEstimate int `yaml:"l1_fee_origin_gas_estimate"`
@@ -89,6 +94,8 @@ type ChainConfig struct {
 	FixedFeeMultiplier float64 `yaml:"fixed_fee_multiplier"`
 	// CCTP start block is the block at which the chain listener will listen for CCTP events.
 	CCTPStartBlock uint64 `yaml:"cctp_start_block"`
+	// Listener is the config of the chain listeners.
+	Listener listener.Config `yaml:"listener"`
 }
 
 // TokenConfig represents the configuration for a token.
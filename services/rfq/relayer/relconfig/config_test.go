@@ -7,6 +7,7 @@
 	"github.com/alecthomas/assert"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/ethergo/listener"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/relconfig"
 )
 
@@ -33,6 +34,7 @@ func TestGetters(t *testing.T) {
 				QuotePct:               50,
 				QuoteOffsetBps:         10,
 				FixedFeeMultiplier:     1.1,
+				Listener:               listener.Config{PollIntervalMs: 1000},
 			},
 		},
 		BaseChainConfig: relconfig.ChainConfig{
@@ -51,6 +53,7 @@ func TestGetters(t *testing.T) {
 			QuotePct:               51,
 			QuoteOffsetBps:         11,
 			FixedFeeMultiplier:     1.2,
+			Listener:               listener.Config{PollIntervalMs: 2000, GetLogsRange: 500},
 		},
 	}
 	cfg := relconfig.Config{
@@ -292,6 +295,20 @@ func TestGetters(t *testing.T) {
 		assert.Equal(t, chainVal, cfgWithBase.Chains[chainID].FixedFeeMultiplier)
 	})
 
+	t.Run("GetListenerConfig", func(t *testing.T) {
+		defaultVal, err := cfg.GetListenerConfig(badChainID)
+		assert.NoError(t, err)
+		assert.Equal(t, defaultVal, relconfig.DefaultChainConfig.Listener)
+
+		baseVal, err := cfgWithBase.GetListenerConfig(badChainID)
+		assert.NoError(t, err)
+		assert.Equal(t, baseVal, cfgWithBase.BaseChainConfig.Listener)
+
+		chainVal, err := cfgWithBase.GetListenerConfig(chainID)
+		assert.NoError(t, err)
+		assert.Equal(t, chainVal, cfgWithBase.Chains[chainID].Listener)
+	})
+
 	t.Run("GetMaxRebalanceAmount", func(t *testing.T) {
 		defaultVal := cfg.GetMaxRebalanceAmount(badChainID, common.HexToAddress(usdcAddr))
 		assert.Equal(t, defaultVal.String(), abi.MaxInt256.String())
@@ -8,6 +8,7 @@
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/ethergo/listener"
 	"github.com/synapsecns/sanguine/ethergo/signer/config"
 )
 
@@ -324,6 +325,20 @@ func (c Config) GetCCTPStartBlock(chainID int) (value uint64, err error) {
 	return value, nil
 }
 
+// GetListenerConfig returns the chain listener config for the given chainID.
+func (c Config) GetListenerConfig(chainID int) (value listener.Config, err error) {
+	rawValue, err := c.getChainConfigValue(chainID, "Listener")
+	if err != nil {
+		return value, err
+	}
+
+	value, ok := rawValue.(listener.Config)
+	if !ok {
+		return value, fmt.Errorf("failed to cast Listener to listener.Config")
+	}
+	return value, nil
+}
+
 // GetL1FeeParams returns the L1 fee params for the given chain.
 func (c Config) GetL1FeeParams(chainID uint32, origin bool) (uint32, int, bool) {
 	var gasEstimate int
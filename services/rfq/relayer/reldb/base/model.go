@@ -23,6 +23,7 @@ func init() {
 	originTxHashFieldName = namer.GetConsistentName("OriginTxHash")
 	destTxHashFieldName = namer.GetConsistentName("DestTxHash")
 	rebalanceIDFieldName = namer.GetConsistentName("RebalanceID")
+	relayNonceFieldName = namer.GetConsistentName("RelayNonce")
 }
 
 var (
@@ -36,6 +37,8 @@ func init() {
 	destTxHashFieldName string
 	// rebalanceIDFieldName is the rebalances id field name.
 	rebalanceIDFieldName string
+	// relayNonceFieldName is the relay nonce field name.
+	relayNonceFieldName string
 )
 
 // RequestForQuote is the primary event model.
@@ -88,6 +91,8 @@ type RequestForQuote struct {
 	RawRequest string
 	// SendChainGas is true if the chain should send gas
 	SendChainGas bool
+	// RelayNonce is the submitter nonce of the relay tx on the destination chain
+	RelayNonce sql.NullInt64
 }
 
 // Rebalance is the event model for a rebalance action.
@@ -129,6 +134,7 @@ func FromQuoteRequest(request reldb.QuoteRequest) RequestForQuote {
 		OriginNonce:          int(request.Transaction.Nonce.Uint64()),
 		Status:               request.Status,
 		BlockNumber:          request.BlockNumber,
+		RelayNonce:           uint64PtrToNullInt64(request.RelayNonce),
 	}
 }
 
@@ -163,6 +169,16 @@ func hashToNullString(h common.Hash) sql.NullString {
 	}
 }
 
+func uint64PtrToNullInt64(i *uint64) sql.NullInt64 {
+	if i == nil {
+		return sql.NullInt64{Valid: false}
+	}
+	return sql.NullInt64{
+		Int64: int64(*i),
+		Valid: true,
+	}
+}
+
 func stringToNullString(s string) sql.NullString {
 	if s == "" {
 		return sql.NullString{Valid: false}
@@ -190,6 +206,12 @@ func (r RequestForQuote) ToQuoteRequest() (*reldb.QuoteRequest, error) {
 		return nil, fmt.Errorf("could not convert transaction id: %w", err)
 	}
 
+	var relayNonce *uint64
+	if r.RelayNonce.Valid {
+		nonce := uint64(r.RelayNonce.Int64)
+		relayNonce = &nonce
+	}
+
 	return &reldb.QuoteRequest{
 		OriginTokenDecimals: r.OriginTokenDecimals,
 		DestTokenDecimals:   r.DestTokenDecimals,
@@ -214,6 +236,7 @@ func (r RequestForQuote) ToQuoteRequest() (*reldb.QuoteRequest, error) {
 		Status:       r.Status,
 		OriginTxHash: common.HexToHash(r.OriginTxHash.String),
 		DestTxHash:   common.HexToHash(r.DestTxHash.String),
+		RelayNonce:   relayNonce,
 	}, nil
 }
 
@@ -16,6 +16,7 @@
 
 func TestRoundtripBetweenFromQuoteRequestAndToQuoteRequest(t *testing.T) {
 	// Step 1: Setup
+	relayNonce := uint64(7)
 	originalRequest := reldb.QuoteRequest{
 		OriginTokenDecimals: 18,
 		DestTokenDecimals:   6,
@@ -35,7 +36,8 @@ func TestRoundtripBetweenFromQuoteRequestAndToQuoteRequest(t *testing.T) {
 			Deadline:      big.NewInt(time.Now().Unix()),
 			Nonce:         big.NewInt(1),
 		},
-		Status: reldb.QuoteRequestStatus(1),
+		Status:     reldb.QuoteRequestStatus(1),
+		RelayNonce: &relayNonce,
 	}
 
 	// Step 2: Test FromQuoteRequest
@@ -109,3 +109,31 @@ func (s Store) UpdateDestTxHash(ctx context.Context, id [32]byte, destTxHash com
 	}
 	return nil
 }
+
+// UpdateRelayNonce todo: db test.
+func (s Store) UpdateRelayNonce(ctx context.Context, id [32]byte, nonce uint64) error {
+	tx := s.DB().WithContext(ctx).Model(&RequestForQuote{}).
+		Where(fmt.Sprintf("%s = ?", transactionIDFieldName), hexutil.Encode(id[:])).
+		Update(relayNonceFieldName, nonce)
+	if tx.Error != nil {
+		return fmt.Errorf("could not update: %w", tx.Error)
+	}
+	return nil
+}
+
+// DeleteQuoteRequest deletes a quote request if its status is one of matchStatuses, returning false if it wasn't deleted.
+func (s Store) DeleteQuoteRequest(ctx context.Context, id [32]byte, matchStatuses ...reldb.QuoteRequestStatus) (bool, error) {
+	inArgs := make([]int, len(matchStatuses))
+	for i := range matchStatuses {
+		inArgs[i] = int(matchStatuses[i].Int())
+	}
+
+	tx := s.DB().WithContext(ctx).
+		Where(fmt.Sprintf("%s = ?", transactionIDFieldName), hexutil.Encode(id[:])).
+		Where(fmt.Sprintf("%s IN ?", statusFieldName), inArgs).
+		Delete(&RequestForQuote{})
+	if tx.Error != nil {
+		return false, fmt.Errorf("could not delete quote request: %w", tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
@@ -11,7 +11,7 @@
 
 // Store implements the service.
 type Store struct {
-	listenerDB.ChainListenerDB
+	listenerDB.Service
 	db             *gorm.DB
 	submitterStore submitterDB.Service
 }
@@ -20,7 +20,7 @@ type Store struct {
 func NewStore(db *gorm.DB, metrics metrics.Handler) *Store {
 	txDB := txdb.NewTXStore(db, metrics)
 
-	return &Store{ChainListenerDB: listenerDB.NewChainListenerStore(db, metrics), db: db, submitterStore: txDB}
+	return &Store{Service: listenerDB.NewChainListenerStore(db, metrics), db: db, submitterStore: txDB}
 }
 
 // DB gets the database object for mutation outside of the lib.
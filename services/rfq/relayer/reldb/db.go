@@ -29,6 +29,10 @@ type Writer interface {
 	UpdateRebalance(ctx context.Context, rebalance Rebalance, updateID bool) error
 	// UpdateDestTxHash updates the dest tx hash of a quote request
 	UpdateDestTxHash(ctx context.Context, id [32]byte, destTxHash common.Hash) error
+	// UpdateRelayNonce updates the submitter nonce of the relay tx of a quote request
+	UpdateRelayNonce(ctx context.Context, id [32]byte, nonce uint64) error
+	// DeleteQuoteRequest deletes a quote request if its status is one of matchStatuses, returning false if it wasn't deleted.
+	DeleteQuoteRequest(ctx context.Context, id [32]byte, matchStatuses ...QuoteRequestStatus) (bool, error)
 }
 
 // Reader is the interface for reading from the database.
@@ -51,7 +55,7 @@ type Service interface {
 	// SubmitterDB returns the submitter database service.
 	SubmitterDB() submitterDB.Service
 	Writer
-	db.ChainListenerDB
+	db.Service
 }
 
 var (
@@ -76,6 +80,9 @@ type QuoteRequest struct {
 	Status       QuoteRequestStatus
 	OriginTxHash common.Hash
 	DestTxHash   common.Hash
+	// RelayNonce is the submitter nonce of the relay tx on the destination chain.
+	// This is nil until the relay has been submitted.
+	RelayNonce *uint64
 }
 
 // GetOriginIDPair gets the origin chain id and token address pair.
@@ -6,6 +6,7 @@
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/ethergo/listener"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
 )
 
@@ -92,3 +93,39 @@ func (d *DBSuite) TestStoreAndUpdateRebalance() {
 		d.Equal(rebalanceCompleted.Status, dbRebalance.Status)
 	})
 }
+
+func (d *DBSuite) TestDeleteQuoteRequest() {
+	d.RunOnAllDBs(func(testDB reldb.Service) {
+		request := reldb.QuoteRequest{
+			TransactionID: common.HexToHash("0x1"),
+			Transaction: fastbridge.IFastBridgeBridgeTransaction{
+				OriginAmount: big.NewInt(100),
+				DestAmount:   big.NewInt(100),
+				Deadline:     big.NewInt(0),
+				Nonce:        big.NewInt(0),
+			},
+			Status: reldb.RelayStarted,
+		}
+		err := testDB.StoreQuoteRequest(d.GetTestContext(), request)
+		d.Require().NoError(err)
+
+		// a request that's been relayed isn't deleted.
+		deleted, err := testDB.DeleteQuoteRequest(d.GetTestContext(), request.TransactionID, reldb.Seen, reldb.CommittedPending)
+		d.Require().NoError(err)
+		d.False(deleted)
+		_, err = testDB.GetQuoteRequestByID(d.GetTestContext(), request.TransactionID)
+		d.Require().NoError(err)
+
+		deleted, err = testDB.DeleteQuoteRequest(d.GetTestContext(), request.TransactionID, reldb.Seen, reldb.RelayStarted)
+		d.Require().NoError(err)
+		d.True(deleted)
+		_, err = testDB.GetQuoteRequestByID(d.GetTestContext(), request.TransactionID)
+		d.ErrorIs(err, reldb.ErrNoQuoteForID)
+
+		// the request can be stored again once it's deleted.
+		err = testDB.StoreQuoteRequest(d.GetTestContext(), request)
+		d.Require().NoError(err)
+		_, err = testDB.GetQuoteRequestByID(d.GetTestContext(), request.TransactionID)
+		d.Require().NoError(err)
+	})
+}
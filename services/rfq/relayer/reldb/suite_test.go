@@ -6,6 +6,7 @@
 	"github.com/synapsecns/sanguine/services/rfq/api/metadata"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/mysql"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/postgres"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/sqlite"
 	"os"
 	"sync"
@@ -62,6 +63,7 @@ func (d *DBSuite) SetupTest() {
 
 	d.dbs = []reldb.Service{sqliteStore}
 	d.setupMysqlDB()
+	d.setupPostgresDB()
 }
 
 func (d *DBSuite) setupMysqlDB() {
@@ -89,6 +91,21 @@ func (d *DBSuite) setupMysqlDB() {
 	d.dbs = append(d.dbs, mysqlStore)
 }
 
+func (d *DBSuite) setupPostgresDB() {
+	if os.Getenv(dbcommon.EnablePostgresTestVar) != "true" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("rfq_%d", d.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(d.GetTestContext(), dbcommon.GetTestPostgresConnString(), d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs = append(d.dbs, postgresStore)
+}
+
 func (d *DBSuite) RunOnAllDBs(testFunc func(testDB reldb.Service)) {
 	d.T().Helper()
 
@@ -2,11 +2,15 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/listener"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/ierc20"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/chain"
@@ -119,6 +123,65 @@ func (r *Relayer) runChainIndexer(ctx context.Context, chainID int) (err error)
 	return nil
 }
 
+// unrelayedStatuses are the statuses of quote requests that haven't been relayed. They're forgotten if their request
+// is removed by a reorg, and stored again if the request is mined again.
+var unrelayedStatuses = []reldb.QuoteRequestStatus{reldb.Seen, reldb.NotEnoughInventory, reldb.WillNotProcess, reldb.DeadlineExceeded, reldb.CommittedPending, reldb.CommittedConfirmed}
+
+// removedLogHandler undoes the fast bridge logs on a chain that were removed by a reorg. Requests that haven't been
+// relayed are forgotten, and the statuses set by the relayer's own relay, proof and claim logs go back to pending until
+// the log is delivered again.
+func removedLogHandler(store reldb.Service, relayer common.Address, parser fastbridge.Parser) listener.HandleRemovedLog {
+	return func(ctx context.Context, log types.Log) error {
+		_, parsedEvent, ok := parser.ParseEvent(log)
+		if !ok {
+			return nil
+		}
+
+		switch event := parsedEvent.(type) {
+		case *fastbridge.FastBridgeBridgeRequested:
+			deleted, err := store.DeleteQuoteRequest(ctx, event.TransactionId, unrelayedStatuses...)
+			if err != nil {
+				return fmt.Errorf("could not delete removed request: %w", err)
+			}
+			if !deleted {
+				logger.Warnf("request %s was removed by a reorg after it was relayed", hexutil.Encode(event.TransactionId[:]))
+			}
+		case *fastbridge.FastBridgeBridgeRelayed:
+			return revertRemovedStatus(ctx, store, relayer, event.Relayer, event.TransactionId, reldb.RelayCompleted, reldb.RelayStarted)
+		case *fastbridge.FastBridgeBridgeProofProvided:
+			return revertRemovedStatus(ctx, store, relayer, event.Relayer, event.TransactionId, reldb.ProvePosted, reldb.ProvePosting)
+		case *fastbridge.FastBridgeBridgeDepositClaimed:
+			return revertRemovedStatus(ctx, store, relayer, event.Relayer, event.TransactionId, reldb.ClaimCompleted, reldb.ClaimPending)
+		}
+		return nil
+	}
+}
+
+// revertRemovedStatus sets the status of a request back to pending if a removed log of the relayer set it to completed.
+func revertRemovedStatus(ctx context.Context, store reldb.Service, relayer, eventRelayer common.Address, id [32]byte, completed, pending reldb.QuoteRequestStatus) error {
+	// logs of other relayers are expected to be mined again.
+	if eventRelayer != relayer {
+		return nil
+	}
+
+	request, err := store.GetQuoteRequestByID(ctx, id)
+	if errors.Is(err, reldb.ErrNoQuoteForID) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get request: %w", err)
+	}
+	if request.Status != completed {
+		return nil
+	}
+
+	err = store.UpdateQuoteRequestStatus(ctx, id, pending)
+	if err != nil {
+		return fmt.Errorf("could not revert request status: %w", err)
+	}
+	return nil
+}
+
 const ethDecimals = 18
 
 // getDecimals gets the decimals for the origin and dest tokens.
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"math/big"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb/connect"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/service"
+)
+
+func (r *RelayerTestSuite) TestRemovedLogHandler() {
+	store, err := connect.Connect(r.GetTestContext(), dbcommon.Sqlite, filet.TmpDir(r.T(), ""), r.metrics)
+	r.Require().NoError(err)
+
+	fastBridgeABI, err := fastbridge.FastBridgeMetaData.GetAbi()
+	r.Require().NoError(err)
+
+	bridgeAddress := common.HexToAddress("0x1")
+	relayer := common.HexToAddress("0x2")
+	otherRelayer := common.HexToAddress("0x3")
+
+	parser, err := fastbridge.NewParser(bridgeAddress)
+	r.Require().NoError(err)
+	handleRemovedLog := service.RemovedLogHandler(store, relayer, parser)
+
+	// removedLog builds a removed log of the event with the values of its inputs, in order.
+	removedLog := func(eventName string, values ...interface{}) types.Log {
+		event := fastBridgeABI.Events[eventName]
+		var indexed, nonIndexed []interface{}
+		for i, input := range event.Inputs {
+			if input.Indexed {
+				indexed = append(indexed, values[i])
+			} else {
+				nonIndexed = append(nonIndexed, values[i])
+			}
+		}
+
+		topics, err := abi.MakeTopics(indexed)
+		r.Require().NoError(err)
+		data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+		r.Require().NoError(err)
+
+		return types.Log{
+			Address: bridgeAddress,
+			Topics:  append([]common.Hash{event.ID}, topics[0]...),
+			Data:    data,
+			Removed: true,
+		}
+	}
+
+	storeRequest := func(id common.Hash, status reldb.QuoteRequestStatus) {
+		err := store.StoreQuoteRequest(r.GetTestContext(), reldb.QuoteRequest{
+			TransactionID: id,
+			Transaction: fastbridge.IFastBridgeBridgeTransaction{
+				OriginAmount: big.NewInt(1),
+				DestAmount:   big.NewInt(1),
+				Deadline:     big.NewInt(0),
+				Nonce:        big.NewInt(0),
+			},
+			Status: status,
+		})
+		r.Require().NoError(err)
+	}
+
+	requireStatus := func(id common.Hash, status reldb.QuoteRequestStatus) {
+		request, err := store.GetQuoteRequestByID(r.GetTestContext(), id)
+		r.Require().NoError(err)
+		r.Equal(status, request.Status)
+	}
+
+	requested := func(id common.Hash) types.Log {
+		return removedLog("BridgeRequested", id, common.Address{}, []byte{}, uint32(2), common.Address{}, common.Address{}, big.NewInt(1), big.NewInt(1), false)
+	}
+	relayed := func(id common.Hash, eventRelayer common.Address) types.Log {
+		return removedLog("BridgeRelayed", id, eventRelayer, common.Address{}, uint32(1), common.Address{}, common.Address{}, big.NewInt(1), big.NewInt(1), big.NewInt(0))
+	}
+
+	// requests that haven't been relayed are forgotten.
+	unrelayed := common.HexToHash("0xa")
+	storeRequest(unrelayed, reldb.CommittedPending)
+	r.Require().NoError(handleRemovedLog(r.GetTestContext(), requested(unrelayed)))
+	_, err = store.GetQuoteRequestByID(r.GetTestContext(), unrelayed)
+	r.ErrorIs(err, reldb.ErrNoQuoteForID)
+
+	// relayed requests are kept.
+	relayedRequest := common.HexToHash("0xb")
+	storeRequest(relayedRequest, reldb.RelayCompleted)
+	r.Require().NoError(handleRemovedLog(r.GetTestContext(), requested(relayedRequest)))
+	requireStatus(relayedRequest, reldb.RelayCompleted)
+
+	// a removed relay of another relayer doesn't change the status, but our own goes back to pending.
+	r.Require().NoError(handleRemovedLog(r.GetTestContext(), relayed(relayedRequest, otherRelayer)))
+	requireStatus(relayedRequest, reldb.RelayCompleted)
+	r.Require().NoError(handleRemovedLog(r.GetTestContext(), relayed(relayedRequest, relayer)))
+	requireStatus(relayedRequest, reldb.RelayStarted)
+
+	// handling the same removed log again is a no-op.
+	r.Require().NoError(handleRemovedLog(r.GetTestContext(), relayed(relayedRequest, relayer)))
+	requireStatus(relayedRequest, reldb.RelayStarted)
+}
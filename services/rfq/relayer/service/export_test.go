@@ -1,8 +1,20 @@
 package service
 
-import "context"
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
+	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
+)
 
 // StartChainParser exports chain parser for testing.
 func (r *Relayer) StartChainParser(ctx context.Context) error {
 	return r.startChainIndexers(ctx)
 }
+
+// RemovedLogHandler exports removedLogHandler for testing.
+func RemovedLogHandler(store reldb.Service, relayer common.Address, parser fastbridge.Parser) listener.HandleRemovedLog {
+	return removedLogHandler(store, relayer, parser)
+}
@@ -4,12 +4,15 @@
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
 	"github.com/synapsecns/sanguine/services/rfq/contracts/fastbridge"
 	"github.com/synapsecns/sanguine/services/rfq/relayer/reldb"
 	"go.opentelemetry.io/otel/attribute"
@@ -195,13 +198,87 @@ func (q *QuoteRequestHandler) handleCommitConfirmed(ctx context.Context, _ trace
 		return fmt.Errorf("could not update quote request status: %w", err)
 	}
 
-	// TODO: store the dest txhash connected to the nonce
 	nonce, _, err := q.Dest.SubmitRelay(ctx, request)
 	if err != nil {
 		return fmt.Errorf("could not submit relay: %w", err)
 	}
-	_ = nonce
 
+	// store the nonce so the relay can be cancelled if the deadline passes before it lands.
+	err = q.db.UpdateRelayNonce(ctx, request.TransactionID, nonce)
+	if err != nil {
+		return fmt.Errorf("could not update relay nonce: %w", err)
+	}
+	return nil
+}
+
+// handleRelayStarted handles the relay started status.
+// Possible Errors: DeadlineExceeded, RelayRaceLost, WillNotProcess
+//
+// The relay is marked as completed once the relay log is seen (see handleRelayLog), so there is nothing to do here
+// unless the relay reverted or the deadline passes. Past the deadline, the relay can only revert, so we cancel it
+// rather than keep bumping it.
+func (q *QuoteRequestHandler) handleRelayStarted(ctx context.Context, span trace.Span, request reldb.QuoteRequest) (err error) {
+	if request.RelayNonce != nil {
+		span.SetAttributes(attribute.Int64("relay_nonce", int64(*request.RelayNonce)))
+
+		var status submitter.SubmissionStatus
+		status, err = q.Dest.GetSubmissionStatus(ctx, *request.RelayNonce)
+		if err != nil {
+			return fmt.Errorf("could not get relay status: %w", err)
+		}
+
+		//nolint: exhaustive
+		switch status.State() {
+		case submitter.Reverted:
+			return q.handleRelayReverted(ctx, span, request, status)
+		case submitter.Confirmed:
+			// the relay landed, we're just waiting on the relay log.
+			return nil
+		}
+	}
+
+	if request.Transaction.Deadline.Cmp(big.NewInt(time.Now().Unix())) >= 0 {
+		return nil
+	}
+
+	if request.RelayNonce != nil {
+		err = q.Dest.CancelTransaction(ctx, *request.RelayNonce)
+		// if the relay was already mined, the relay log will still be handled.
+		if err != nil && !errors.Is(err, submitter.ErrNotCancellable) {
+			return fmt.Errorf("could not cancel relay: %w", err)
+		}
+	}
+
+	err = q.db.UpdateQuoteRequestStatus(ctx, request.TransactionID, reldb.DeadlineExceeded)
+	if err != nil {
+		return fmt.Errorf("could not update request status: %w", err)
+	}
+	return nil
+}
+
+// handleRelayReverted handles a relay that was mined, but reverted. A reverted relay is never completed.
+// The request is marked as lost if another relayer relayed it, as past its deadline if that's why it reverted,
+// and as will not process otherwise.
+func (q *QuoteRequestHandler) handleRelayReverted(ctx context.Context, span trace.Span, request reldb.QuoteRequest, status submitter.SubmissionStatus) error {
+	span.AddEvent("relay reverted", trace.WithAttributes(
+		attribute.String("tx_hash", status.TxHash().String()),
+		attribute.String("revert_reason", status.RevertReason()),
+	))
+
+	relayed, err := q.Dest.Bridge.BridgeRelays(&bind.CallOpts{Context: ctx}, request.TransactionID)
+	if err != nil {
+		return fmt.Errorf("could not check if relayed: %w", err)
+	}
+
+	newStatus := reldb.WillNotProcess
+	switch {
+	case relayed:
+		newStatus = reldb.RelayRaceLost
+	case request.Transaction.Deadline.Cmp(big.NewInt(time.Now().Unix())) < 0:
+		newStatus = reldb.DeadlineExceeded
+	}
+
+	err = q.db.UpdateQuoteRequestStatus(ctx, request.TransactionID, newStatus)
 	if err != nil {
 		return fmt.Errorf("could not update request status: %w", err)
 	}
@@ -218,8 +295,9 @@ func (r *Relayer) handleRelayLog(ctx context.Context, req *fastbridge.FastBridge
 	if err != nil {
 		return fmt.Errorf("could not get quote request: %w", err)
 	}
-	// we might've accidentally gotten this later, if so we'll just ignore it
-	if reqID.Status != reldb.RelayStarted {
+	// we might've accidentally gotten this later, if so we'll just ignore it.
+	// a relay that was mined before it could be cancelled still needs to be proven.
+	if reqID.Status != reldb.RelayStarted && reqID.Status != reldb.DeadlineExceeded {
 		logger.Warnf("got relay log for request that was not relay started (transaction id: %s, txhash: %s)", hexutil.Encode(reqID.TransactionID[:]), req.Raw.TxHash)
 		return nil
 	}
@@ -11,11 +11,13 @@
 	"github.com/ipfs/go-log"
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/ginhelper"
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/ethergo/listener"
 	signerConfig "github.com/synapsecns/sanguine/ethergo/signer/config"
 	"github.com/synapsecns/sanguine/ethergo/signer/signer"
 	"github.com/synapsecns/sanguine/ethergo/submitter"
+	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/attestation"
 	cctpSql "github.com/synapsecns/sanguine/services/cctp-relayer/db/sql"
 	"github.com/synapsecns/sanguine/services/cctp-relayer/relayer"
@@ -65,6 +67,12 @@ func NewRelayer(ctx context.Context, metricHandler metrics.Handler, cfg relconfi
 	if err != nil {
 		return nil, fmt.Errorf("could not make db: %w", err)
 	}
+	sg, err := signerConfig.SignerFromConfig(ctx, cfg.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("could not get signer: %w", err)
+	}
+	fmt.Printf("loaded signer with address: %s\n", sg.Address().String())
+
 	chainListeners := make(map[int]listener.ContractListener)
 
 	// setup chain listeners
@@ -86,20 +94,34 @@ func NewRelayer(ctx context.Context, metricHandler metrics.Handler, cfg relconfi
 		if err != nil {
 			return nil, fmt.Errorf("could not get deploy block: %w", err)
 		}
-		chainListener, err := listener.NewChainListener(chainClient, store, common.HexToAddress(rfqAddr), uint64(startBlock.Int64()), metricHandler)
+		listenerCfg, err := cfg.GetListenerConfig(chainID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get listener config: %w", err)
+		}
+		listenerOpts, err := listenerCfg.Options(ctx, metricHandler)
+		if err != nil {
+			return nil, fmt.Errorf("could not get listener options: %w", err)
+		}
+		parser, err := fastbridge.NewParser(common.HexToAddress(rfqAddr))
+		if err != nil {
+			return nil, fmt.Errorf("could not get parser: %w", err)
+		}
+		// requests are acted on as soon as they're seen, so undo the ones that are reorged out.
+		listenerOpts = append(listenerOpts, listener.WithRemovedLogHandler(removedLogHandler(store, sg.Address(), parser)))
+		chainListener, err := listener.NewChainListener(chainClient, store, common.HexToAddress(rfqAddr), uint64(startBlock.Int64()), metricHandler, listenerOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("could not get chain listener: %w", err)
 		}
 		chainListeners[chainID] = chainListener
 	}
 
-	sg, err := signerConfig.SignerFromConfig(ctx, cfg.Signer)
+	// decode the fast bridge's custom errors when relays revert.
+	fastBridgeABI, err := fastbridge.FastBridgeMetaData.GetAbi()
 	if err != nil {
-		return nil, fmt.Errorf("could not get signer: %w", err)
+		return nil, fmt.Errorf("could not get fast bridge abi: %w", err)
 	}
-	fmt.Printf("loaded signer with address: %s\n", sg.Address().String())
 
-	sm := submitter.NewTransactionSubmitter(metricHandler, sg, omniClient, store.SubmitterDB(), &cfg.SubmitterConfig)
+	sm := submitter.NewTransactionSubmitter(metricHandler, sg, omniClient, store.SubmitterDB(), &cfg.SubmitterConfig, submitter.WithRevertABIs(fastBridgeABI))
 
 	im, err := inventory.NewInventoryManager(ctx, omniClient, metricHandler, cfg, sg.Address(), sm, store)
 	if err != nil {
@@ -118,6 +140,7 @@ func NewRelayer(ctx context.Context, metricHandler metrics.Handler, cfg relconfi
 	if err != nil {
 		return nil, fmt.Errorf("could not get api server: %w", err)
 	}
+	apiServer.Health().Register("submitter_backlog", submitterBacklogCheck(store.SubmitterDB(), sg.Address()))
 
 	cache := ttlcache.New[common.Hash, bool](ttlcache.WithTTL[common.Hash, bool](time.Second * 30))
 	rel := Relayer{
@@ -138,6 +161,20 @@ func NewRelayer(ctx context.Context, metricHandler metrics.Handler, cfg relconfi
 
 const defaultPostInterval = 1
 
+// maxSubmitterBacklog is the number of unconfirmed transactions the submitter can hold before the relayer is not ready.
+const maxSubmitterBacklog = 500
+
+// submitterBacklogCheck checks that the number of unconfirmed transactions is below maxSubmitterBacklog.
+func submitterBacklogCheck(store submitterDB.Service, address common.Address) ginhelper.CheckFunc {
+	return ginhelper.ThresholdCheck("submitter backlog", func(ctx context.Context) (uint64, error) {
+		count, err := store.CountNoncesByStatus(ctx, address, nil, submitterDB.Pending, submitterDB.Stored, submitterDB.Submitted, submitterDB.FailedSubmit)
+		if err != nil {
+			return 0, fmt.Errorf("could not count unconfirmed txs: %w", err)
+		}
+		return count, nil
+	}, maxSubmitterBacklog)
+}
+
 // Start starts the relayer.
 //
 // This will:
@@ -289,14 +326,15 @@ func (r *Relayer) startCCTPRelayer(ctx context.Context) (err error) {
 }
 
 func (r *Relayer) processDB(ctx context.Context) error {
-	requests, err := r.db.GetQuoteResultsByStatus(ctx, reldb.Seen, reldb.CommittedPending, reldb.CommittedConfirmed, reldb.RelayCompleted, reldb.ProvePosted, reldb.NotEnoughInventory)
+	requests, err := r.db.GetQuoteResultsByStatus(ctx, reldb.Seen, reldb.CommittedPending, reldb.CommittedConfirmed, reldb.RelayStarted, reldb.RelayCompleted, reldb.ProvePosted, reldb.NotEnoughInventory)
 	if err != nil {
 		return fmt.Errorf("could not get quote results: %w", err)
 	}
 	// Obviously, these are only seen.
 	for _, request := range requests {
 		// if deadline < now
-		if request.Transaction.Deadline.Cmp(big.NewInt(time.Now().Unix())) < 0 && request.Status.Int() < reldb.RelayCompleted.Int() {
+		// started relays are cancelled by their handler.
+		if request.Transaction.Deadline.Cmp(big.NewInt(time.Now().Unix())) < 0 && request.Status.Int() < reldb.RelayStarted.Int() {
 			err = r.db.UpdateQuoteRequestStatus(ctx, request.TransactionID, reldb.DeadlineExceeded)
 			if err != nil {
 				return fmt.Errorf("could not update request status: %w", err)
@@ -73,6 +73,7 @@ func (r *Relayer) requestToHandler(ctx context.Context, req reldb.QuoteRequest)
 	qr.handlers[reldb.Seen] = r.deadlineMiddleware(r.gasMiddleware(qr.handleSeen))
 	qr.handlers[reldb.CommittedPending] = r.deadlineMiddleware(r.gasMiddleware(qr.handleCommitPending))
 	qr.handlers[reldb.CommittedConfirmed] = r.deadlineMiddleware(r.gasMiddleware(qr.handleCommitConfirmed))
+	qr.handlers[reldb.RelayStarted] = qr.handleRelayStarted
 	// no more need for deadline middleware now, we already relayed.
 	qr.handlers[reldb.RelayCompleted] = qr.handleRelayCompleted
 	qr.handlers[reldb.ProvePosted] = qr.handleProofPosted
@@ -12,10 +12,12 @@
 	"github.com/synapsecns/sanguine/core/metrics"
 	"github.com/synapsecns/sanguine/services/scribe/db"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
 	gqlServer "github.com/synapsecns/sanguine/services/scribe/graphql/server"
 	"github.com/synapsecns/sanguine/services/scribe/grpc/server"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 	"net"
 	"net/http"
 	"os"
@@ -54,6 +56,12 @@ func Start(ctx context.Context, cfg Config, handler metrics.Handler) error {
 		return fmt.Errorf("could not initialize database: %w", err)
 	}
 
+	health := ginhelper.NewHealthRegistry()
+	if gormStore, ok := eventDB.(interface{ DB() *gorm.DB }); ok {
+		health.Register("db", ginhelper.DBCheck(gormStore.DB()), ginhelper.Readiness)
+	}
+	health.Mount(router)
+
 	router.Use(handler.Gin())
 	gqlServer.EnableGraphql(router, eventDB, cfg.OmniRPCURL, handler)
 	grpcServer, err := server.SetupGRPCServer(ctx, router, eventDB, handler)
@@ -156,6 +164,13 @@ func InitDB(ctx context.Context, databaseType string, path string, metrics metri
 		}
 
 		return mysqlStore, nil
+	case databaseType == "postgres":
+		postgresStore, err := postgres.NewPostgresStore(ctx, path, metrics, skipMigrations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create postgres store: %w", err)
+		}
+
+		return postgresStore, nil
 	default:
 		return nil, fmt.Errorf("invalid databaseType type: %s", databaseType)
 	}
@@ -50,7 +50,7 @@
 
 var dbFlag = &cli.StringFlag{
 	Name:     "db",
-	Usage:    "--db <sqlite> or <mysql>",
+	Usage:    "--db <sqlite>, <mysql> or <postgres>",
 	Value:    "sqlite",
 	Required: true,
 }
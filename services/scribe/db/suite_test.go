@@ -21,6 +21,7 @@
 	. "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/mysql"
+	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/postgres"
 	"github.com/synapsecns/sanguine/services/scribe/db/datastore/sql/sqlite"
 	"gorm.io/gorm/schema"
 )
@@ -50,6 +51,7 @@ func (t *DBSuite) SetupTest() {
 
 	t.dbs = []db.EventDB{sqliteStore}
 	t.setupMysqlDB()
+	t.setupPostgresDB()
 }
 
 func (t *DBSuite) SetupSuite() {
@@ -102,6 +104,26 @@ func (t *DBSuite) setupMysqlDB() {
 	t.dbs = append(t.dbs, mysqlStore)
 }
 
+func (t *DBSuite) setupPostgresDB() {
+	// skip if postgres test disabled, this really only needs to be run in ci
+	if os.Getenv(dbcommon.EnablePostgresTestVar) == "" {
+		return
+	}
+
+	// override the naming strategy to prevent tests from messing with each other.
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("test%d_%d_", t.GetTestID(), time.Now().Unix()),
+	}
+
+	postgres.MaxIdleConns = 10
+	postgres.MaxOpenConns = 10
+
+	postgresStore, err := postgres.NewPostgresStore(t.GetTestContext(), dbcommon.GetTestPostgresConnString(), t.scribeMetrics, false)
+	Nil(t.T(), err)
+
+	t.dbs = append(t.dbs, postgresStore)
+}
+
 func (t *DBSuite) RunOnAllDBs(testFunc func(testDB db.EventDB)) {
 	t.T().Helper()
 
@@ -18,6 +18,7 @@
 	"github.com/synapsecns/sanguine/services/stiprelayer/db"
 	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql"
 	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql/mysql"
+	"github.com/synapsecns/sanguine/services/stiprelayer/db/sql/postgres"
 	"github.com/synapsecns/sanguine/services/stiprelayer/metadata"
 	"gorm.io/gorm/schema"
 )
@@ -62,6 +63,7 @@ func (d *DBSuite) SetupTest() {
 
 	d.dbs = []db.STIPDB{sqliteStore}
 	d.setupMysqlDB()
+	d.setupPostgresDB()
 }
 
 func (d *DBSuite) setupMysqlDB() {
@@ -89,6 +91,21 @@ func (d *DBSuite) setupMysqlDB() {
 	d.dbs = append(d.dbs, mysqlStore)
 }
 
+func (d *DBSuite) setupPostgresDB() {
+	if os.Getenv(dbcommon.EnablePostgresTestVar) != "true" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("stip_%d", d.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(d.GetTestContext(), dbcommon.GetTestPostgresConnString(), d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs = append(d.dbs, postgresStore)
+}
+
 func (d *DBSuite) RunOnAllDBs(testFunc func(testDB db.STIPDB)) {
 	d.T().Helper()
 
@@ -2,6 +2,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/synapsecns/sanguine/ethergo/signer/config"
 	submitterConfig "github.com/synapsecns/sanguine/ethergo/submitter/config"
 )
@@ -18,6 +20,14 @@ type Config struct {
 	Signer config.SignerConfig `yaml:"signer"`
 	// Submitter is the submitter config.
 	SubmitterConfig submitterConfig.Config `yaml:"submitter_config"`
+	// ExecutionTimeoutSeconds is how long an execution can stay unmined before it's cancelled. Interchain
+	// transactions don't have an on-chain deadline, so this is the executor's own. Zero never cancels executions.
+	ExecutionTimeoutSeconds int `yaml:"execution_timeout_seconds"`
+}
+
+// GetExecutionTimeout returns how long an execution can stay unmined before it's cancelled, or zero if it never is.
+func (c Config) GetExecutionTimeout() time.Duration {
+	return time.Duration(c.ExecutionTimeoutSeconds) * time.Second
 }
 
 // ChainConfig represents the configuration for a chain.
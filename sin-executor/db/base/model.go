@@ -9,6 +9,8 @@ func init() {
 	blockNumberFieldName = namer.GetConsistentName("BlockNumber")
 	statusFieldName = namer.GetConsistentName("Status")
 	transactionIDFieldName = namer.GetConsistentName("TransactionID")
+	executionNonceFieldName = namer.GetConsistentName("ExecutionNonce")
+	executionDeadlineFieldName = namer.GetConsistentName("ExecutionDeadline")
 }
 
 var (
@@ -20,4 +22,8 @@ func init() {
 	statusFieldName string
 	// transactionIDFieldName is the name of the transaction id field.
 	transactionIDFieldName string
+	// executionNonceFieldName is the name of the execution nonce field.
+	executionNonceFieldName string
+	// executionDeadlineFieldName is the name of the execution deadline field.
+	executionDeadlineFieldName string
 )
@@ -2,6 +2,7 @@
 
 import (
 	"github.com/synapsecns/sanguine/core/metrics"
+	listenerDB "github.com/synapsecns/sanguine/ethergo/listener/db"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/ethergo/submitter/db/txdb"
 	"github.com/synapsecns/sanguine/sin-executor/db"
@@ -10,6 +11,7 @@
 
 // Store implements the service.
 type Store struct {
+	listenerDB.ReorgDB
 	db             *gorm.DB
 	submitterStore submitterDB.Service
 }
@@ -19,6 +21,7 @@ func NewStore(db *gorm.DB, metrics metrics.Handler) *Store {
 	txDB := txdb.NewTXStore(db, metrics)
 
 	return &Store{
+		ReorgDB:        listenerDB.NewChainListenerStore(db, metrics),
 		db:             db,
 		submitterStore: txDB,
 	}
@@ -37,7 +40,7 @@ func (s Store) SubmitterDB() submitterDB.Service {
 // GetAllModels gets all models to migrate
 // see: https://medium.com/@SaifAbid/slice-interfaces-8c78f8b6345d for an explanation of why we can't do this at initialization time
 func GetAllModels() (allModels []interface{}) {
-	allModels = append(txdb.GetAllModels(), &LastIndexed{}, &InterchainTransaction{})
+	allModels = append(txdb.GetAllModels(), &LastIndexed{}, &InterchainTransaction{}, &listenerDB.ProcessedBlock{}, &listenerDB.ProcessedLog{})
 	return allModels
 }
 
@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/sin-executor/contracts/interchainclient"
@@ -27,6 +28,10 @@ type InterchainTransaction struct {
 	GasLimit string `gorm:"column:gas_limit;index"`
 	// EncodedTx is the encoded transaction.
 	EncodedTx string `gorm:"column:encoded_tx"`
+	// ExecutionNonce is the nonce of the submitter tx executing the transaction.
+	ExecutionNonce *uint64 `gorm:"column:execution_nonce"`
+	// ExecutionDeadline is when the execution is cancelled if it hasn't been mined.
+	ExecutionDeadline *time.Time `gorm:"column:execution_deadline;index"`
 }
 
 // ToTransactionSent converts the interchain transaction to a transaction sent.
@@ -51,6 +56,8 @@ func (s InterchainTransaction) ToTransactionSent() (db.TransactionSent, error) {
 			GasAirdrop: airdrop,
 			GasLimit:   gasLimit,
 		},
+		ExecutionNonce:    s.ExecutionNonce,
+		ExecutionDeadline: s.ExecutionDeadline,
 	}, nil
 }
 
@@ -91,6 +98,25 @@ func (s Store) GetInterchainTXsByStatus(ctx context.Context, matchStatuses ...db
 		return []db.TransactionSent{}, fmt.Errorf("could not get db results: %w", tx.Error)
 	}
 
+	return toTransactionsSent(interchainTransactions)
+}
+
+// GetExpiredExecutions gets the executed interchain transactions whose execution deadline is before now.
+func (s Store) GetExpiredExecutions(ctx context.Context, now time.Time) ([]db.TransactionSent, error) {
+	var interchainTransactions []InterchainTransaction
+
+	tx := s.DB().WithContext(ctx).Model(&InterchainTransaction{}).
+		Where(fmt.Sprintf("%s = ?", statusFieldName), db.Executed.Int()).
+		Where(fmt.Sprintf("%s < ?", executionDeadlineFieldName), now).
+		Find(&interchainTransactions)
+	if tx.Error != nil {
+		return []db.TransactionSent{}, fmt.Errorf("could not get db results: %w", tx.Error)
+	}
+
+	return toTransactionsSent(interchainTransactions)
+}
+
+func toTransactionsSent(interchainTransactions []InterchainTransaction) (res []db.TransactionSent, err error) {
 	for _, result := range interchainTransactions {
 		marshaled, err := result.ToTransactionSent()
 		if err != nil {
@@ -109,3 +135,51 @@ func (s Store) UpdateInterchainTransactionStatus(ctx context.Context, transactio
 	}
 	return nil
 }
+
+// DeleteInterchainTransaction deletes an interchain transaction if its status is one of matchStatuses, returning false
+// if it wasn't deleted.
+func (s Store) DeleteInterchainTransaction(ctx context.Context, transactionid [32]byte, matchStatuses ...db.ExecutableStatus) (bool, error) {
+	inArgs := make([]int, len(matchStatuses))
+	for i := range matchStatuses {
+		inArgs[i] = int(matchStatuses[i].Int())
+	}
+
+	tx := s.DB().WithContext(ctx).
+		Where(fmt.Sprintf("%s = ?", transactionIDFieldName), common.Bytes2Hex(transactionid[:])).
+		Where(fmt.Sprintf("%s IN ?", statusFieldName), inArgs).
+		Delete(&InterchainTransaction{})
+	if tx.Error != nil {
+		return false, fmt.Errorf("could not delete interchain transaction: %w", tx.Error)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// MarkInterchainTransactionExecuted marks an interchain transaction as executed by the submitter tx with the given
+// nonce. If deadline isn't nil, the execution is cancelled if it isn't mined by then.
+func (s Store) MarkInterchainTransactionExecuted(ctx context.Context, transactionid [32]byte, nonce uint64, deadline *time.Time) error {
+	tx := s.DB().WithContext(ctx).Model(&InterchainTransaction{}).
+		Where(fmt.Sprintf("%s = ?", transactionIDFieldName), common.Bytes2Hex(transactionid[:])).
+		Updates(map[string]interface{}{
+			statusFieldName:            db.Executed,
+			executionNonceFieldName:    nonce,
+			executionDeadlineFieldName: deadline,
+		})
+	if tx.Error != nil {
+		return fmt.Errorf("could not mark interchain transaction executed: %w", tx.Error)
+	}
+	return nil
+}
+
+// ResolveExecution sets the final status of an execution and clears its deadline.
+func (s Store) ResolveExecution(ctx context.Context, transactionid [32]byte, status db.ExecutableStatus) error {
+	tx := s.DB().WithContext(ctx).Model(&InterchainTransaction{}).
+		Where(fmt.Sprintf("%s = ?", transactionIDFieldName), common.Bytes2Hex(transactionid[:])).
+		Updates(map[string]interface{}{
+			statusFieldName:            status,
+			executionDeadlineFieldName: nil,
+		})
+	if tx.Error != nil {
+		return fmt.Errorf("could not resolve execution: %w", tx.Error)
+	}
+	return nil
+}
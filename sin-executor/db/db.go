@@ -6,9 +6,11 @@
 	"errors"
 	"fmt"
 	"github.com/synapsecns/sanguine/core/dbcommon"
+	listenerDB "github.com/synapsecns/sanguine/ethergo/listener/db"
 	submitterDB "github.com/synapsecns/sanguine/ethergo/submitter/db"
 	"github.com/synapsecns/sanguine/sin-executor/contracts/interchainclient"
 	"math/big"
+	"time"
 )
 
 // ErrNoLatestBlockForChainID is returned when no block exists for the chain.
@@ -18,6 +20,8 @@
 type Reader interface {
 	LatestBlockForChain(ctx context.Context, chainID uint64) (uint64, error)
 	GetInterchainTXsByStatus(ctx context.Context, statuses ...ExecutableStatus) ([]TransactionSent, error)
+	// GetExpiredExecutions gets the executed interchain transactions whose execution deadline is before now.
+	GetExpiredExecutions(ctx context.Context, now time.Time) ([]TransactionSent, error)
 }
 
 // Writer is the interface for writing to the database.
@@ -25,12 +29,22 @@ type Writer interface {
 	PutLatestBlock(ctx context.Context, chainID, height uint64) error
 	StoreInterchainTransaction(ctx context.Context, originChainID *big.Int, interchainTx *interchainclient.InterchainClientV1InterchainTransactionSent, options *interchainclient.OptionsV1, encodedTX []byte) error
 	UpdateInterchainTransactionStatus(ctx context.Context, transactionid [32]byte, statuses ExecutableStatus) error
+	// DeleteInterchainTransaction deletes an interchain transaction if its status is one of matchStatuses, returning
+	// false if it wasn't deleted.
+	DeleteInterchainTransaction(ctx context.Context, transactionid [32]byte, matchStatuses ...ExecutableStatus) (bool, error)
+	// MarkInterchainTransactionExecuted marks an interchain transaction as executed by the submitter tx with the given
+	// nonce. If deadline isn't nil, the execution is cancelled if it isn't mined by then.
+	MarkInterchainTransactionExecuted(ctx context.Context, transactionid [32]byte, nonce uint64, deadline *time.Time) error
+	// ResolveExecution sets the final status of an execution and clears its deadline.
+	ResolveExecution(ctx context.Context, transactionid [32]byte, status ExecutableStatus) error
 }
 
 // Service is the interface for the database service.
 type Service interface {
 	Reader
 	Writer
+	// ReorgDB stores the blocks processed by the reorg aware chain listeners.
+	listenerDB.ReorgDB
 	// SubmitterDB returns the submitter database service.
 	SubmitterDB() submitterDB.Service
 }
@@ -47,6 +61,9 @@ type Service interface {
 	Ready
 	// Executed is the status of a synapse request that has been executed.
 	Executed
+	// Abandoned is the status of a synapse request whose execution was cancelled because it wasn't mined before its
+	// deadline.
+	Abandoned
 )
 
 // Int returns the integer value of the synapse request status.
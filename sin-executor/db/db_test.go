@@ -0,0 +1,102 @@
+package db_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/synapsecns/sanguine/sin-executor/contracts/interchainclient"
+	"github.com/synapsecns/sanguine/sin-executor/db"
+)
+
+// storeTransaction stores an interchain transaction from chain 1 to chain 2 with the given id and status.
+func (d *DBSuite) storeTransaction(testDB db.Service, transactionID common.Hash, status db.ExecutableStatus) {
+	err := testDB.StoreInterchainTransaction(d.GetTestContext(), big.NewInt(1), &interchainclient.InterchainClientV1InterchainTransactionSent{
+		TransactionId: transactionID,
+		DstChainId:    2,
+	}, &interchainclient.OptionsV1{
+		GasLimit:   big.NewInt(100_000),
+		GasAirdrop: big.NewInt(0),
+	}, []byte{1, 2, 3})
+	d.Require().NoError(err)
+
+	if status != db.Seen {
+		err = testDB.UpdateInterchainTransactionStatus(d.GetTestContext(), transactionID, status)
+		d.Require().NoError(err)
+	}
+}
+
+// transactionIDs gets the ids of the interchain transactions with one of the given statuses.
+func (d *DBSuite) transactionIDs(testDB db.Service, statuses ...db.ExecutableStatus) (ids []common.Hash) {
+	txs, err := testDB.GetInterchainTXsByStatus(d.GetTestContext(), statuses...)
+	d.Require().NoError(err)
+
+	for _, tx := range txs {
+		ids = append(ids, tx.TransactionID)
+	}
+	return ids
+}
+
+func (d *DBSuite) TestDeleteInterchainTransaction() {
+	d.RunOnAllDBs(func(testDB db.Service) {
+		seen := common.BigToHash(big.NewInt(1))
+		ready := common.BigToHash(big.NewInt(2))
+		executed := common.BigToHash(big.NewInt(3))
+
+		d.storeTransaction(testDB, seen, db.Seen)
+		d.storeTransaction(testDB, ready, db.Ready)
+		d.storeTransaction(testDB, executed, db.Executed)
+
+		// only the matching transaction is deleted.
+		deleted, err := testDB.DeleteInterchainTransaction(d.GetTestContext(), seen, db.Seen, db.Ready)
+		d.Require().NoError(err)
+		d.True(deleted)
+		d.ElementsMatch([]common.Hash{ready, executed}, d.transactionIDs(testDB, db.Seen, db.Ready, db.Executed))
+
+		// a transaction isn't deleted unless its status matches.
+		deleted, err = testDB.DeleteInterchainTransaction(d.GetTestContext(), executed, db.Seen, db.Ready)
+		d.Require().NoError(err)
+		d.False(deleted)
+		d.ElementsMatch([]common.Hash{ready, executed}, d.transactionIDs(testDB, db.Seen, db.Ready, db.Executed))
+
+		// deleting a transaction that isn't stored is a no-op.
+		deleted, err = testDB.DeleteInterchainTransaction(d.GetTestContext(), seen, db.Seen, db.Ready)
+		d.Require().NoError(err)
+		d.False(deleted)
+	})
+}
+
+func (d *DBSuite) TestExpiredExecutions() {
+	d.RunOnAllDBs(func(testDB db.Service) {
+		expired := common.BigToHash(big.NewInt(1))
+		notExpired := common.BigToHash(big.NewInt(2))
+		noDeadline := common.BigToHash(big.NewInt(3))
+
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+
+		for i, deadline := range []*time.Time{&past, &future, nil} {
+			transactionID := []common.Hash{expired, notExpired, noDeadline}[i]
+			d.storeTransaction(testDB, transactionID, db.Ready)
+
+			err := testDB.MarkInterchainTransactionExecuted(d.GetTestContext(), transactionID, uint64(i), deadline)
+			d.Require().NoError(err)
+		}
+
+		executions, err := testDB.GetExpiredExecutions(d.GetTestContext(), time.Now())
+		d.Require().NoError(err)
+		d.Require().Len(executions, 1)
+		d.Equal(expired, executions[0].TransactionID)
+		d.Require().NotNil(executions[0].ExecutionNonce)
+		d.Equal(uint64(0), *executions[0].ExecutionNonce)
+
+		// a resolved execution is no longer expired.
+		err = testDB.ResolveExecution(d.GetTestContext(), expired, db.Abandoned)
+		d.Require().NoError(err)
+
+		executions, err = testDB.GetExpiredExecutions(d.GetTestContext(), time.Now())
+		d.Require().NoError(err)
+		d.Empty(executions)
+		d.Equal([]common.Hash{expired}, d.transactionIDs(testDB, db.Abandoned))
+	})
+}
@@ -11,11 +11,12 @@ func _() {
 	_ = x[Seen-1]
 	_ = x[Ready-2]
 	_ = x[Executed-3]
+	_ = x[Abandoned-4]
 }
 
-const _ExecutableStatus_name = "SeenReadyExecuted"
+const _ExecutableStatus_name = "SeenReadyExecutedAbandoned"
 
-var _ExecutableStatus_index = [...]uint8{0, 4, 9, 17}
+var _ExecutableStatus_index = [...]uint8{0, 4, 9, 17, 26}
 
 func (i ExecutableStatus) String() string {
 	i -= 1
@@ -4,6 +4,7 @@
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/synapsecns/sanguine/sin-executor/contracts/interchainclient"
 	"math/big"
+	"time"
 )
 
 // TransactionSent is the transaction sent model. It tracks the data about current status of the transaction/execution.
@@ -19,4 +20,8 @@ type TransactionSent struct {
 	Status ExecutableStatus
 	// Options is the options of the transaction.
 	Options interchainclient.OptionsV1
+	// ExecutionNonce is the nonce of the submitter tx executing the transaction, if it's been executed.
+	ExecutionNonce *uint64
+	// ExecutionDeadline is when the execution is cancelled if it hasn't been mined.
+	ExecutionDeadline *time.Time
 }
@@ -0,0 +1,116 @@
+package db_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/Flaque/filet"
+	. "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/synapsecns/sanguine/core"
+	"github.com/synapsecns/sanguine/core/dbcommon"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/core/metrics/localmetrics"
+	"github.com/synapsecns/sanguine/core/testsuite"
+	"github.com/synapsecns/sanguine/sin-executor/db"
+	"github.com/synapsecns/sanguine/sin-executor/db/mysql"
+	"github.com/synapsecns/sanguine/sin-executor/db/postgres"
+	"github.com/synapsecns/sanguine/sin-executor/db/sqlite"
+	"github.com/synapsecns/sanguine/sin-executor/metadata"
+	"gorm.io/gorm/schema"
+)
+
+type DBSuite struct {
+	*testsuite.TestSuite
+	dbs     []db.Service
+	metrics metrics.Handler
+}
+
+// NewDBSuite creates a new DBSuite.
+func NewDBSuite(tb testing.TB) *DBSuite {
+	tb.Helper()
+	return &DBSuite{
+		TestSuite: testsuite.NewTestSuite(tb),
+		dbs:       []db.Service{},
+	}
+}
+
+func (d *DBSuite) SetupSuite() {
+	d.TestSuite.SetupSuite()
+
+	// don't use metrics on ci for integration tests
+	isCI := core.GetEnvBool("CI", false)
+	useMetrics := !isCI
+	metricsHandler := metrics.Null
+
+	if useMetrics {
+		localmetrics.SetupTestJaeger(d.GetSuiteContext(), d.T())
+		metricsHandler = metrics.Jaeger
+	}
+
+	var err error
+	d.metrics, err = metrics.NewByType(d.GetSuiteContext(), metadata.BuildInfo(), metricsHandler)
+	Nil(d.T(), err)
+}
+
+func (d *DBSuite) SetupTest() {
+	d.TestSuite.SetupTest()
+
+	sqliteStore, err := sqlite.NewSqliteStore(d.GetTestContext(), filet.TmpDir(d.T(), ""), d.metrics)
+	Nil(d.T(), err)
+
+	d.dbs = []db.Service{sqliteStore}
+	d.setupMysqlDB()
+	d.setupPostgresDB()
+}
+
+func (d *DBSuite) setupMysqlDB() {
+	if os.Getenv(dbcommon.EnableMysqlTestVar) != "true" {
+		return
+	}
+
+	mysql.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("sin_%d", d.GetTestID()),
+	}
+
+	mysqlStore, err := mysql.NewMysqlStore(d.GetTestContext(), dbcommon.GetTestConnString(), d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs = append(d.dbs, mysqlStore)
+}
+
+func (d *DBSuite) setupPostgresDB() {
+	if os.Getenv(dbcommon.EnablePostgresTestVar) != "true" {
+		return
+	}
+
+	postgres.NamingStrategy = schema.NamingStrategy{
+		TablePrefix: fmt.Sprintf("sin_%d", d.GetTestID()),
+	}
+
+	postgresStore, err := postgres.NewPostgresStore(d.GetTestContext(), dbcommon.GetTestPostgresConnString(), d.metrics)
+	d.Require().NoError(err)
+
+	d.dbs = append(d.dbs, postgresStore)
+}
+
+func (d *DBSuite) RunOnAllDBs(testFunc func(testDB db.Service)) {
+	d.T().Helper()
+
+	wg := sync.WaitGroup{}
+	for _, testDB := range d.dbs {
+		wg.Add(1)
+		// capture the value
+		go func(testDB db.Service) {
+			defer wg.Done()
+			testFunc(testDB)
+		}(testDB)
+	}
+	wg.Wait()
+}
+
+func TestDBSuite(t *testing.T) {
+	suite.Run(t, NewDBSuite(t))
+}
@@ -0,0 +1,110 @@
+package executor_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/sin-executor/config"
+	"github.com/synapsecns/sanguine/sin-executor/contracts/interchainclient"
+	"github.com/synapsecns/sanguine/sin-executor/db"
+	"github.com/synapsecns/sanguine/sin-executor/db/sqlite"
+	"github.com/synapsecns/sanguine/sin-executor/executor"
+)
+
+// fakeStatus is a submission status that only has a state.
+type fakeStatus struct {
+	submitter.SubmissionStatus
+	state submitter.SubmissionState
+}
+
+func (f fakeStatus) State() submitter.SubmissionState {
+	return f.state
+}
+
+// fakeSubmitter is a submitter that reports a fixed state for each nonce and records cancellations.
+type fakeSubmitter struct {
+	submitter.TransactionSubmitter
+	states    map[uint64]submitter.SubmissionState
+	cancelled []uint64
+}
+
+func (f *fakeSubmitter) GetSubmissionStatus(_ context.Context, _ *big.Int, nonce uint64) (submitter.SubmissionStatus, error) {
+	return fakeStatus{state: f.states[nonce]}, nil
+}
+
+func (f *fakeSubmitter) CancelTransaction(_ context.Context, _ *big.Int, nonce uint64) error {
+	f.cancelled = append(f.cancelled, nonce)
+	return nil
+}
+
+func TestAbandonExpiredExecutions(t *testing.T) {
+	ctx := context.Background()
+	handler := metrics.NewNullHandler()
+
+	store, err := sqlite.NewSqliteStore(ctx, filet.TmpDir(t, ""), handler)
+	require.NoError(t, err)
+
+	pending := common.BigToHash(big.NewInt(1))
+	mined := common.BigToHash(big.NewInt(2))
+	notExpired := common.BigToHash(big.NewInt(3))
+
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	executions := []struct {
+		transactionID common.Hash
+		nonce         uint64
+		deadline      *time.Time
+	}{
+		{pending, 1, &past},
+		{mined, 2, &past},
+		{notExpired, 3, &future},
+	}
+	for _, execution := range executions {
+		err = store.StoreInterchainTransaction(ctx, big.NewInt(1), &interchainclient.InterchainClientV1InterchainTransactionSent{
+			TransactionId: execution.transactionID,
+			DstChainId:    2,
+		}, &interchainclient.OptionsV1{GasLimit: big.NewInt(100_000), GasAirdrop: big.NewInt(0)}, nil)
+		require.NoError(t, err)
+
+		err = store.MarkInterchainTransactionExecuted(ctx, execution.transactionID, execution.nonce, execution.deadline)
+		require.NoError(t, err)
+	}
+
+	txSubmitter := &fakeSubmitter{states: map[uint64]submitter.SubmissionState{
+		1: submitter.Pending,
+		2: submitter.Confirmed,
+		3: submitter.Pending,
+	}}
+	testExecutor := executor.NewTestExecutor(store, txSubmitter, config.Config{ExecutionTimeoutSeconds: 60}, handler)
+
+	err = testExecutor.AbandonExpiredExecutions(ctx)
+	require.NoError(t, err)
+
+	// only the expired execution that's still pending is cancelled.
+	require.Equal(t, []uint64{1}, txSubmitter.cancelled)
+
+	abandoned, err := store.GetInterchainTXsByStatus(ctx, db.Abandoned)
+	require.NoError(t, err)
+	require.Len(t, abandoned, 1)
+	require.Equal(t, pending, abandoned[0].TransactionID)
+
+	executed, err := store.GetInterchainTXsByStatus(ctx, db.Executed)
+	require.NoError(t, err)
+	require.Len(t, executed, 2)
+
+	// resolved executions aren't checked again.
+	expired, err := store.GetExpiredExecutions(ctx, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, expired)
+
+	err = testExecutor.AbandonExpiredExecutions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, txSubmitter.cancelled)
+}
@@ -3,6 +3,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -77,7 +78,13 @@ func NewExecutor(ctx context.Context, handler metrics.Handler, cfg config.Config
 			return nil, fmt.Errorf("could not get block number: %w", err)
 		}
 
-		chainListener, err := listener.NewChainListener(chainClient, executor.db, executionService, blockNum, handler)
+		parser, err := executionservice.NewParser(executionService)
+		if err != nil {
+			return nil, fmt.Errorf("could not get parser: %w", err)
+		}
+		// transactions are executed as soon as they're ready, so forget the ones that are reorged out.
+		chainListener, err := listener.NewChainListener(chainClient, executor.db, executionService, blockNum, handler,
+			listener.WithRemovedLogHandler(executor.removedLogHandler(parser)))
 		if err != nil {
 			return nil, fmt.Errorf("could not get chain listener: %w", err)
 		}
@@ -166,6 +173,11 @@ func (e *Executor) runDBSelector(ctx context.Context) error {
 					panic("unhandled default case")
 				}
 			}
+
+			err = e.abandonExpiredExecutions(ctx)
+			if err != nil {
+				e.metrics.ExperimentalLogger().Errorf(ctx, "could not abandon expired executions: %v", err)
+			}
 		}
 	}
 }
@@ -176,7 +188,7 @@ func (e *Executor) executeTransaction(ctx context.Context, request db.Transactio
 		return fmt.Errorf("could not get contract for chain %d", request.SrcChainID.Int64())
 	}
 
-	_, err := e.submitter.SubmitTransaction(ctx, request.DstChainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
+	nonce, err := e.submitter.SubmitTransaction(ctx, request.DstChainID, func(transactor *bind.TransactOpts) (tx *types.Transaction, err error) {
 		transactor.Value = request.Options.GasAirdrop
 
 		// nolint: wrapcheck
@@ -186,7 +198,14 @@ func (e *Executor) executeTransaction(ctx context.Context, request db.Transactio
 		return fmt.Errorf("could not submit transaction: %w", err)
 	}
 
-	err = e.db.UpdateInterchainTransactionStatus(ctx, request.TransactionID, db.Executed)
+	var deadline *time.Time
+	if timeout := e.cfg.GetExecutionTimeout(); timeout > 0 {
+		expiry := time.Now().Add(timeout)
+		deadline = &expiry
+	}
+
+	// store the nonce so the execution can be cancelled if the deadline passes before it lands.
+	err = e.db.MarkInterchainTransactionExecuted(ctx, request.TransactionID, nonce, deadline)
 	if err != nil {
 		return fmt.Errorf("could not update transaction status: %w", err)
 	}
@@ -194,6 +213,47 @@ func (e *Executor) executeTransaction(ctx context.Context, request db.Transactio
 	return nil
 }
 
+// abandonExpiredExecutions cancels the executions that weren't mined before their deadline, so they aren't bumped
+// forever. Executions that were mined in the meantime are kept.
+func (e *Executor) abandonExpiredExecutions(ctx context.Context) error {
+	expired, err := e.db.GetExpiredExecutions(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("could not get expired executions: %w", err)
+	}
+
+	for _, request := range expired {
+		if request.ExecutionNonce == nil {
+			continue
+		}
+
+		status, err := e.submitter.GetSubmissionStatus(ctx, request.DstChainID, *request.ExecutionNonce)
+		if err != nil {
+			return fmt.Errorf("could not get execution status: %w", err)
+		}
+
+		newStatus := db.Abandoned
+		//nolint: exhaustive
+		switch status.State() {
+		case submitter.Confirming, submitter.Confirmed, submitter.Reverted:
+			newStatus = db.Executed
+		case submitter.Pending:
+			err = e.submitter.CancelTransaction(ctx, request.DstChainID, *request.ExecutionNonce)
+			// the execution was mined before it could be cancelled.
+			if errors.Is(err, submitter.ErrNotCancellable) {
+				newStatus = db.Executed
+			} else if err != nil {
+				return fmt.Errorf("could not cancel execution: %w", err)
+			}
+		}
+
+		err = e.db.ResolveExecution(ctx, request.TransactionID, newStatus)
+		if err != nil {
+			return fmt.Errorf("could not resolve execution: %w", err)
+		}
+	}
+	return nil
+}
+
 func (e *Executor) checkReady(ctx context.Context, request db.TransactionSent) error {
 	contract, ok := e.clientContracts[int(request.DstChainID.Int64())]
 	if !ok {
@@ -338,6 +398,31 @@ func (e *Executor) runChainIndexer(parentCtx context.Context, chainID int) (err
 	return nil
 }
 
+// removedLogHandler forgets the interchain transactions whose execution request was removed by a reorg before they
+// were executed. If a removed request is mined again, it's stored again when its log is delivered.
+func (e *Executor) removedLogHandler(parser executionservice.Parser) listener.HandleRemovedLog {
+	return func(ctx context.Context, log types.Log) error {
+		_, parsedEvent, ok := parser.ParseEvent(log)
+		if !ok {
+			return nil
+		}
+
+		event, ok := parsedEvent.(*executionservice.SynapseExecutionServiceV1HarnessExecutionRequested)
+		if !ok {
+			return nil
+		}
+
+		deleted, err := e.db.DeleteInterchainTransaction(ctx, event.TransactionId, db.Seen, db.Ready)
+		if err != nil {
+			return fmt.Errorf("could not delete removed transaction: %w", err)
+		}
+		if !deleted {
+			e.metrics.ExperimentalLogger().Warnf(ctx, "transaction %s was removed by a reorg after it was executed", common.Hash(event.TransactionId))
+		}
+		return nil
+	}
+}
+
 // DB returns the db service.
 func (e *Executor) DB() db.Service {
 	return e.db
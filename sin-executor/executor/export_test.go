@@ -0,0 +1,27 @@
+package executor
+
+import (
+	"context"
+
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/ethergo/listener"
+	"github.com/synapsecns/sanguine/ethergo/submitter"
+	"github.com/synapsecns/sanguine/sin-executor/config"
+	"github.com/synapsecns/sanguine/sin-executor/contracts/executionservice"
+	"github.com/synapsecns/sanguine/sin-executor/db"
+)
+
+// NewTestExecutor creates an executor that only has a db and a submitter for testing.
+func NewTestExecutor(store db.Service, txSubmitter submitter.TransactionSubmitter, cfg config.Config, handler metrics.Handler) *Executor {
+	return &Executor{db: store, submitter: txSubmitter, cfg: cfg, metrics: handler}
+}
+
+// RemovedLogHandler exports removedLogHandler for testing.
+func (e *Executor) RemovedLogHandler(parser executionservice.Parser) listener.HandleRemovedLog {
+	return e.removedLogHandler(parser)
+}
+
+// AbandonExpiredExecutions exports abandonExpiredExecutions for testing.
+func (e *Executor) AbandonExpiredExecutions(ctx context.Context) error {
+	return e.abandonExpiredExecutions(ctx)
+}
@@ -0,0 +1,85 @@
+package executor_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Flaque/filet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/synapsecns/sanguine/core/metrics"
+	"github.com/synapsecns/sanguine/sin-executor/config"
+	"github.com/synapsecns/sanguine/sin-executor/contracts/executionservice"
+	"github.com/synapsecns/sanguine/sin-executor/contracts/interchainclient"
+	"github.com/synapsecns/sanguine/sin-executor/db"
+	"github.com/synapsecns/sanguine/sin-executor/db/sqlite"
+	"github.com/synapsecns/sanguine/sin-executor/executor"
+)
+
+// executionRequestedLog creates the ExecutionRequested log for a transaction.
+func executionRequestedLog(t *testing.T, executionService common.Address, transactionID common.Hash) types.Log {
+	t.Helper()
+
+	serviceABI, err := executionservice.SynapseExecutionServiceV1HarnessMetaData.GetAbi()
+	require.NoError(t, err)
+
+	event := serviceABI.Events["ExecutionRequested"]
+	data, err := event.Inputs.NonIndexed().Pack(common.BigToAddress(big.NewInt(1)))
+	require.NoError(t, err)
+
+	return types.Log{
+		Address: executionService,
+		Topics:  []common.Hash{event.ID, transactionID},
+		Data:    data,
+		Removed: true,
+	}
+}
+
+func TestRemovedLogHandler(t *testing.T) {
+	ctx := context.Background()
+	handler := metrics.NewNullHandler()
+
+	store, err := sqlite.NewSqliteStore(ctx, filet.TmpDir(t, ""), handler)
+	require.NoError(t, err)
+
+	executionService := common.BigToAddress(big.NewInt(2))
+	parser, err := executionservice.NewParser(executionService)
+	require.NoError(t, err)
+
+	removed := common.BigToHash(big.NewInt(1))
+	kept := common.BigToHash(big.NewInt(2))
+	for _, transactionID := range []common.Hash{removed, kept} {
+		err = store.StoreInterchainTransaction(ctx, big.NewInt(1), &interchainclient.InterchainClientV1InterchainTransactionSent{
+			TransactionId: transactionID,
+			DstChainId:    2,
+		}, &interchainclient.OptionsV1{GasLimit: big.NewInt(100_000), GasAirdrop: big.NewInt(0)}, nil)
+		require.NoError(t, err)
+	}
+
+	removedLogHandler := executor.NewTestExecutor(store, nil, config.Config{}, handler).RemovedLogHandler(parser)
+
+	// the removed request is forgotten, the other one is still executed.
+	err = removedLogHandler(ctx, executionRequestedLog(t, executionService, removed))
+	require.NoError(t, err)
+
+	pending, err := store.GetInterchainTXsByStatus(ctx, db.Seen, db.Ready, db.Executed)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, kept, pending[0].TransactionID)
+
+	// an executed transaction can't be undone, so it's kept.
+	err = store.UpdateInterchainTransactionStatus(ctx, kept, db.Executed)
+	require.NoError(t, err)
+	err = removedLogHandler(ctx, executionRequestedLog(t, executionService, kept))
+	require.NoError(t, err)
+
+	executed, err := store.GetInterchainTXsByStatus(ctx, db.Executed)
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+
+	// logs from other events are ignored.
+	err = removedLogHandler(ctx, types.Log{Address: executionService, Topics: []common.Hash{{}}, Removed: true})
+	require.NoError(t, err)
+}